@@ -0,0 +1,1326 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: admin.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Models
+type ArchivedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ArchivedAt    int64                  `protobuf:"varint,2,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedUser) Reset() {
+	*x = ArchivedUser{}
+	mi := &file_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedUser) ProtoMessage() {}
+
+func (x *ArchivedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedUser.ProtoReflect.Descriptor instead.
+func (*ArchivedUser) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ArchivedUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ArchivedUser) GetArchivedAt() int64 {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return 0
+}
+
+type ArchivedPost struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	AuthorUid      string                 `protobuf:"bytes,2,opt,name=author_uid,json=authorUid,proto3" json:"author_uid,omitempty"`
+	AuthorNickname string                 `protobuf:"bytes,3,opt,name=author_nickname,json=authorNickname,proto3" json:"author_nickname,omitempty"`
+	Text           string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Images         []string               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	Visibility     string                 `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt     int64                  `protobuf:"varint,8,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ArchivedPost) Reset() {
+	*x = ArchivedPost{}
+	mi := &file_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedPost) ProtoMessage() {}
+
+func (x *ArchivedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedPost.ProtoReflect.Descriptor instead.
+func (*ArchivedPost) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArchivedPost) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ArchivedPost) GetAuthorUid() string {
+	if x != nil {
+		return x.AuthorUid
+	}
+	return ""
+}
+
+func (x *ArchivedPost) GetAuthorNickname() string {
+	if x != nil {
+		return x.AuthorNickname
+	}
+	return ""
+}
+
+func (x *ArchivedPost) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ArchivedPost) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ArchivedPost) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *ArchivedPost) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ArchivedPost) GetArchivedAt() int64 {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return 0
+}
+
+type ArchivedComment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PostUid        string                 `protobuf:"bytes,2,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	RootUid        string                 `protobuf:"bytes,3,opt,name=root_uid,json=rootUid,proto3" json:"root_uid,omitempty"`
+	AuthorUid      string                 `protobuf:"bytes,4,opt,name=author_uid,json=authorUid,proto3" json:"author_uid,omitempty"`
+	AuthorNickname string                 `protobuf:"bytes,5,opt,name=author_nickname,json=authorNickname,proto3" json:"author_nickname,omitempty"`
+	Content        string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Images         []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt     int64                  `protobuf:"varint,9,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ArchivedComment) Reset() {
+	*x = ArchivedComment{}
+	mi := &file_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedComment) ProtoMessage() {}
+
+func (x *ArchivedComment) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedComment.ProtoReflect.Descriptor instead.
+func (*ArchivedComment) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArchivedComment) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ArchivedComment) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *ArchivedComment) GetRootUid() string {
+	if x != nil {
+		return x.RootUid
+	}
+	return ""
+}
+
+func (x *ArchivedComment) GetAuthorUid() string {
+	if x != nil {
+		return x.AuthorUid
+	}
+	return ""
+}
+
+func (x *ArchivedComment) GetAuthorNickname() string {
+	if x != nil {
+		return x.AuthorNickname
+	}
+	return ""
+}
+
+func (x *ArchivedComment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ArchivedComment) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ArchivedComment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ArchivedComment) GetArchivedAt() int64 {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return 0
+}
+
+type AuditLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ActorUid      string                 `protobuf:"bytes,2,opt,name=actor_uid,json=actorUid,proto3" json:"actor_uid,omitempty"`
+	ActorNickname string                 `protobuf:"bytes,3,opt,name=actor_nickname,json=actorNickname,proto3" json:"actor_nickname,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    string                 `protobuf:"bytes,5,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetUid     string                 `protobuf:"bytes,6,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Detail        string                 `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuditLog) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *AuditLog) GetActorUid() string {
+	if x != nil {
+		return x.ActorUid
+	}
+	return ""
+}
+
+func (x *AuditLog) GetActorNickname() string {
+	if x != nil {
+		return x.ActorNickname
+	}
+	return ""
+}
+
+func (x *AuditLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTargetUid() string {
+	if x != nil {
+		return x.TargetUid
+	}
+	return ""
+}
+
+func (x *AuditLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditLog) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SuspendUserRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RestoreUserRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RestoreUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // ADMIN/USER
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleRequest) Reset() {
+	*x = SetUserRoleRequest{}
+	mi := &file_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleRequest) ProtoMessage() {}
+
+func (x *SetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetUserRoleRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SetUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetUserRoleRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ArchivePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePostRequest) Reset() {
+	*x = ArchivePostRequest{}
+	mi := &file_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePostRequest) ProtoMessage() {}
+
+func (x *ArchivePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePostRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePostRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ArchivePostRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ArchivePostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RestorePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
+	mi := &file_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRequest) ProtoMessage() {}
+
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestorePostRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RestorePostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ArchiveCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCommentRequest) Reset() {
+	*x = ArchiveCommentRequest{}
+	mi := &file_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCommentRequest) ProtoMessage() {}
+
+func (x *ArchiveCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCommentRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCommentRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArchiveCommentRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ArchiveCommentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RestoreCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCommentRequest) Reset() {
+	*x = RestoreCommentRequest{}
+	mi := &file_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCommentRequest) ProtoMessage() {}
+
+func (x *RestoreCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCommentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCommentRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreCommentRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RestoreCommentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListArchivedRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CursorArchivedAt int64                  `protobuf:"varint,1,opt,name=cursor_archived_at,json=cursorArchivedAt,proto3" json:"cursor_archived_at,omitempty"` // unix seconds
+	CursorId         string                 `protobuf:"bytes,2,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListArchivedRequest) Reset() {
+	*x = ListArchivedRequest{}
+	mi := &file_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedRequest) ProtoMessage() {}
+
+func (x *ListArchivedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedRequest.ProtoReflect.Descriptor instead.
+func (*ListArchivedRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListArchivedRequest) GetCursorArchivedAt() int64 {
+	if x != nil {
+		return x.CursorArchivedAt
+	}
+	return 0
+}
+
+func (x *ListArchivedRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListArchivedUsersResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Users                []*ArchivedUser        `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursorArchivedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_archived_at,json=nextCursorArchivedAt,proto3" json:"next_cursor_archived_at,omitempty"`
+	NextCursorId         string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListArchivedUsersResponse) Reset() {
+	*x = ListArchivedUsersResponse{}
+	mi := &file_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedUsersResponse) ProtoMessage() {}
+
+func (x *ListArchivedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListArchivedUsersResponse) GetUsers() []*ArchivedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListArchivedUsersResponse) GetNextCursorArchivedAt() int64 {
+	if x != nil {
+		return x.NextCursorArchivedAt
+	}
+	return 0
+}
+
+func (x *ListArchivedUsersResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+type ListArchivedPostsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Posts                []*ArchivedPost        `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextCursorArchivedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_archived_at,json=nextCursorArchivedAt,proto3" json:"next_cursor_archived_at,omitempty"`
+	NextCursorId         string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListArchivedPostsResponse) Reset() {
+	*x = ListArchivedPostsResponse{}
+	mi := &file_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedPostsResponse) ProtoMessage() {}
+
+func (x *ListArchivedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListArchivedPostsResponse) GetPosts() []*ArchivedPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListArchivedPostsResponse) GetNextCursorArchivedAt() int64 {
+	if x != nil {
+		return x.NextCursorArchivedAt
+	}
+	return 0
+}
+
+func (x *ListArchivedPostsResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+type ListArchivedCommentsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Comments             []*ArchivedComment     `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursorArchivedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_archived_at,json=nextCursorArchivedAt,proto3" json:"next_cursor_archived_at,omitempty"`
+	NextCursorId         string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListArchivedCommentsResponse) Reset() {
+	*x = ListArchivedCommentsResponse{}
+	mi := &file_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedCommentsResponse) ProtoMessage() {}
+
+func (x *ListArchivedCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivedCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListArchivedCommentsResponse) GetComments() []*ArchivedComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListArchivedCommentsResponse) GetNextCursorArchivedAt() int64 {
+	if x != nil {
+		return x.NextCursorArchivedAt
+	}
+	return 0
+}
+
+func (x *ListArchivedCommentsResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+type ListAuditLogsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TargetUid       string                 `protobuf:"bytes,1,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"`
+	CursorCreatedAt int64                  `protobuf:"varint,2,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,3,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAuditLogsRequest) GetTargetUid() string {
+	if x != nil {
+		return x.TargetUid
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetCursorCreatedAt() int64 {
+	if x != nil {
+		return x.CursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListAuditLogsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Logs                []*AuditLog            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	NextCursorCreatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_created_at,json=nextCursorCreatedAt,proto3" json:"next_cursor_created_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAuditLogsResponse) GetLogs() []*AuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetNextCursorCreatedAt() int64 {
+	if x != nil {
+		return x.NextCursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListAuditLogsResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+var File_admin_proto protoreflect.FileDescriptor
+
+const file_admin_proto_rawDesc = "" +
+	"\n" +
+	"\vadmin.proto\x12\x05admin\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\x1a\n" +
+	"post.proto\"[\n" +
+	"\fArchivedUser\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\f.common.UserB\x03\xe0A\x02R\x04user\x12$\n" +
+	"\varchived_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\n" +
+	"archivedAt\"\x9c\x02\n" +
+	"\fArchivedPost\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\"\n" +
+	"\n" +
+	"author_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\tauthorUid\x12,\n" +
+	"\x0fauthor_nickname\x18\x03 \x01(\tB\x03\xe0A\x02R\x0eauthorNickname\x12\x17\n" +
+	"\x04text\x18\x04 \x01(\tB\x03\xe0A\x02R\x04text\x12\x1b\n" +
+	"\x06images\x18\x05 \x03(\tB\x03\xe0A\x02R\x06images\x12#\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\tB\x03\xe0A\x02R\n" +
+	"visibility\x12\"\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12$\n" +
+	"\varchived_at\x18\b \x01(\x03B\x03\xe0A\x02R\n" +
+	"archivedAt\"\xc0\x02\n" +
+	"\x0fArchivedComment\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1e\n" +
+	"\bpost_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\apostUid\x12\x1e\n" +
+	"\broot_uid\x18\x03 \x01(\tB\x03\xe0A\x02R\arootUid\x12\"\n" +
+	"\n" +
+	"author_uid\x18\x04 \x01(\tB\x03\xe0A\x02R\tauthorUid\x12,\n" +
+	"\x0fauthor_nickname\x18\x05 \x01(\tB\x03\xe0A\x02R\x0eauthorNickname\x12\x1d\n" +
+	"\acontent\x18\x06 \x01(\tB\x03\xe0A\x02R\acontent\x12\x1b\n" +
+	"\x06images\x18\a \x03(\tB\x03\xe0A\x02R\x06images\x12\"\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12$\n" +
+	"\varchived_at\x18\t \x01(\x03B\x03\xe0A\x02R\n" +
+	"archivedAt\"\xb4\x02\n" +
+	"\bAuditLog\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12 \n" +
+	"\tactor_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\bactorUid\x12*\n" +
+	"\x0eactor_nickname\x18\x03 \x01(\tB\x03\xe0A\x02R\ractorNickname\x12\x1b\n" +
+	"\x06action\x18\x04 \x01(\tB\x03\xe0A\x02R\x06action\x12$\n" +
+	"\vtarget_type\x18\x05 \x01(\tB\x03\xe0A\x02R\n" +
+	"targetType\x12\"\n" +
+	"\n" +
+	"target_uid\x18\x06 \x01(\tB\x03\xe0A\x02R\ttargetUid\x12\x1b\n" +
+	"\x06reason\x18\a \x01(\tB\x03\xe0A\x02R\x06reason\x12\x1b\n" +
+	"\x06detail\x18\b \x01(\tB\x03\xe0A\x02R\x06detail\x12\"\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03B\x03\xe0A\x02R\tcreatedAt\"C\n" +
+	"\x12SuspendUserRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"C\n" +
+	"\x12RestoreUserRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\\\n" +
+	"\x12SetUserRoleRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x17\n" +
+	"\x04role\x18\x02 \x01(\tB\x03\xe0A\x02R\x04role\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"C\n" +
+	"\x12ArchivePostRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"C\n" +
+	"\x12RestorePostRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"F\n" +
+	"\x15ArchiveCommentRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"F\n" +
+	"\x15RestoreCommentRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"`\n" +
+	"\x13ListArchivedRequest\x12,\n" +
+	"\x12cursor_archived_at\x18\x01 \x01(\x03R\x10cursorArchivedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x02 \x01(\tR\bcursorId\"\xb2\x01\n" +
+	"\x19ListArchivedUsersResponse\x12.\n" +
+	"\x05users\x18\x01 \x03(\v2\x13.admin.ArchivedUserB\x03\xe0A\x02R\x05users\x12:\n" +
+	"\x17next_cursor_archived_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x14nextCursorArchivedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"\xb2\x01\n" +
+	"\x19ListArchivedPostsResponse\x12.\n" +
+	"\x05posts\x18\x01 \x03(\v2\x13.admin.ArchivedPostB\x03\xe0A\x02R\x05posts\x12:\n" +
+	"\x17next_cursor_archived_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x14nextCursorArchivedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"\xbe\x01\n" +
+	"\x1cListArchivedCommentsResponse\x127\n" +
+	"\bcomments\x18\x01 \x03(\v2\x16.admin.ArchivedCommentB\x03\xe0A\x02R\bcomments\x12:\n" +
+	"\x17next_cursor_archived_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x14nextCursorArchivedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"~\n" +
+	"\x14ListAuditLogsRequest\x12\x1d\n" +
+	"\n" +
+	"target_uid\x18\x01 \x01(\tR\ttargetUid\x12*\n" +
+	"\x11cursor_created_at\x18\x02 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x03 \x01(\tR\bcursorId\"\xa6\x01\n" +
+	"\x15ListAuditLogsResponse\x12(\n" +
+	"\x04logs\x18\x01 \x03(\v2\x0f.admin.AuditLogB\x03\xe0A\x02R\x04logs\x128\n" +
+	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId2\x94\v\n" +
+	"\fAdminService\x12n\n" +
+	"\vSuspendUser\x12\x19.admin.SuspendUserRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/users/{uid}/suspend\x12n\n" +
+	"\vRestoreUser\x12\x19.admin.RestoreUserRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/users/{uid}/restore\x12k\n" +
+	"\vSetUserRole\x12\x19.admin.SetUserRoleRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/admin/users/{uid}/role\x12n\n" +
+	"\vArchivePost\x12\x19.admin.ArchivePostRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/posts/{uid}/archive\x12n\n" +
+	"\vRestorePost\x12\x19.admin.RestorePostRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/posts/{uid}/restore\x12\x81\x01\n" +
+	"\x11ListPostRevisions\x12\x1e.post.ListPostRevisionsRequest\x1a\x1f.post.ListPostRevisionsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/admin/posts/{uid}/revisions\x12w\n" +
+	"\x0eArchiveComment\x12\x1c.admin.ArchiveCommentRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/comments/{uid}/archive\x12w\n" +
+	"\x0eRestoreComment\x12\x1c.admin.RestoreCommentRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/comments/{uid}/restore\x12w\n" +
+	"\x11ListArchivedUsers\x12\x1a.admin.ListArchivedRequest\x1a .admin.ListArchivedUsersResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/admin/archived/users\x12w\n" +
+	"\x11ListArchivedPosts\x12\x1a.admin.ListArchivedRequest\x1a .admin.ListArchivedPostsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/admin/archived/posts\x12\x80\x01\n" +
+	"\x14ListArchivedComments\x12\x1a.admin.ListArchivedRequest\x1a#.admin.ListArchivedCommentsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/admin/archived/comments\x12l\n" +
+	"\rListAuditLogs\x12\x1b.admin.ListAuditLogsRequest\x1a\x1c.admin.ListAuditLogsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/admin/audit-logsB\x0fZ\raeibi/api;apib\x06proto3"
+
+var (
+	file_admin_proto_rawDescOnce sync.Once
+	file_admin_proto_rawDescData []byte
+)
+
+func file_admin_proto_rawDescGZIP() []byte {
+	file_admin_proto_rawDescOnce.Do(func() {
+		file_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)))
+	})
+	return file_admin_proto_rawDescData
+}
+
+var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_admin_proto_goTypes = []any{
+	(*ArchivedUser)(nil),                 // 0: admin.ArchivedUser
+	(*ArchivedPost)(nil),                 // 1: admin.ArchivedPost
+	(*ArchivedComment)(nil),              // 2: admin.ArchivedComment
+	(*AuditLog)(nil),                     // 3: admin.AuditLog
+	(*SuspendUserRequest)(nil),           // 4: admin.SuspendUserRequest
+	(*RestoreUserRequest)(nil),           // 5: admin.RestoreUserRequest
+	(*SetUserRoleRequest)(nil),           // 6: admin.SetUserRoleRequest
+	(*ArchivePostRequest)(nil),           // 7: admin.ArchivePostRequest
+	(*RestorePostRequest)(nil),           // 8: admin.RestorePostRequest
+	(*ArchiveCommentRequest)(nil),        // 9: admin.ArchiveCommentRequest
+	(*RestoreCommentRequest)(nil),        // 10: admin.RestoreCommentRequest
+	(*ListArchivedRequest)(nil),          // 11: admin.ListArchivedRequest
+	(*ListArchivedUsersResponse)(nil),    // 12: admin.ListArchivedUsersResponse
+	(*ListArchivedPostsResponse)(nil),    // 13: admin.ListArchivedPostsResponse
+	(*ListArchivedCommentsResponse)(nil), // 14: admin.ListArchivedCommentsResponse
+	(*ListAuditLogsRequest)(nil),         // 15: admin.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),        // 16: admin.ListAuditLogsResponse
+	(*User)(nil),                         // 17: common.User
+	(*ListPostRevisionsRequest)(nil),     // 18: post.ListPostRevisionsRequest
+	(*emptypb.Empty)(nil),                // 19: google.protobuf.Empty
+	(*ListPostRevisionsResponse)(nil),    // 20: post.ListPostRevisionsResponse
+}
+var file_admin_proto_depIdxs = []int32{
+	17, // 0: admin.ArchivedUser.user:type_name -> common.User
+	0,  // 1: admin.ListArchivedUsersResponse.users:type_name -> admin.ArchivedUser
+	1,  // 2: admin.ListArchivedPostsResponse.posts:type_name -> admin.ArchivedPost
+	2,  // 3: admin.ListArchivedCommentsResponse.comments:type_name -> admin.ArchivedComment
+	3,  // 4: admin.ListAuditLogsResponse.logs:type_name -> admin.AuditLog
+	4,  // 5: admin.AdminService.SuspendUser:input_type -> admin.SuspendUserRequest
+	5,  // 6: admin.AdminService.RestoreUser:input_type -> admin.RestoreUserRequest
+	6,  // 7: admin.AdminService.SetUserRole:input_type -> admin.SetUserRoleRequest
+	7,  // 8: admin.AdminService.ArchivePost:input_type -> admin.ArchivePostRequest
+	8,  // 9: admin.AdminService.RestorePost:input_type -> admin.RestorePostRequest
+	18, // 10: admin.AdminService.ListPostRevisions:input_type -> post.ListPostRevisionsRequest
+	9,  // 11: admin.AdminService.ArchiveComment:input_type -> admin.ArchiveCommentRequest
+	10, // 12: admin.AdminService.RestoreComment:input_type -> admin.RestoreCommentRequest
+	11, // 13: admin.AdminService.ListArchivedUsers:input_type -> admin.ListArchivedRequest
+	11, // 14: admin.AdminService.ListArchivedPosts:input_type -> admin.ListArchivedRequest
+	11, // 15: admin.AdminService.ListArchivedComments:input_type -> admin.ListArchivedRequest
+	15, // 16: admin.AdminService.ListAuditLogs:input_type -> admin.ListAuditLogsRequest
+	19, // 17: admin.AdminService.SuspendUser:output_type -> google.protobuf.Empty
+	19, // 18: admin.AdminService.RestoreUser:output_type -> google.protobuf.Empty
+	19, // 19: admin.AdminService.SetUserRole:output_type -> google.protobuf.Empty
+	19, // 20: admin.AdminService.ArchivePost:output_type -> google.protobuf.Empty
+	19, // 21: admin.AdminService.RestorePost:output_type -> google.protobuf.Empty
+	20, // 22: admin.AdminService.ListPostRevisions:output_type -> post.ListPostRevisionsResponse
+	19, // 23: admin.AdminService.ArchiveComment:output_type -> google.protobuf.Empty
+	19, // 24: admin.AdminService.RestoreComment:output_type -> google.protobuf.Empty
+	12, // 25: admin.AdminService.ListArchivedUsers:output_type -> admin.ListArchivedUsersResponse
+	13, // 26: admin.AdminService.ListArchivedPosts:output_type -> admin.ListArchivedPostsResponse
+	14, // 27: admin.AdminService.ListArchivedComments:output_type -> admin.ListArchivedCommentsResponse
+	16, // 28: admin.AdminService.ListAuditLogs:output_type -> admin.ListAuditLogsResponse
+	17, // [17:29] is the sub-list for method output_type
+	5,  // [5:17] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_admin_proto_init() }
+func file_admin_proto_init() {
+	if File_admin_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_post_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_proto_goTypes,
+		DependencyIndexes: file_admin_proto_depIdxs,
+		MessageInfos:      file_admin_proto_msgTypes,
+	}.Build()
+	File_admin_proto = out.File
+	file_admin_proto_goTypes = nil
+	file_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,568 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: admin.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_SuspendUser_FullMethodName          = "/admin.AdminService/SuspendUser"
+	AdminService_RestoreUser_FullMethodName          = "/admin.AdminService/RestoreUser"
+	AdminService_SetUserRole_FullMethodName          = "/admin.AdminService/SetUserRole"
+	AdminService_ArchivePost_FullMethodName          = "/admin.AdminService/ArchivePost"
+	AdminService_RestorePost_FullMethodName          = "/admin.AdminService/RestorePost"
+	AdminService_ListPostRevisions_FullMethodName    = "/admin.AdminService/ListPostRevisions"
+	AdminService_ArchiveComment_FullMethodName       = "/admin.AdminService/ArchiveComment"
+	AdminService_RestoreComment_FullMethodName       = "/admin.AdminService/RestoreComment"
+	AdminService_ListArchivedUsers_FullMethodName    = "/admin.AdminService/ListArchivedUsers"
+	AdminService_ListArchivedPosts_FullMethodName    = "/admin.AdminService/ListArchivedPosts"
+	AdminService_ListArchivedComments_FullMethodName = "/admin.AdminService/ListArchivedComments"
+	AdminService_ListAuditLogs_FullMethodName        = "/admin.AdminService/ListAuditLogs"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService
+type AdminServiceClient interface {
+	// POST /api/v1/admin/users/{uid}/suspend 封禁用户
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/admin/users/{uid}/restore 解封用户
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PUT /api/v1/admin/users/{uid}/role 修改用户角色
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/admin/posts/{uid}/archive 强制下架帖子
+	ArchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/admin/posts/{uid}/restore 恢复帖子
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/admin/posts/{uid}/revisions 帖子编辑历史（含已下架、不可见的帖子），用于审核举报
+	ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error)
+	// POST /api/v1/admin/comments/{uid}/archive 强制下架评论
+	ArchiveComment(ctx context.Context, in *ArchiveCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/admin/comments/{uid}/restore 恢复评论
+	RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/admin/archived/users 已封禁用户列表
+	ListArchivedUsers(ctx context.Context, in *ListArchivedRequest, opts ...grpc.CallOption) (*ListArchivedUsersResponse, error)
+	// GET /api/v1/admin/archived/posts 已下架帖子列表
+	ListArchivedPosts(ctx context.Context, in *ListArchivedRequest, opts ...grpc.CallOption) (*ListArchivedPostsResponse, error)
+	// GET /api/v1/admin/archived/comments 已下架评论列表
+	ListArchivedComments(ctx context.Context, in *ListArchivedRequest, opts ...grpc.CallOption) (*ListArchivedCommentsResponse, error)
+	// GET /api/v1/admin/audit-logs 管理操作审计日志
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_SetUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ArchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_ArchivePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPostRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ArchiveComment(ctx context.Context, in *ArchiveCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_ArchiveComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_RestoreComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListArchivedUsers(ctx context.Context, in *ListArchivedRequest, opts ...grpc.CallOption) (*ListArchivedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArchivedUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListArchivedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListArchivedPosts(ctx context.Context, in *ListArchivedRequest, opts ...grpc.CallOption) (*ListArchivedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArchivedPostsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListArchivedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListArchivedComments(ctx context.Context, in *ListArchivedRequest, opts ...grpc.CallOption) (*ListArchivedCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArchivedCommentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListArchivedComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService
+type AdminServiceServer interface {
+	// POST /api/v1/admin/users/{uid}/suspend 封禁用户
+	SuspendUser(context.Context, *SuspendUserRequest) (*emptypb.Empty, error)
+	// POST /api/v1/admin/users/{uid}/restore 解封用户
+	RestoreUser(context.Context, *RestoreUserRequest) (*emptypb.Empty, error)
+	// PUT /api/v1/admin/users/{uid}/role 修改用户角色
+	SetUserRole(context.Context, *SetUserRoleRequest) (*emptypb.Empty, error)
+	// POST /api/v1/admin/posts/{uid}/archive 强制下架帖子
+	ArchivePost(context.Context, *ArchivePostRequest) (*emptypb.Empty, error)
+	// POST /api/v1/admin/posts/{uid}/restore 恢复帖子
+	RestorePost(context.Context, *RestorePostRequest) (*emptypb.Empty, error)
+	// GET /api/v1/admin/posts/{uid}/revisions 帖子编辑历史（含已下架、不可见的帖子），用于审核举报
+	ListPostRevisions(context.Context, *ListPostRevisionsRequest) (*ListPostRevisionsResponse, error)
+	// POST /api/v1/admin/comments/{uid}/archive 强制下架评论
+	ArchiveComment(context.Context, *ArchiveCommentRequest) (*emptypb.Empty, error)
+	// POST /api/v1/admin/comments/{uid}/restore 恢复评论
+	RestoreComment(context.Context, *RestoreCommentRequest) (*emptypb.Empty, error)
+	// GET /api/v1/admin/archived/users 已封禁用户列表
+	ListArchivedUsers(context.Context, *ListArchivedRequest) (*ListArchivedUsersResponse, error)
+	// GET /api/v1/admin/archived/posts 已下架帖子列表
+	ListArchivedPosts(context.Context, *ListArchivedRequest) (*ListArchivedPostsResponse, error)
+	// GET /api/v1/admin/archived/comments 已下架评论列表
+	ListArchivedComments(context.Context, *ListArchivedRequest) (*ListArchivedCommentsResponse, error)
+	// GET /api/v1/admin/audit-logs 管理操作审计日志
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreUser(context.Context, *RestoreUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedAdminServiceServer) SetUserRole(context.Context, *SetUserRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedAdminServiceServer) ArchivePost(context.Context, *ArchivePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchivePost not implemented")
+}
+func (UnimplementedAdminServiceServer) RestorePost(context.Context, *RestorePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPostRevisions(context.Context, *ListPostRevisionsRequest) (*ListPostRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPostRevisions not implemented")
+}
+func (UnimplementedAdminServiceServer) ArchiveComment(context.Context, *ArchiveCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveComment not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreComment(context.Context, *RestoreCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreComment not implemented")
+}
+func (UnimplementedAdminServiceServer) ListArchivedUsers(context.Context, *ListArchivedRequest) (*ListArchivedUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListArchivedUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) ListArchivedPosts(context.Context, *ListArchivedRequest) (*ListArchivedPostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListArchivedPosts not implemented")
+}
+func (UnimplementedAdminServiceServer) ListArchivedComments(context.Context, *ListArchivedRequest) (*ListArchivedCommentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListArchivedComments not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserRole(ctx, req.(*SetUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ArchivePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ArchivePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ArchivePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ArchivePost(ctx, req.(*ArchivePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestorePost(ctx, req.(*RestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPostRevisions(ctx, req.(*ListPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ArchiveComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ArchiveComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ArchiveComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ArchiveComment(ctx, req.(*ArchiveCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreComment(ctx, req.(*RestoreCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListArchivedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListArchivedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListArchivedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListArchivedUsers(ctx, req.(*ListArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListArchivedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListArchivedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListArchivedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListArchivedPosts(ctx, req.(*ListArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListArchivedComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListArchivedComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListArchivedComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListArchivedComments(ctx, req.(*ListArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SuspendUser",
+			Handler:    _AdminService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _AdminService_RestoreUser_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _AdminService_SetUserRole_Handler,
+		},
+		{
+			MethodName: "ArchivePost",
+			Handler:    _AdminService_ArchivePost_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _AdminService_RestorePost_Handler,
+		},
+		{
+			MethodName: "ListPostRevisions",
+			Handler:    _AdminService_ListPostRevisions_Handler,
+		},
+		{
+			MethodName: "ArchiveComment",
+			Handler:    _AdminService_ArchiveComment_Handler,
+		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _AdminService_RestoreComment_Handler,
+		},
+		{
+			MethodName: "ListArchivedUsers",
+			Handler:    _AdminService_ListArchivedUsers_Handler,
+		},
+		{
+			MethodName: "ListArchivedPosts",
+			Handler:    _AdminService_ListArchivedPosts_Handler,
+		},
+		{
+			MethodName: "ListArchivedComments",
+			Handler:    _AdminService_ListArchivedComments_Handler,
+		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _AdminService_ListAuditLogs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
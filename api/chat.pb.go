@@ -0,0 +1,1785 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: chat.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Models
+type MessageSender struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageSender) Reset() {
+	*x = MessageSender{}
+	mi := &file_chat_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageSender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageSender) ProtoMessage() {}
+
+func (x *MessageSender) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageSender.ProtoReflect.Descriptor instead.
+func (*MessageSender) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageSender) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *MessageSender) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *MessageSender) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type Message struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uid             string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ConversationUid string                 `protobuf:"bytes,2,opt,name=conversation_uid,json=conversationUid,proto3" json:"conversation_uid,omitempty"`
+	Sender          *MessageSender         `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Content         string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Images          []string               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type            string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                     // TEXT/SYSTEM
+	SystemAction    string                 `protobuf:"bytes,8,opt,name=system_action,json=systemAction,proto3" json:"system_action,omitempty"` // GROUP_CREATED/GROUP_UPDATED/MEMBER_ADDED/MEMBER_REMOVED/MEMBER_LEFT/ROLE_CHANGED
+	TargetUid       string                 `protobuf:"bytes,9,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"`          // 系统消息涉及的成员
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_chat_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Message) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Message) GetConversationUid() string {
+	if x != nil {
+		return x.ConversationUid
+	}
+	return ""
+}
+
+func (x *Message) GetSender() *MessageSender {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *Message) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Message) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Message) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Message) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Message) GetSystemAction() string {
+	if x != nil {
+		return x.SystemAction
+	}
+	return ""
+}
+
+func (x *Message) GetTargetUid() string {
+	if x != nil {
+		return x.TargetUid
+	}
+	return ""
+}
+
+type ReadReceipt struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	LastReadMessageUid string                 `protobuf:"bytes,1,opt,name=last_read_message_uid,json=lastReadMessageUid,proto3" json:"last_read_message_uid,omitempty"`
+	LastReadAt         int64                  `protobuf:"varint,2,opt,name=last_read_at,json=lastReadAt,proto3" json:"last_read_at,omitempty"` // 已读到的消息的发送时间
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReadReceipt) Reset() {
+	*x = ReadReceipt{}
+	mi := &file_chat_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipt) ProtoMessage() {}
+
+func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipt.ProtoReflect.Descriptor instead.
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadReceipt) GetLastReadMessageUid() string {
+	if x != nil {
+		return x.LastReadMessageUid
+	}
+	return ""
+}
+
+func (x *ReadReceipt) GetLastReadAt() int64 {
+	if x != nil {
+		return x.LastReadAt
+	}
+	return 0
+}
+
+type Conversation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // DIRECT/GROUP
+	Peer          *MessageSender         `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"` // DIRECT 会话的对方
+	LastMessage   *Message               `protobuf:"bytes,4,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,5,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MyRead        *ReadReceipt           `protobuf:"bytes,6,opt,name=my_read,json=myRead,proto3" json:"my_read,omitempty"`
+	PeerRead      *ReadReceipt           `protobuf:"bytes,7,opt,name=peer_read,json=peerRead,proto3" json:"peer_read,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Name          string                 `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`                            // GROUP
+	AvatarUrl     string                 `protobuf:"bytes,11,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // GROUP
+	MemberCount   int32                  `protobuf:"varint,12,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	MyRole        string                 `protobuf:"bytes,13,opt,name=my_role,json=myRole,proto3" json:"my_role,omitempty"` // OWNER/ADMIN/MEMBER
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_chat_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Conversation) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Conversation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Conversation) GetPeer() *MessageSender {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *Conversation) GetLastMessage() *Message {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+func (x *Conversation) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *Conversation) GetMyRead() *ReadReceipt {
+	if x != nil {
+		return x.MyRead
+	}
+	return nil
+}
+
+func (x *Conversation) GetPeerRead() *ReadReceipt {
+	if x != nil {
+		return x.PeerRead
+	}
+	return nil
+}
+
+func (x *Conversation) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Conversation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Conversation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Conversation) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Conversation) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Conversation) GetMyRole() string {
+	if x != nil {
+		return x.MyRole
+	}
+	return ""
+}
+
+type GroupMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // OWNER/ADMIN/MEMBER
+	JoinedAt      int64                  `protobuf:"varint,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	mi := &file_chat_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GroupMember) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *GroupMember) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GroupMember) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *GroupMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GroupMember) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+type MessageSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permission    string                 `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"` // EVERYONE/FOLLOWING/NOBODY
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageSettings) Reset() {
+	*x = MessageSettings{}
+	mi := &file_chat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageSettings) ProtoMessage() {}
+
+func (x *MessageSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageSettings.ProtoReflect.Descriptor instead.
+func (*MessageSettings) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MessageSettings) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// Send
+type SendMessageRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationUid string                 `protobuf:"bytes,1,opt,name=conversation_uid,json=conversationUid,proto3" json:"conversation_uid,omitempty"` // 与 recipient_uid 二选一
+	RecipientUid    string                 `protobuf:"bytes,2,opt,name=recipient_uid,json=recipientUid,proto3" json:"recipient_uid,omitempty"`
+	Content         string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Images          []string               `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"` // FileService 上传的图片 URL
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_chat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SendMessageRequest) GetConversationUid() string {
+	if x != nil {
+		return x.ConversationUid
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetRecipientUid() string {
+	if x != nil {
+		return x.RecipientUid
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// List
+type ListConversationsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CursorUpdatedAt int64                  `protobuf:"varint,1,opt,name=cursor_updated_at,json=cursorUpdatedAt,proto3" json:"cursor_updated_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,2,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsRequest) ProtoMessage() {}
+
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListConversationsRequest) GetCursorUpdatedAt() int64 {
+	if x != nil {
+		return x.CursorUpdatedAt
+	}
+	return 0
+}
+
+func (x *ListConversationsRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListConversationsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Conversations       []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	NextCursorUpdatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_updated_at,json=nextCursorUpdatedAt,proto3" json:"next_cursor_updated_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListConversationsResponse) Reset() {
+	*x = ListConversationsResponse{}
+	mi := &file_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsResponse) ProtoMessage() {}
+
+func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListConversationsResponse) GetConversations() []*Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+func (x *ListConversationsResponse) GetNextCursorUpdatedAt() int64 {
+	if x != nil {
+		return x.NextCursorUpdatedAt
+	}
+	return 0
+}
+
+func (x *ListConversationsResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+type GetConversationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationRequest) Reset() {
+	*x = GetConversationRequest{}
+	mi := &file_chat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationRequest) ProtoMessage() {}
+
+func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetConversationRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type GetConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationResponse) Reset() {
+	*x = GetConversationResponse{}
+	mi := &file_chat_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationResponse) ProtoMessage() {}
+
+func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type ListMessagesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uid             string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CursorCreatedAt int64                  `protobuf:"varint,2,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,3,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMessagesRequest) Reset() {
+	*x = ListMessagesRequest{}
+	mi := &file_chat_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesRequest) ProtoMessage() {}
+
+func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListMessagesRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ListMessagesRequest) GetCursorCreatedAt() int64 {
+	if x != nil {
+		return x.CursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListMessagesRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListMessagesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Messages            []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextCursorCreatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_created_at,json=nextCursorCreatedAt,proto3" json:"next_cursor_created_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListMessagesResponse) Reset() {
+	*x = ListMessagesResponse{}
+	mi := &file_chat_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesResponse) ProtoMessage() {}
+
+func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListMessagesResponse) GetNextCursorCreatedAt() int64 {
+	if x != nil {
+		return x.NextCursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListMessagesResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+// Group
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // FileService 上传的图片 URL
+	MemberUids    []string               `protobuf:"bytes,3,rep,name=member_uids,json=memberUids,proto3" json:"member_uids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetMemberUids() []string {
+	if x != nil {
+		return x.MemberUids
+	}
+	return nil
+}
+
+type CreateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateGroupResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // 为空时不修改
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // 为空时不修改
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateGroupRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type ListGroupMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CursorJoinedAt int64                  `protobuf:"varint,2,opt,name=cursor_joined_at,json=cursorJoinedAt,proto3" json:"cursor_joined_at,omitempty"` // unix seconds
+	CursorId       string                 `protobuf:"bytes,3,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	mi := &file_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListGroupMembersRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ListGroupMembersRequest) GetCursorJoinedAt() int64 {
+	if x != nil {
+		return x.CursorJoinedAt
+	}
+	return 0
+}
+
+func (x *ListGroupMembersRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListGroupMembersResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Members            []*GroupMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextCursorJoinedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_joined_at,json=nextCursorJoinedAt,proto3" json:"next_cursor_joined_at,omitempty"`
+	NextCursorId       string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersResponse) Reset() {
+	*x = ListGroupMembersResponse{}
+	mi := &file_chat_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersResponse) ProtoMessage() {}
+
+func (x *ListGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListGroupMembersResponse) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListGroupMembersResponse) GetNextCursorJoinedAt() int64 {
+	if x != nil {
+		return x.NextCursorJoinedAt
+	}
+	return 0
+}
+
+func (x *ListGroupMembersResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+type InviteGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	MemberUids    []string               `protobuf:"bytes,2,rep,name=member_uids,json=memberUids,proto3" json:"member_uids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteGroupMembersRequest) Reset() {
+	*x = InviteGroupMembersRequest{}
+	mi := &file_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteGroupMembersRequest) ProtoMessage() {}
+
+func (x *InviteGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*InviteGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InviteGroupMembersRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *InviteGroupMembersRequest) GetMemberUids() []string {
+	if x != nil {
+		return x.MemberUids
+	}
+	return nil
+}
+
+type KickGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	MemberUid     string                 `protobuf:"bytes,2,opt,name=member_uid,json=memberUid,proto3" json:"member_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickGroupMemberRequest) Reset() {
+	*x = KickGroupMemberRequest{}
+	mi := &file_chat_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGroupMemberRequest) ProtoMessage() {}
+
+func (x *KickGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *KickGroupMemberRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *KickGroupMemberRequest) GetMemberUid() string {
+	if x != nil {
+		return x.MemberUid
+	}
+	return ""
+}
+
+type SetGroupMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	MemberUid     string                 `protobuf:"bytes,2,opt,name=member_uid,json=memberUid,proto3" json:"member_uid,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // OWNER/ADMIN/MEMBER
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupMemberRoleRequest) Reset() {
+	*x = SetGroupMemberRoleRequest{}
+	mi := &file_chat_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetGroupMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetGroupMemberRoleRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SetGroupMemberRoleRequest) GetMemberUid() string {
+	if x != nil {
+		return x.MemberUid
+	}
+	return ""
+}
+
+func (x *SetGroupMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type LeaveGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupRequest) Reset() {
+	*x = LeaveGroupRequest{}
+	mi := &file_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupRequest) ProtoMessage() {}
+
+func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LeaveGroupRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+// Read
+type MarkConversationReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	MessageUid    string                 `protobuf:"bytes,2,opt,name=message_uid,json=messageUid,proto3" json:"message_uid,omitempty"` // 为空时标记到最新消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkConversationReadRequest) Reset() {
+	*x = MarkConversationReadRequest{}
+	mi := &file_chat_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationReadRequest) ProtoMessage() {}
+
+func (x *MarkConversationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkConversationReadRequest) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MarkConversationReadRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *MarkConversationReadRequest) GetMessageUid() string {
+	if x != nil {
+		return x.MessageUid
+	}
+	return ""
+}
+
+type GetUnreadMessageCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadMessageCountResponse) Reset() {
+	*x = GetUnreadMessageCountResponse{}
+	mi := &file_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadMessageCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadMessageCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadMessageCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadMessageCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadMessageCountResponse) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUnreadMessageCountResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+var File_chat_proto protoreflect.FileDescriptor
+
+const file_chat_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"chat.proto\x12\x04chat\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\"k\n" +
+	"\rMessageSender\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
+	"\bnickname\x18\x02 \x01(\tB\x03\xe0A\x02R\bnickname\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\"\xbf\x02\n" +
+	"\aMessage\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12.\n" +
+	"\x10conversation_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\x0fconversationUid\x120\n" +
+	"\x06sender\x18\x03 \x01(\v2\x13.chat.MessageSenderB\x03\xe0A\x02R\x06sender\x12\x1d\n" +
+	"\acontent\x18\x04 \x01(\tB\x03\xe0A\x02R\acontent\x12\x1b\n" +
+	"\x06images\x18\x05 \x03(\tB\x03\xe0A\x02R\x06images\x12\"\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12\x17\n" +
+	"\x04type\x18\a \x01(\tB\x03\xe0A\x02R\x04type\x12#\n" +
+	"\rsystem_action\x18\b \x01(\tR\fsystemAction\x12\x1d\n" +
+	"\n" +
+	"target_uid\x18\t \x01(\tR\ttargetUid\"b\n" +
+	"\vReadReceipt\x121\n" +
+	"\x15last_read_message_uid\x18\x01 \x01(\tR\x12lastReadMessageUid\x12 \n" +
+	"\flast_read_at\x18\x02 \x01(\x03R\n" +
+	"lastReadAt\"\xe3\x03\n" +
+	"\fConversation\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tB\x03\xe0A\x02R\x04type\x12'\n" +
+	"\x04peer\x18\x03 \x01(\v2\x13.chat.MessageSenderR\x04peer\x120\n" +
+	"\flast_message\x18\x04 \x01(\v2\r.chat.MessageR\vlastMessage\x12&\n" +
+	"\funread_count\x18\x05 \x01(\x05B\x03\xe0A\x02R\vunreadCount\x12/\n" +
+	"\amy_read\x18\x06 \x01(\v2\x11.chat.ReadReceiptB\x03\xe0A\x02R\x06myRead\x12.\n" +
+	"\tpeer_read\x18\a \x01(\v2\x11.chat.ReadReceiptR\bpeerRead\x12\"\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03B\x03\xe0A\x02R\tupdatedAt\x12\"\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12\x12\n" +
+	"\x04name\x18\n" +
+	" \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\v \x01(\tR\tavatarUrl\x12&\n" +
+	"\fmember_count\x18\f \x01(\x05B\x03\xe0A\x02R\vmemberCount\x12\x1c\n" +
+	"\amy_role\x18\r \x01(\tB\x03\xe0A\x02R\x06myRole\"\xa4\x01\n" +
+	"\vGroupMember\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
+	"\bnickname\x18\x02 \x01(\tB\x03\xe0A\x02R\bnickname\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\x12\x17\n" +
+	"\x04role\x18\x04 \x01(\tB\x03\xe0A\x02R\x04role\x12 \n" +
+	"\tjoined_at\x18\x05 \x01(\x03B\x03\xe0A\x02R\bjoinedAt\"6\n" +
+	"\x0fMessageSettings\x12#\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\tB\x03\xe0A\x02R\n" +
+	"permission\"\x96\x01\n" +
+	"\x12SendMessageRequest\x12)\n" +
+	"\x10conversation_uid\x18\x01 \x01(\tR\x0fconversationUid\x12#\n" +
+	"\rrecipient_uid\x18\x02 \x01(\tR\frecipientUid\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
+	"\x06images\x18\x04 \x03(\tR\x06images\"C\n" +
+	"\x13SendMessageResponse\x12,\n" +
+	"\amessage\x18\x01 \x01(\v2\r.chat.MessageB\x03\xe0A\x02R\amessage\"c\n" +
+	"\x18ListConversationsRequest\x12*\n" +
+	"\x11cursor_updated_at\x18\x01 \x01(\x03R\x0fcursorUpdatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x02 \x01(\tR\bcursorId\"\xbf\x01\n" +
+	"\x19ListConversationsResponse\x12=\n" +
+	"\rconversations\x18\x01 \x03(\v2\x12.chat.ConversationB\x03\xe0A\x02R\rconversations\x128\n" +
+	"\x16next_cursor_updated_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorUpdatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"/\n" +
+	"\x16GetConversationRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"V\n" +
+	"\x17GetConversationResponse\x12;\n" +
+	"\fconversation\x18\x01 \x01(\v2\x12.chat.ConversationB\x03\xe0A\x02R\fconversation\"u\n" +
+	"\x13ListMessagesRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12*\n" +
+	"\x11cursor_created_at\x18\x02 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x03 \x01(\tR\bcursorId\"\xab\x01\n" +
+	"\x14ListMessagesResponse\x12.\n" +
+	"\bmessages\x18\x01 \x03(\v2\r.chat.MessageB\x03\xe0A\x02R\bmessages\x128\n" +
+	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"m\n" +
+	"\x12CreateGroupRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\x12\x1f\n" +
+	"\vmember_uids\x18\x03 \x03(\tR\n" +
+	"memberUids\"R\n" +
+	"\x13CreateGroupResponse\x12;\n" +
+	"\fconversation\x18\x01 \x01(\v2\x12.chat.ConversationB\x03\xe0A\x02R\fconversation\"^\n" +
+	"\x12UpdateGroupRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\"w\n" +
+	"\x17ListGroupMembersRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12(\n" +
+	"\x10cursor_joined_at\x18\x02 \x01(\x03R\x0ecursorJoinedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x03 \x01(\tR\bcursorId\"\xaf\x01\n" +
+	"\x18ListGroupMembersResponse\x120\n" +
+	"\amembers\x18\x01 \x03(\v2\x11.chat.GroupMemberB\x03\xe0A\x02R\amembers\x126\n" +
+	"\x15next_cursor_joined_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x12nextCursorJoinedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"X\n" +
+	"\x19InviteGroupMembersRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12$\n" +
+	"\vmember_uids\x18\x02 \x03(\tB\x03\xe0A\x02R\n" +
+	"memberUids\"S\n" +
+	"\x16KickGroupMemberRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\"\n" +
+	"\n" +
+	"member_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\tmemberUid\"o\n" +
+	"\x19SetGroupMemberRoleRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\"\n" +
+	"\n" +
+	"member_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\tmemberUid\x12\x17\n" +
+	"\x04role\x18\x03 \x01(\tB\x03\xe0A\x02R\x04role\"*\n" +
+	"\x11LeaveGroupRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"U\n" +
+	"\x1bMarkConversationReadRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
+	"\vmessage_uid\x18\x02 \x01(\tR\n" +
+	"messageUid\"G\n" +
+	"\x1dGetUnreadMessageCountResponse\x12&\n" +
+	"\funread_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\vunreadCount2\xcc\r\n" +
+	"\x0eMessageService\x12_\n" +
+	"\vSendMessage\x12\x18.chat.SendMessageRequest\x1a\x19.chat.SendMessageResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/messages\x12v\n" +
+	"\x11ListConversations\x12\x1e.chat.ListConversationsRequest\x1a\x1f.chat.ListConversationsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/me/conversations\x12s\n" +
+	"\x0fGetConversation\x12\x1c.chat.GetConversationRequest\x1a\x1d.chat.GetConversationResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/conversations/{uid}\x12s\n" +
+	"\fListMessages\x12\x19.chat.ListMessagesRequest\x1a\x1a.chat.ListMessagesResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/conversations/{uid}/messages\x12~\n" +
+	"\x14MarkConversationRead\x12!.chat.MarkConversationReadRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/conversations/{uid}/read\x12]\n" +
+	"\vCreateGroup\x12\x18.chat.CreateGroupRequest\x1a\x19.chat.CreateGroupResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/groups\x12`\n" +
+	"\vUpdateGroup\x12\x18.chat.UpdateGroupRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*2\x14/api/v1/groups/{uid}\x12w\n" +
+	"\x10ListGroupMembers\x12\x1d.chat.ListGroupMembersRequest\x1a\x1e.chat.ListGroupMembersResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/groups/{uid}/members\x12v\n" +
+	"\x12InviteGroupMembers\x12\x1f.chat.InviteGroupMembersRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/groups/{uid}/members\x12z\n" +
+	"\x0fKickGroupMember\x12\x1c.chat.KickGroupMemberRequest\x1a\x16.google.protobuf.Empty\"1\x82\xd3\xe4\x93\x02+*)/api/v1/groups/{uid}/members/{member_uid}\x12\x88\x01\n" +
+	"\x12SetGroupMemberRole\x12\x1f.chat.SetGroupMemberRoleRequest\x1a\x16.google.protobuf.Empty\"9\x82\xd3\xe4\x93\x023:\x01*\x1a./api/v1/groups/{uid}/members/{member_uid}/role\x12d\n" +
+	"\n" +
+	"LeaveGroup\x12\x17.chat.LeaveGroupRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/groups/{uid}/leave\x12~\n" +
+	"\x15GetUnreadMessageCount\x12\x16.google.protobuf.Empty\x1a#.chat.GetUnreadMessageCountResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/me/messages/unread-count\x12h\n" +
+	"\x12GetMessageSettings\x12\x16.google.protobuf.Empty\x1a\x15.chat.MessageSettings\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/me/message-settings\x12n\n" +
+	"\x15UpdateMessageSettings\x12\x15.chat.MessageSettings\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/api/v1/me/message-settingsB\x0fZ\raeibi/api;apib\x06proto3"
+
+var (
+	file_chat_proto_rawDescOnce sync.Once
+	file_chat_proto_rawDescData []byte
+)
+
+func file_chat_proto_rawDescGZIP() []byte {
+	file_chat_proto_rawDescOnce.Do(func() {
+		file_chat_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_chat_proto_rawDesc), len(file_chat_proto_rawDesc)))
+	})
+	return file_chat_proto_rawDescData
+}
+
+var file_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_chat_proto_goTypes = []any{
+	(*MessageSender)(nil),                 // 0: chat.MessageSender
+	(*Message)(nil),                       // 1: chat.Message
+	(*ReadReceipt)(nil),                   // 2: chat.ReadReceipt
+	(*Conversation)(nil),                  // 3: chat.Conversation
+	(*GroupMember)(nil),                   // 4: chat.GroupMember
+	(*MessageSettings)(nil),               // 5: chat.MessageSettings
+	(*SendMessageRequest)(nil),            // 6: chat.SendMessageRequest
+	(*SendMessageResponse)(nil),           // 7: chat.SendMessageResponse
+	(*ListConversationsRequest)(nil),      // 8: chat.ListConversationsRequest
+	(*ListConversationsResponse)(nil),     // 9: chat.ListConversationsResponse
+	(*GetConversationRequest)(nil),        // 10: chat.GetConversationRequest
+	(*GetConversationResponse)(nil),       // 11: chat.GetConversationResponse
+	(*ListMessagesRequest)(nil),           // 12: chat.ListMessagesRequest
+	(*ListMessagesResponse)(nil),          // 13: chat.ListMessagesResponse
+	(*CreateGroupRequest)(nil),            // 14: chat.CreateGroupRequest
+	(*CreateGroupResponse)(nil),           // 15: chat.CreateGroupResponse
+	(*UpdateGroupRequest)(nil),            // 16: chat.UpdateGroupRequest
+	(*ListGroupMembersRequest)(nil),       // 17: chat.ListGroupMembersRequest
+	(*ListGroupMembersResponse)(nil),      // 18: chat.ListGroupMembersResponse
+	(*InviteGroupMembersRequest)(nil),     // 19: chat.InviteGroupMembersRequest
+	(*KickGroupMemberRequest)(nil),        // 20: chat.KickGroupMemberRequest
+	(*SetGroupMemberRoleRequest)(nil),     // 21: chat.SetGroupMemberRoleRequest
+	(*LeaveGroupRequest)(nil),             // 22: chat.LeaveGroupRequest
+	(*MarkConversationReadRequest)(nil),   // 23: chat.MarkConversationReadRequest
+	(*GetUnreadMessageCountResponse)(nil), // 24: chat.GetUnreadMessageCountResponse
+	(*emptypb.Empty)(nil),                 // 25: google.protobuf.Empty
+}
+var file_chat_proto_depIdxs = []int32{
+	0,  // 0: chat.Message.sender:type_name -> chat.MessageSender
+	0,  // 1: chat.Conversation.peer:type_name -> chat.MessageSender
+	1,  // 2: chat.Conversation.last_message:type_name -> chat.Message
+	2,  // 3: chat.Conversation.my_read:type_name -> chat.ReadReceipt
+	2,  // 4: chat.Conversation.peer_read:type_name -> chat.ReadReceipt
+	1,  // 5: chat.SendMessageResponse.message:type_name -> chat.Message
+	3,  // 6: chat.ListConversationsResponse.conversations:type_name -> chat.Conversation
+	3,  // 7: chat.GetConversationResponse.conversation:type_name -> chat.Conversation
+	1,  // 8: chat.ListMessagesResponse.messages:type_name -> chat.Message
+	3,  // 9: chat.CreateGroupResponse.conversation:type_name -> chat.Conversation
+	4,  // 10: chat.ListGroupMembersResponse.members:type_name -> chat.GroupMember
+	6,  // 11: chat.MessageService.SendMessage:input_type -> chat.SendMessageRequest
+	8,  // 12: chat.MessageService.ListConversations:input_type -> chat.ListConversationsRequest
+	10, // 13: chat.MessageService.GetConversation:input_type -> chat.GetConversationRequest
+	12, // 14: chat.MessageService.ListMessages:input_type -> chat.ListMessagesRequest
+	23, // 15: chat.MessageService.MarkConversationRead:input_type -> chat.MarkConversationReadRequest
+	14, // 16: chat.MessageService.CreateGroup:input_type -> chat.CreateGroupRequest
+	16, // 17: chat.MessageService.UpdateGroup:input_type -> chat.UpdateGroupRequest
+	17, // 18: chat.MessageService.ListGroupMembers:input_type -> chat.ListGroupMembersRequest
+	19, // 19: chat.MessageService.InviteGroupMembers:input_type -> chat.InviteGroupMembersRequest
+	20, // 20: chat.MessageService.KickGroupMember:input_type -> chat.KickGroupMemberRequest
+	21, // 21: chat.MessageService.SetGroupMemberRole:input_type -> chat.SetGroupMemberRoleRequest
+	22, // 22: chat.MessageService.LeaveGroup:input_type -> chat.LeaveGroupRequest
+	25, // 23: chat.MessageService.GetUnreadMessageCount:input_type -> google.protobuf.Empty
+	25, // 24: chat.MessageService.GetMessageSettings:input_type -> google.protobuf.Empty
+	5,  // 25: chat.MessageService.UpdateMessageSettings:input_type -> chat.MessageSettings
+	7,  // 26: chat.MessageService.SendMessage:output_type -> chat.SendMessageResponse
+	9,  // 27: chat.MessageService.ListConversations:output_type -> chat.ListConversationsResponse
+	11, // 28: chat.MessageService.GetConversation:output_type -> chat.GetConversationResponse
+	13, // 29: chat.MessageService.ListMessages:output_type -> chat.ListMessagesResponse
+	25, // 30: chat.MessageService.MarkConversationRead:output_type -> google.protobuf.Empty
+	15, // 31: chat.MessageService.CreateGroup:output_type -> chat.CreateGroupResponse
+	25, // 32: chat.MessageService.UpdateGroup:output_type -> google.protobuf.Empty
+	18, // 33: chat.MessageService.ListGroupMembers:output_type -> chat.ListGroupMembersResponse
+	25, // 34: chat.MessageService.InviteGroupMembers:output_type -> google.protobuf.Empty
+	25, // 35: chat.MessageService.KickGroupMember:output_type -> google.protobuf.Empty
+	25, // 36: chat.MessageService.SetGroupMemberRole:output_type -> google.protobuf.Empty
+	25, // 37: chat.MessageService.LeaveGroup:output_type -> google.protobuf.Empty
+	24, // 38: chat.MessageService.GetUnreadMessageCount:output_type -> chat.GetUnreadMessageCountResponse
+	5,  // 39: chat.MessageService.GetMessageSettings:output_type -> chat.MessageSettings
+	25, // 40: chat.MessageService.UpdateMessageSettings:output_type -> google.protobuf.Empty
+	26, // [26:41] is the sub-list for method output_type
+	11, // [11:26] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_chat_proto_init() }
+func file_chat_proto_init() {
+	if File_chat_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chat_proto_rawDesc), len(file_chat_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_chat_proto_goTypes,
+		DependencyIndexes: file_chat_proto_depIdxs,
+		MessageInfos:      file_chat_proto_msgTypes,
+	}.Build()
+	File_chat_proto = out.File
+	file_chat_proto_goTypes = nil
+	file_chat_proto_depIdxs = nil
+}
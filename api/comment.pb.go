@@ -99,6 +99,7 @@ type Comment struct {
 	UpdatedAt        int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	LikeCount        int32                  `protobuf:"varint,12,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
 	Liked            bool                   `protobuf:"varint,13,opt,name=liked,proto3" json:"liked,omitempty"`
+	Entities         []*TextEntity          `protobuf:"bytes,14,rep,name=entities,proto3" json:"entities,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -224,6 +225,13 @@ func (x *Comment) GetLiked() bool {
 	return false
 }
 
+func (x *Comment) GetEntities() []*TextEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
 type CreateTopCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostUid       string                 `protobuf:"bytes,1,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
@@ -821,7 +829,7 @@ const file_comment_proto_rawDesc = "" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
 	"\bnickname\x18\x02 \x01(\tB\x03\xe0A\x02R\bnickname\x12\"\n" +
 	"\n" +
-	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\"\xcc\x03\n" +
+	"avatar_url\x18\x03 \x01(\tB\x03\xe0A\x02R\tavatarUrl\"\x81\x04\n" +
 	"\aComment\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x123\n" +
 	"\x06author\x18\x02 \x01(\v2\x16.comment.CommentAuthorB\x03\xe0A\x02R\x06author\x12\x1e\n" +
@@ -841,7 +849,8 @@ const file_comment_proto_rawDesc = "" +
 	"updated_at\x18\v \x01(\x03B\x03\xe0A\x02R\tupdatedAt\x12\"\n" +
 	"\n" +
 	"like_count\x18\f \x01(\x05B\x03\xe0A\x02R\tlikeCount\x12\x19\n" +
-	"\x05liked\x18\r \x01(\bB\x03\xe0A\x02R\x05liked\"p\n" +
+	"\x05liked\x18\r \x01(\bB\x03\xe0A\x02R\x05liked\x123\n" +
+	"\bentities\x18\x0e \x03(\v2\x12.common.TextEntityB\x03\xe0A\x02R\bentities\"p\n" +
 	"\x17CreateTopCommentRequest\x12\x1e\n" +
 	"\bpost_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\apostUid\x12\x1d\n" +
 	"\acontent\x18\x02 \x01(\tB\x03\xe0A\x02R\acontent\x12\x16\n" +
@@ -914,31 +923,33 @@ var file_comment_proto_goTypes = []any{
 	(*DeleteCommentRequest)(nil),     // 10: comment.DeleteCommentRequest
 	(*LikeCommentRequest)(nil),       // 11: comment.LikeCommentRequest
 	(*LikeCommentResponse)(nil),      // 12: comment.LikeCommentResponse
-	(ToggleAction)(0),                // 13: common.ToggleAction
-	(*emptypb.Empty)(nil),            // 14: google.protobuf.Empty
+	(*TextEntity)(nil),               // 13: common.TextEntity
+	(ToggleAction)(0),                // 14: common.ToggleAction
+	(*emptypb.Empty)(nil),            // 15: google.protobuf.Empty
 }
 var file_comment_proto_depIdxs = []int32{
 	0,  // 0: comment.Comment.author:type_name -> comment.CommentAuthor
-	1,  // 1: comment.ListTopCommentsResponse.comments:type_name -> comment.Comment
-	1,  // 2: comment.ListRepliesResponse.comments:type_name -> comment.Comment
-	13, // 3: comment.LikeCommentRequest.action:type_name -> common.ToggleAction
-	2,  // 4: comment.CommentService.CreateTopComment:input_type -> comment.CreateTopCommentRequest
-	4,  // 5: comment.CommentService.CreateReply:input_type -> comment.CreateReplyRequest
-	6,  // 6: comment.CommentService.ListTopComments:input_type -> comment.ListTopCommentsRequest
-	8,  // 7: comment.CommentService.ListReplies:input_type -> comment.ListRepliesRequest
-	10, // 8: comment.CommentService.DeleteComment:input_type -> comment.DeleteCommentRequest
-	11, // 9: comment.CommentService.LikeComment:input_type -> comment.LikeCommentRequest
-	3,  // 10: comment.CommentService.CreateTopComment:output_type -> comment.CreateTopCommentResponse
-	5,  // 11: comment.CommentService.CreateReply:output_type -> comment.CreateReplyResponse
-	7,  // 12: comment.CommentService.ListTopComments:output_type -> comment.ListTopCommentsResponse
-	9,  // 13: comment.CommentService.ListReplies:output_type -> comment.ListRepliesResponse
-	14, // 14: comment.CommentService.DeleteComment:output_type -> google.protobuf.Empty
-	12, // 15: comment.CommentService.LikeComment:output_type -> comment.LikeCommentResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	13, // 1: comment.Comment.entities:type_name -> common.TextEntity
+	1,  // 2: comment.ListTopCommentsResponse.comments:type_name -> comment.Comment
+	1,  // 3: comment.ListRepliesResponse.comments:type_name -> comment.Comment
+	14, // 4: comment.LikeCommentRequest.action:type_name -> common.ToggleAction
+	2,  // 5: comment.CommentService.CreateTopComment:input_type -> comment.CreateTopCommentRequest
+	4,  // 6: comment.CommentService.CreateReply:input_type -> comment.CreateReplyRequest
+	6,  // 7: comment.CommentService.ListTopComments:input_type -> comment.ListTopCommentsRequest
+	8,  // 8: comment.CommentService.ListReplies:input_type -> comment.ListRepliesRequest
+	10, // 9: comment.CommentService.DeleteComment:input_type -> comment.DeleteCommentRequest
+	11, // 10: comment.CommentService.LikeComment:input_type -> comment.LikeCommentRequest
+	3,  // 11: comment.CommentService.CreateTopComment:output_type -> comment.CreateTopCommentResponse
+	5,  // 12: comment.CommentService.CreateReply:output_type -> comment.CreateReplyResponse
+	7,  // 13: comment.CommentService.ListTopComments:output_type -> comment.ListTopCommentsResponse
+	9,  // 14: comment.CommentService.ListReplies:output_type -> comment.ListRepliesResponse
+	15, // 15: comment.CommentService.DeleteComment:output_type -> google.protobuf.Empty
+	12, // 16: comment.CommentService.LikeComment:output_type -> comment.LikeCommentResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_comment_proto_init() }
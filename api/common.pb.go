@@ -82,6 +82,7 @@ type User struct {
 	FollowersCount int32                  `protobuf:"varint,7,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
 	FollowingCount int32                  `protobuf:"varint,8,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
 	IsFollowing    bool                   `protobuf:"varint,9,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	IsPrivate      bool                   `protobuf:"varint,10,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -179,11 +180,95 @@ func (x *User) GetIsFollowing() bool {
 	return false
 }
 
+func (x *User) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+// TextEntity 正文中的可点击片段（@提及、#话题），start/end 为 Unicode 码点偏移，end 不含
+type TextEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // MENTION/HASHTAG
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"` // 不含前缀符号
+	Uid           string                 `protobuf:"bytes,5,opt,name=uid,proto3" json:"uid,omitempty"`   // MENTION：被提及用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextEntity) Reset() {
+	*x = TextEntity{}
+	mi := &file_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEntity) ProtoMessage() {}
+
+func (x *TextEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEntity.ProtoReflect.Descriptor instead.
+func (*TextEntity) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TextEntity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TextEntity) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TextEntity) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *TextEntity) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TextEntity) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
 var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\x06common\x1a\x1fgoogle/api/field_behavior.proto\"\xac\x02\n" +
+	"\fcommon.proto\x12\x06common\x1a\x1fgoogle/api/field_behavior.proto\"\xcb\x02\n" +
 	"\x04User\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x17\n" +
@@ -194,7 +279,17 @@ const file_common_proto_rawDesc = "" +
 	"avatar_url\x18\x06 \x01(\tB\x03\xe0A\x02R\tavatarUrl\x12,\n" +
 	"\x0ffollowers_count\x18\a \x01(\x05B\x03\xe0A\x02R\x0efollowersCount\x12,\n" +
 	"\x0ffollowing_count\x18\b \x01(\x05B\x03\xe0A\x02R\x0efollowingCount\x12!\n" +
-	"\fis_following\x18\t \x01(\bR\visFollowing*?\n" +
+	"\fis_following\x18\t \x01(\bR\visFollowing\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\n" +
+	" \x01(\bR\tisPrivate\"\x82\x01\n" +
+	"\n" +
+	"TextEntity\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tB\x03\xe0A\x02R\x04type\x12\x19\n" +
+	"\x05start\x18\x02 \x01(\x05B\x03\xe0A\x02R\x05start\x12\x15\n" +
+	"\x03end\x18\x03 \x01(\x05B\x03\xe0A\x02R\x03end\x12\x17\n" +
+	"\x04text\x18\x04 \x01(\tB\x03\xe0A\x02R\x04text\x12\x10\n" +
+	"\x03uid\x18\x05 \x01(\tR\x03uid*?\n" +
 	"\fToggleAction\x12\x15\n" +
 	"\x11TOGGLE_ACTION_ADD\x10\x00\x12\x18\n" +
 	"\x14TOGGLE_ACTION_REMOVE\x10\x01B\x0fZ\raeibi/api;apib\x06proto3"
@@ -212,10 +307,11 @@ func file_common_proto_rawDescGZIP() []byte {
 }
 
 var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_common_proto_goTypes = []any{
-	(ToggleAction)(0), // 0: common.ToggleAction
-	(*User)(nil),      // 1: common.User
+	(ToggleAction)(0),  // 0: common.ToggleAction
+	(*User)(nil),       // 1: common.User
+	(*TextEntity)(nil), // 2: common.TextEntity
 }
 var file_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -236,7 +332,7 @@ func file_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
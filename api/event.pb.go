@@ -0,0 +1,560 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: event.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Models
+type NotificationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // POST_LIKE/COMMENT_LIKE/COMMENT/REPLY/FOLLOW/FOLLOW_REQUEST
+	ActorUid      string                 `protobuf:"bytes,2,opt,name=actor_uid,json=actorUid,proto3" json:"actor_uid,omitempty"`
+	TargetUid     string                 `protobuf:"bytes,3,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"`
+	PostUid       string                 `protobuf:"bytes,4,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationEvent) Reset() {
+	*x = NotificationEvent{}
+	mi := &file_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent) ProtoMessage() {}
+
+func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetActorUid() string {
+	if x != nil {
+		return x.ActorUid
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetTargetUid() string {
+	if x != nil {
+		return x.TargetUid
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+type CommentEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PostUid       string                 `protobuf:"bytes,2,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"`
+	RootUid       string                 `protobuf:"bytes,3,opt,name=root_uid,json=rootUid,proto3" json:"root_uid,omitempty"`
+	ParentUid     string                 `protobuf:"bytes,4,opt,name=parent_uid,json=parentUid,proto3" json:"parent_uid,omitempty"`
+	AuthorUid     string                 `protobuf:"bytes,5,opt,name=author_uid,json=authorUid,proto3" json:"author_uid,omitempty"`
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentEvent) Reset() {
+	*x = CommentEvent{}
+	mi := &file_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentEvent) ProtoMessage() {}
+
+func (x *CommentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentEvent.ProtoReflect.Descriptor instead.
+func (*CommentEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CommentEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CommentEvent) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+func (x *CommentEvent) GetRootUid() string {
+	if x != nil {
+		return x.RootUid
+	}
+	return ""
+}
+
+func (x *CommentEvent) GetParentUid() string {
+	if x != nil {
+		return x.ParentUid
+	}
+	return ""
+}
+
+func (x *CommentEvent) GetAuthorUid() string {
+	if x != nil {
+		return x.AuthorUid
+	}
+	return ""
+}
+
+func (x *CommentEvent) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CounterEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // POST/COMMENT
+	TargetUid     string                 `protobuf:"bytes,2,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"`
+	Counter       string                 `protobuf:"bytes,3,opt,name=counter,proto3" json:"counter,omitempty"` // LIKE/COLLECTION/COMMENT/REPLY/REPOST
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CounterEvent) Reset() {
+	*x = CounterEvent{}
+	mi := &file_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterEvent) ProtoMessage() {}
+
+func (x *CounterEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterEvent.ProtoReflect.Descriptor instead.
+func (*CounterEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CounterEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *CounterEvent) GetTargetUid() string {
+	if x != nil {
+		return x.TargetUid
+	}
+	return ""
+}
+
+func (x *CounterEvent) GetCounter() string {
+	if x != nil {
+		return x.Counter
+	}
+	return ""
+}
+
+func (x *CounterEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MessageReadEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ConversationUid    string                 `protobuf:"bytes,1,opt,name=conversation_uid,json=conversationUid,proto3" json:"conversation_uid,omitempty"`
+	ReaderUid          string                 `protobuf:"bytes,2,opt,name=reader_uid,json=readerUid,proto3" json:"reader_uid,omitempty"`
+	LastReadMessageUid string                 `protobuf:"bytes,3,opt,name=last_read_message_uid,json=lastReadMessageUid,proto3" json:"last_read_message_uid,omitempty"`
+	LastReadAt         int64                  `protobuf:"varint,4,opt,name=last_read_at,json=lastReadAt,proto3" json:"last_read_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MessageReadEvent) Reset() {
+	*x = MessageReadEvent{}
+	mi := &file_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReadEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReadEvent) ProtoMessage() {}
+
+func (x *MessageReadEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReadEvent.ProtoReflect.Descriptor instead.
+func (*MessageReadEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MessageReadEvent) GetConversationUid() string {
+	if x != nil {
+		return x.ConversationUid
+	}
+	return ""
+}
+
+func (x *MessageReadEvent) GetReaderUid() string {
+	if x != nil {
+		return x.ReaderUid
+	}
+	return ""
+}
+
+func (x *MessageReadEvent) GetLastReadMessageUid() string {
+	if x != nil {
+		return x.LastReadMessageUid
+	}
+	return ""
+}
+
+func (x *MessageReadEvent) GetLastReadAt() int64 {
+	if x != nil {
+		return x.LastReadAt
+	}
+	return 0
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // NOTIFICATION/COMMENT/COUNTER/MESSAGE/MESSAGE_READ/HEARTBEAT
+	Notification  *NotificationEvent     `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+	Comment       *CommentEvent          `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Counter       *CounterEvent          `protobuf:"bytes,4,opt,name=counter,proto3" json:"counter,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Message       *Message               `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	MessageRead   *MessageReadEvent      `protobuf:"bytes,7,opt,name=message_read,json=messageRead,proto3" json:"message_read,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetNotification() *NotificationEvent {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *Event) GetComment() *CommentEvent {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *Event) GetCounter() *CounterEvent {
+	if x != nil {
+		return x.Counter
+	}
+	return nil
+}
+
+func (x *Event) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Event) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Event) GetMessageRead() *MessageReadEvent {
+	if x != nil {
+		return x.MessageRead
+	}
+	return nil
+}
+
+// Stream
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostUid       string                 `protobuf:"bytes,1,opt,name=post_uid,json=postUid,proto3" json:"post_uid,omitempty"` // 正在浏览的帖子，订阅其评论与计数变化
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamEventsRequest) GetPostUid() string {
+	if x != nil {
+		return x.PostUid
+	}
+	return ""
+}
+
+var File_event_proto protoreflect.FileDescriptor
+
+const file_event_proto_rawDesc = "" +
+	"\n" +
+	"\vevent.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\n" +
+	"chat.proto\"\x8d\x01\n" +
+	"\x11NotificationEvent\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tB\x03\xe0A\x02R\x04type\x12 \n" +
+	"\tactor_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\bactorUid\x12\"\n" +
+	"\n" +
+	"target_uid\x18\x03 \x01(\tB\x03\xe0A\x02R\ttargetUid\x12\x19\n" +
+	"\bpost_uid\x18\x04 \x01(\tR\apostUid\"\xc7\x01\n" +
+	"\fCommentEvent\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1e\n" +
+	"\bpost_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\apostUid\x12\x1e\n" +
+	"\broot_uid\x18\x03 \x01(\tB\x03\xe0A\x02R\arootUid\x12\x1d\n" +
+	"\n" +
+	"parent_uid\x18\x04 \x01(\tR\tparentUid\x12\"\n" +
+	"\n" +
+	"author_uid\x18\x05 \x01(\tB\x03\xe0A\x02R\tauthorUid\x12\x1d\n" +
+	"\acontent\x18\x06 \x01(\tB\x03\xe0A\x02R\acontent\"\x92\x01\n" +
+	"\fCounterEvent\x12$\n" +
+	"\vtarget_type\x18\x01 \x01(\tB\x03\xe0A\x02R\n" +
+	"targetType\x12\"\n" +
+	"\n" +
+	"target_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\ttargetUid\x12\x1d\n" +
+	"\acounter\x18\x03 \x01(\tB\x03\xe0A\x02R\acounter\x12\x19\n" +
+	"\x05count\x18\x04 \x01(\x05B\x03\xe0A\x02R\x05count\"\xc5\x01\n" +
+	"\x10MessageReadEvent\x12.\n" +
+	"\x10conversation_uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x0fconversationUid\x12\"\n" +
+	"\n" +
+	"reader_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\treaderUid\x126\n" +
+	"\x15last_read_message_uid\x18\x03 \x01(\tB\x03\xe0A\x02R\x12lastReadMessageUid\x12%\n" +
+	"\flast_read_at\x18\x04 \x01(\x03B\x03\xe0A\x02R\n" +
+	"lastReadAt\"\xc5\x02\n" +
+	"\x05Event\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tB\x03\xe0A\x02R\x04type\x12<\n" +
+	"\fnotification\x18\x02 \x01(\v2\x18.event.NotificationEventR\fnotification\x12-\n" +
+	"\acomment\x18\x03 \x01(\v2\x13.event.CommentEventR\acomment\x12-\n" +
+	"\acounter\x18\x04 \x01(\v2\x13.event.CounterEventR\acounter\x12\"\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12'\n" +
+	"\amessage\x18\x06 \x01(\v2\r.chat.MessageR\amessage\x12:\n" +
+	"\fmessage_read\x18\a \x01(\v2\x17.event.MessageReadEventR\vmessageRead\"0\n" +
+	"\x13StreamEventsRequest\x12\x19\n" +
+	"\bpost_uid\x18\x01 \x01(\tR\apostUid2b\n" +
+	"\fEventService\x12R\n" +
+	"\fStreamEvents\x12\x1a.event.StreamEventsRequest\x1a\f.event.Event\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/events0\x01B\x0fZ\raeibi/api;apib\x06proto3"
+
+var (
+	file_event_proto_rawDescOnce sync.Once
+	file_event_proto_rawDescData []byte
+)
+
+func file_event_proto_rawDescGZIP() []byte {
+	file_event_proto_rawDescOnce.Do(func() {
+		file_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_event_proto_rawDesc), len(file_event_proto_rawDesc)))
+	})
+	return file_event_proto_rawDescData
+}
+
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_event_proto_goTypes = []any{
+	(*NotificationEvent)(nil),   // 0: event.NotificationEvent
+	(*CommentEvent)(nil),        // 1: event.CommentEvent
+	(*CounterEvent)(nil),        // 2: event.CounterEvent
+	(*MessageReadEvent)(nil),    // 3: event.MessageReadEvent
+	(*Event)(nil),               // 4: event.Event
+	(*StreamEventsRequest)(nil), // 5: event.StreamEventsRequest
+	(*Message)(nil),             // 6: chat.Message
+}
+var file_event_proto_depIdxs = []int32{
+	0, // 0: event.Event.notification:type_name -> event.NotificationEvent
+	1, // 1: event.Event.comment:type_name -> event.CommentEvent
+	2, // 2: event.Event.counter:type_name -> event.CounterEvent
+	6, // 3: event.Event.message:type_name -> chat.Message
+	3, // 4: event.Event.message_read:type_name -> event.MessageReadEvent
+	5, // 5: event.EventService.StreamEvents:input_type -> event.StreamEventsRequest
+	4, // 6: event.EventService.StreamEvents:output_type -> event.Event
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_event_proto_init() }
+func file_event_proto_init() {
+	if File_event_proto != nil {
+		return
+	}
+	file_chat_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_proto_rawDesc), len(file_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_event_proto_goTypes,
+		DependencyIndexes: file_event_proto_depIdxs,
+		MessageInfos:      file_event_proto_msgTypes,
+	}.Build()
+	File_event_proto = out.File
+	file_event_proto_goTypes = nil
+	file_event_proto_depIdxs = nil
+}
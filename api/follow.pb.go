@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -79,6 +80,7 @@ type FollowResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	FollowingCount int32                  `protobuf:"varint,1,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
 	FollowersCount int32                  `protobuf:"varint,2,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
+	Requested      bool                   `protobuf:"varint,3,opt,name=requested,proto3" json:"requested,omitempty"` // 对方为私密账号，已发出关注请求等待通过
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -127,6 +129,13 @@ func (x *FollowResponse) GetFollowersCount() int32 {
 	return 0
 }
 
+func (x *FollowResponse) GetRequested() bool {
+	if x != nil {
+		return x.Requested
+	}
+	return false
+}
+
 // List
 type ListMyFollowersRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -352,17 +361,331 @@ func (x *ListMyFollowingResponse) GetNextCursorId() string {
 	return ""
 }
 
+// Follow requests
+type ListIncomingFollowRequestsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CursorCreatedAt int64                  `protobuf:"varint,1,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,2,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListIncomingFollowRequestsRequest) Reset() {
+	*x = ListIncomingFollowRequestsRequest{}
+	mi := &file_follow_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomingFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomingFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListIncomingFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncomingFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncomingFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListIncomingFollowRequestsRequest) GetCursorCreatedAt() int64 {
+	if x != nil {
+		return x.CursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListIncomingFollowRequestsRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListIncomingFollowRequestsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Users               []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursorCreatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_created_at,json=nextCursorCreatedAt,proto3" json:"next_cursor_created_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListIncomingFollowRequestsResponse) Reset() {
+	*x = ListIncomingFollowRequestsResponse{}
+	mi := &file_follow_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomingFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomingFollowRequestsResponse) ProtoMessage() {}
+
+func (x *ListIncomingFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncomingFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncomingFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListIncomingFollowRequestsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListIncomingFollowRequestsResponse) GetNextCursorCreatedAt() int64 {
+	if x != nil {
+		return x.NextCursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListIncomingFollowRequestsResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+type ListOutgoingFollowRequestsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CursorCreatedAt int64                  `protobuf:"varint,1,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,2,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListOutgoingFollowRequestsRequest) Reset() {
+	*x = ListOutgoingFollowRequestsRequest{}
+	mi := &file_follow_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutgoingFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListOutgoingFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListOutgoingFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListOutgoingFollowRequestsRequest) GetCursorCreatedAt() int64 {
+	if x != nil {
+		return x.CursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListOutgoingFollowRequestsRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListOutgoingFollowRequestsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Users               []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursorCreatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_created_at,json=nextCursorCreatedAt,proto3" json:"next_cursor_created_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListOutgoingFollowRequestsResponse) Reset() {
+	*x = ListOutgoingFollowRequestsResponse{}
+	mi := &file_follow_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutgoingFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingFollowRequestsResponse) ProtoMessage() {}
+
+func (x *ListOutgoingFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListOutgoingFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListOutgoingFollowRequestsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListOutgoingFollowRequestsResponse) GetNextCursorCreatedAt() int64 {
+	if x != nil {
+		return x.NextCursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListOutgoingFollowRequestsResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 请求者
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	mi := &file_follow_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
+
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ApproveFollowRequestRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // 请求者
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	mi := &file_follow_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestRequest) ProtoMessage() {}
+
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RejectFollowRequestRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
 var File_follow_proto protoreflect.FileDescriptor
 
 const file_follow_proto_rawDesc = "" +
 	"\n" +
-	"\ffollow.proto\x12\x06follow\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\fcommon.proto\"T\n" +
+	"\ffollow.proto\x12\x06follow\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\"T\n" +
 	"\rFollowRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
-	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"l\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"\x8a\x01\n" +
 	"\x0eFollowResponse\x12,\n" +
 	"\x0ffollowing_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0efollowingCount\x12,\n" +
-	"\x0ffollowers_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\x0efollowersCount\"a\n" +
+	"\x0ffollowers_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\x0efollowersCount\x12\x1c\n" +
+	"\trequested\x18\x03 \x01(\bR\trequested\"a\n" +
 	"\x16ListMyFollowersRequest\x12*\n" +
 	"\x11cursor_created_at\x18\x01 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
 	"\tcursor_id\x18\x02 \x01(\tR\bcursorId\"\xa7\x01\n" +
@@ -376,11 +699,33 @@ const file_follow_proto_rawDesc = "" +
 	"\x17ListMyFollowingResponse\x12'\n" +
 	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x128\n" +
 	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
-	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId2\xd3\x02\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"l\n" +
+	"!ListIncomingFollowRequestsRequest\x12*\n" +
+	"\x11cursor_created_at\x18\x01 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x02 \x01(\tR\bcursorId\"\xb2\x01\n" +
+	"\"ListIncomingFollowRequestsResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x128\n" +
+	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"l\n" +
+	"!ListOutgoingFollowRequestsRequest\x12*\n" +
+	"\x11cursor_created_at\x18\x01 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x02 \x01(\tR\bcursorId\"\xb2\x01\n" +
+	"\"ListOutgoingFollowRequestsResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x128\n" +
+	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"4\n" +
+	"\x1bApproveFollowRequestRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"3\n" +
+	"\x1aRejectFollowRequestRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid2\xa3\a\n" +
 	"\rFollowService\x12^\n" +
 	"\x06Follow\x12\x15.follow.FollowRequest\x1a\x16.follow.FollowResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/users/{uid}/follow\x12p\n" +
 	"\x0fListMyFollowers\x12\x1e.follow.ListMyFollowersRequest\x1a\x1f.follow.ListMyFollowersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/me/followers\x12p\n" +
-	"\x0fListMyFollowing\x12\x1e.follow.ListMyFollowingRequest\x1a\x1f.follow.ListMyFollowingResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/me/followingB\x0fZ\raeibi/api;apib\x06proto3"
+	"\x0fListMyFollowing\x12\x1e.follow.ListMyFollowingRequest\x1a\x1f.follow.ListMyFollowingResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/me/following\x12\x97\x01\n" +
+	"\x1aListIncomingFollowRequests\x12).follow.ListIncomingFollowRequestsRequest\x1a*.follow.ListIncomingFollowRequestsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/me/follow-requests\x12\xa0\x01\n" +
+	"\x1aListOutgoingFollowRequests\x12).follow.ListOutgoingFollowRequestsRequest\x1a*.follow.ListOutgoingFollowRequestsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/me/follow-requests/outgoing\x12\x88\x01\n" +
+	"\x14ApproveFollowRequest\x12#.follow.ApproveFollowRequestRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/me/follow-requests/{uid}/approve\x12\x85\x01\n" +
+	"\x13RejectFollowRequest\x12\".follow.RejectFollowRequestRequest\x1a\x16.google.protobuf.Empty\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/me/follow-requests/{uid}/rejectB\x0fZ\raeibi/api;apib\x06proto3"
 
 var (
 	file_follow_proto_rawDescOnce sync.Once
@@ -394,32 +739,49 @@ func file_follow_proto_rawDescGZIP() []byte {
 	return file_follow_proto_rawDescData
 }
 
-var file_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_follow_proto_goTypes = []any{
-	(*FollowRequest)(nil),           // 0: follow.FollowRequest
-	(*FollowResponse)(nil),          // 1: follow.FollowResponse
-	(*ListMyFollowersRequest)(nil),  // 2: follow.ListMyFollowersRequest
-	(*ListMyFollowersResponse)(nil), // 3: follow.ListMyFollowersResponse
-	(*ListMyFollowingRequest)(nil),  // 4: follow.ListMyFollowingRequest
-	(*ListMyFollowingResponse)(nil), // 5: follow.ListMyFollowingResponse
-	(ToggleAction)(0),               // 6: common.ToggleAction
-	(*User)(nil),                    // 7: common.User
+	(*FollowRequest)(nil),                      // 0: follow.FollowRequest
+	(*FollowResponse)(nil),                     // 1: follow.FollowResponse
+	(*ListMyFollowersRequest)(nil),             // 2: follow.ListMyFollowersRequest
+	(*ListMyFollowersResponse)(nil),            // 3: follow.ListMyFollowersResponse
+	(*ListMyFollowingRequest)(nil),             // 4: follow.ListMyFollowingRequest
+	(*ListMyFollowingResponse)(nil),            // 5: follow.ListMyFollowingResponse
+	(*ListIncomingFollowRequestsRequest)(nil),  // 6: follow.ListIncomingFollowRequestsRequest
+	(*ListIncomingFollowRequestsResponse)(nil), // 7: follow.ListIncomingFollowRequestsResponse
+	(*ListOutgoingFollowRequestsRequest)(nil),  // 8: follow.ListOutgoingFollowRequestsRequest
+	(*ListOutgoingFollowRequestsResponse)(nil), // 9: follow.ListOutgoingFollowRequestsResponse
+	(*ApproveFollowRequestRequest)(nil),        // 10: follow.ApproveFollowRequestRequest
+	(*RejectFollowRequestRequest)(nil),         // 11: follow.RejectFollowRequestRequest
+	(ToggleAction)(0),                          // 12: common.ToggleAction
+	(*User)(nil),                               // 13: common.User
+	(*emptypb.Empty)(nil),                      // 14: google.protobuf.Empty
 }
 var file_follow_proto_depIdxs = []int32{
-	6, // 0: follow.FollowRequest.action:type_name -> common.ToggleAction
-	7, // 1: follow.ListMyFollowersResponse.users:type_name -> common.User
-	7, // 2: follow.ListMyFollowingResponse.users:type_name -> common.User
-	0, // 3: follow.FollowService.Follow:input_type -> follow.FollowRequest
-	2, // 4: follow.FollowService.ListMyFollowers:input_type -> follow.ListMyFollowersRequest
-	4, // 5: follow.FollowService.ListMyFollowing:input_type -> follow.ListMyFollowingRequest
-	1, // 6: follow.FollowService.Follow:output_type -> follow.FollowResponse
-	3, // 7: follow.FollowService.ListMyFollowers:output_type -> follow.ListMyFollowersResponse
-	5, // 8: follow.FollowService.ListMyFollowing:output_type -> follow.ListMyFollowingResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	12, // 0: follow.FollowRequest.action:type_name -> common.ToggleAction
+	13, // 1: follow.ListMyFollowersResponse.users:type_name -> common.User
+	13, // 2: follow.ListMyFollowingResponse.users:type_name -> common.User
+	13, // 3: follow.ListIncomingFollowRequestsResponse.users:type_name -> common.User
+	13, // 4: follow.ListOutgoingFollowRequestsResponse.users:type_name -> common.User
+	0,  // 5: follow.FollowService.Follow:input_type -> follow.FollowRequest
+	2,  // 6: follow.FollowService.ListMyFollowers:input_type -> follow.ListMyFollowersRequest
+	4,  // 7: follow.FollowService.ListMyFollowing:input_type -> follow.ListMyFollowingRequest
+	6,  // 8: follow.FollowService.ListIncomingFollowRequests:input_type -> follow.ListIncomingFollowRequestsRequest
+	8,  // 9: follow.FollowService.ListOutgoingFollowRequests:input_type -> follow.ListOutgoingFollowRequestsRequest
+	10, // 10: follow.FollowService.ApproveFollowRequest:input_type -> follow.ApproveFollowRequestRequest
+	11, // 11: follow.FollowService.RejectFollowRequest:input_type -> follow.RejectFollowRequestRequest
+	1,  // 12: follow.FollowService.Follow:output_type -> follow.FollowResponse
+	3,  // 13: follow.FollowService.ListMyFollowers:output_type -> follow.ListMyFollowersResponse
+	5,  // 14: follow.FollowService.ListMyFollowing:output_type -> follow.ListMyFollowingResponse
+	7,  // 15: follow.FollowService.ListIncomingFollowRequests:output_type -> follow.ListIncomingFollowRequestsResponse
+	9,  // 16: follow.FollowService.ListOutgoingFollowRequests:output_type -> follow.ListOutgoingFollowRequestsResponse
+	14, // 17: follow.FollowService.ApproveFollowRequest:output_type -> google.protobuf.Empty
+	14, // 18: follow.FollowService.RejectFollowRequest:output_type -> google.protobuf.Empty
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_follow_proto_init() }
@@ -434,7 +796,7 @@ func file_follow_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_follow_proto_rawDesc), len(file_follow_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FollowService_Follow_FullMethodName          = "/follow.FollowService/Follow"
-	FollowService_ListMyFollowers_FullMethodName = "/follow.FollowService/ListMyFollowers"
-	FollowService_ListMyFollowing_FullMethodName = "/follow.FollowService/ListMyFollowing"
+	FollowService_Follow_FullMethodName                     = "/follow.FollowService/Follow"
+	FollowService_ListMyFollowers_FullMethodName            = "/follow.FollowService/ListMyFollowers"
+	FollowService_ListMyFollowing_FullMethodName            = "/follow.FollowService/ListMyFollowing"
+	FollowService_ListIncomingFollowRequests_FullMethodName = "/follow.FollowService/ListIncomingFollowRequests"
+	FollowService_ListOutgoingFollowRequests_FullMethodName = "/follow.FollowService/ListOutgoingFollowRequests"
+	FollowService_ApproveFollowRequest_FullMethodName       = "/follow.FollowService/ApproveFollowRequest"
+	FollowService_RejectFollowRequest_FullMethodName        = "/follow.FollowService/RejectFollowRequest"
 )
 
 // FollowServiceClient is the client API for FollowService service.
@@ -36,6 +41,14 @@ type FollowServiceClient interface {
 	ListMyFollowers(ctx context.Context, in *ListMyFollowersRequest, opts ...grpc.CallOption) (*ListMyFollowersResponse, error)
 	// GET /api/v1/me/following 关注列表
 	ListMyFollowing(ctx context.Context, in *ListMyFollowingRequest, opts ...grpc.CallOption) (*ListMyFollowingResponse, error)
+	// GET /api/v1/me/follow-requests 收到的关注请求
+	ListIncomingFollowRequests(ctx context.Context, in *ListIncomingFollowRequestsRequest, opts ...grpc.CallOption) (*ListIncomingFollowRequestsResponse, error)
+	// GET /api/v1/me/follow-requests/outgoing 发出的关注请求
+	ListOutgoingFollowRequests(ctx context.Context, in *ListOutgoingFollowRequestsRequest, opts ...grpc.CallOption) (*ListOutgoingFollowRequestsResponse, error)
+	// POST /api/v1/me/follow-requests/{uid}/approve 通过关注请求
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/me/follow-requests/{uid}/reject 拒绝关注请求
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type followServiceClient struct {
@@ -76,6 +89,46 @@ func (c *followServiceClient) ListMyFollowing(ctx context.Context, in *ListMyFol
 	return out, nil
 }
 
+func (c *followServiceClient) ListIncomingFollowRequests(ctx context.Context, in *ListIncomingFollowRequestsRequest, opts ...grpc.CallOption) (*ListIncomingFollowRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncomingFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, FollowService_ListIncomingFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) ListOutgoingFollowRequests(ctx context.Context, in *ListOutgoingFollowRequestsRequest, opts ...grpc.CallOption) (*ListOutgoingFollowRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutgoingFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, FollowService_ListOutgoingFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FollowService_ApproveFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FollowService_RejectFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FollowServiceServer is the server API for FollowService service.
 // All implementations must embed UnimplementedFollowServiceServer
 // for forward compatibility.
@@ -88,6 +141,14 @@ type FollowServiceServer interface {
 	ListMyFollowers(context.Context, *ListMyFollowersRequest) (*ListMyFollowersResponse, error)
 	// GET /api/v1/me/following 关注列表
 	ListMyFollowing(context.Context, *ListMyFollowingRequest) (*ListMyFollowingResponse, error)
+	// GET /api/v1/me/follow-requests 收到的关注请求
+	ListIncomingFollowRequests(context.Context, *ListIncomingFollowRequestsRequest) (*ListIncomingFollowRequestsResponse, error)
+	// GET /api/v1/me/follow-requests/outgoing 发出的关注请求
+	ListOutgoingFollowRequests(context.Context, *ListOutgoingFollowRequestsRequest) (*ListOutgoingFollowRequestsResponse, error)
+	// POST /api/v1/me/follow-requests/{uid}/approve 通过关注请求
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*emptypb.Empty, error)
+	// POST /api/v1/me/follow-requests/{uid}/reject 拒绝关注请求
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedFollowServiceServer()
 }
 
@@ -107,6 +168,18 @@ func (UnimplementedFollowServiceServer) ListMyFollowers(context.Context, *ListMy
 func (UnimplementedFollowServiceServer) ListMyFollowing(context.Context, *ListMyFollowingRequest) (*ListMyFollowingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMyFollowing not implemented")
 }
+func (UnimplementedFollowServiceServer) ListIncomingFollowRequests(context.Context, *ListIncomingFollowRequestsRequest) (*ListIncomingFollowRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIncomingFollowRequests not implemented")
+}
+func (UnimplementedFollowServiceServer) ListOutgoingFollowRequests(context.Context, *ListOutgoingFollowRequestsRequest) (*ListOutgoingFollowRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOutgoingFollowRequests not implemented")
+}
+func (UnimplementedFollowServiceServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedFollowServiceServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
 func (UnimplementedFollowServiceServer) mustEmbedUnimplementedFollowServiceServer() {}
 func (UnimplementedFollowServiceServer) testEmbeddedByValue()                       {}
 
@@ -182,6 +255,78 @@ func _FollowService_ListMyFollowing_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FollowService_ListIncomingFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncomingFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).ListIncomingFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_ListIncomingFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).ListIncomingFollowRequests(ctx, req.(*ListIncomingFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_ListOutgoingFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).ListOutgoingFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_ListOutgoingFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).ListOutgoingFollowRequests(ctx, req.(*ListOutgoingFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_ApproveFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_RejectFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FollowService_ServiceDesc is the grpc.ServiceDesc for FollowService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +346,22 @@ var FollowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMyFollowing",
 			Handler:    _FollowService_ListMyFollowing_Handler,
 		},
+		{
+			MethodName: "ListIncomingFollowRequests",
+			Handler:    _FollowService_ListIncomingFollowRequests_Handler,
+		},
+		{
+			MethodName: "ListOutgoingFollowRequests",
+			Handler:    _FollowService_ListOutgoingFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _FollowService_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _FollowService_RejectFollowRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "follow.proto",
@@ -180,7 +180,7 @@ type Post struct {
 	CommentCount    int32                  `protobuf:"varint,7,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
 	CollectionCount int32                  `protobuf:"varint,8,opt,name=collection_count,json=collectionCount,proto3" json:"collection_count,omitempty"`
 	LikeCount       int32                  `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	Visibility      string                 `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Visibility      string                 `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"` // PUBLIC/FOLLOWERS/UNLISTED/PRIVATE
 	LatestRepliedOn int64                  `protobuf:"varint,11,opt,name=latest_replied_on,json=latestRepliedOn,proto3" json:"latest_replied_on,omitempty"`
 	Ip              string                 `protobuf:"bytes,12,opt,name=ip,proto3" json:"ip,omitempty"`
 	Pinned          bool                   `protobuf:"varint,13,opt,name=pinned,proto3" json:"pinned,omitempty"`
@@ -188,6 +188,19 @@ type Post struct {
 	Collected       bool                   `protobuf:"varint,15,opt,name=collected,proto3" json:"collected,omitempty"`
 	CreatedAt       int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       int64                  `protobuf:"varint,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RepostCount     int32                  `protobuf:"varint,18,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	Reposted        bool                   `protobuf:"varint,19,opt,name=reposted,proto3" json:"reposted,omitempty"`
+	RepostOfUid     string                 `protobuf:"bytes,20,opt,name=repost_of_uid,json=repostOfUid,proto3" json:"repost_of_uid,omitempty"` // 转发：正文为空，展示 repost_of
+	RepostOf        *Post                  `protobuf:"bytes,21,opt,name=repost_of,json=repostOf,proto3" json:"repost_of,omitempty"`
+	QuotedPostUid   string                 `protobuf:"bytes,22,opt,name=quoted_post_uid,json=quotedPostUid,proto3" json:"quoted_post_uid,omitempty"` // 引用转发
+	QuotedPost      *Post                  `protobuf:"bytes,23,opt,name=quoted_post,json=quotedPost,proto3" json:"quoted_post,omitempty"`
+	Tombstone       bool                   `protobuf:"varint,24,opt,name=tombstone,proto3" json:"tombstone,omitempty"` // 原帖已删除或不可见，仅保留 uid
+	Entities        []*TextEntity          `protobuf:"bytes,25,rep,name=entities,proto3" json:"entities,omitempty"`
+	Edited          bool                   `protobuf:"varint,26,opt,name=edited,proto3" json:"edited,omitempty"`
+	EditCount       int32                  `protobuf:"varint,27,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`
+	Status          string                 `protobuf:"bytes,28,opt,name=status,proto3" json:"status,omitempty"`                         // 草稿列表：DRAFT/SCHEDULED
+	PublishAt       int64                  `protobuf:"varint,29,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // 定时发布时间，unix seconds
+	Poll            *Poll                  `protobuf:"bytes,30,opt,name=poll,proto3" json:"poll,omitempty"`                             // 投票，未附带时为空
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -341,21 +354,402 @@ func (x *Post) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Post) GetRepostCount() int32 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+func (x *Post) GetReposted() bool {
+	if x != nil {
+		return x.Reposted
+	}
+	return false
+}
+
+func (x *Post) GetRepostOfUid() string {
+	if x != nil {
+		return x.RepostOfUid
+	}
+	return ""
+}
+
+func (x *Post) GetRepostOf() *Post {
+	if x != nil {
+		return x.RepostOf
+	}
+	return nil
+}
+
+func (x *Post) GetQuotedPostUid() string {
+	if x != nil {
+		return x.QuotedPostUid
+	}
+	return ""
+}
+
+func (x *Post) GetQuotedPost() *Post {
+	if x != nil {
+		return x.QuotedPost
+	}
+	return nil
+}
+
+func (x *Post) GetTombstone() bool {
+	if x != nil {
+		return x.Tombstone
+	}
+	return false
+}
+
+func (x *Post) GetEntities() []*TextEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Post) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Post) GetEditCount() int32 {
+	if x != nil {
+		return x.EditCount
+	}
+	return 0
+}
+
+func (x *Post) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Post) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *Post) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+// Poll 帖子附带的投票；投票或截止前 options 中的 vote_count 不返回
+type Poll struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Multiple       bool                   `protobuf:"varint,2,opt,name=multiple,proto3" json:"multiple,omitempty"`
+	ExpiresAt      int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Closed         bool                   `protobuf:"varint,4,opt,name=closed,proto3" json:"closed,omitempty"`
+	VoterCount     int32                  `protobuf:"varint,5,opt,name=voter_count,json=voterCount,proto3" json:"voter_count,omitempty"`
+	Options        []*PollOption          `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	Voted          bool                   `protobuf:"varint,7,opt,name=voted,proto3" json:"voted,omitempty"`
+	MyChoices      []int32                `protobuf:"varint,8,rep,packed,name=my_choices,json=myChoices,proto3" json:"my_choices,omitempty"`
+	ResultsVisible bool                   `protobuf:"varint,9,opt,name=results_visible,json=resultsVisible,proto3" json:"results_visible,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	mi := &file_post_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Poll) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Poll) GetMultiple() bool {
+	if x != nil {
+		return x.Multiple
+	}
+	return false
+}
+
+func (x *Poll) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Poll) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Poll) GetVoterCount() int32 {
+	if x != nil {
+		return x.VoterCount
+	}
+	return 0
+}
+
+func (x *Poll) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetVoted() bool {
+	if x != nil {
+		return x.Voted
+	}
+	return false
+}
+
+func (x *Poll) GetMyChoices() []int32 {
+	if x != nil {
+		return x.MyChoices
+	}
+	return nil
+}
+
+func (x *Poll) GetResultsVisible() bool {
+	if x != nil {
+		return x.ResultsVisible
+	}
+	return false
+}
+
+type PollOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	VoteCount     int32                  `protobuf:"varint,3,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	mi := &file_post_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOption) ProtoMessage() {}
+
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PollOption) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PollOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PollOption) GetVoteCount() int32 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+// PostRevision 帖子被编辑前的一个版本
+type PostRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"` // 0 为原始版本
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Entities      []*TextEntity          `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities,omitempty"`
+	Images        []string               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,6,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Visibility    string                 `protobuf:"bytes,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	PublishedAt   int64                  `protobuf:"varint,9,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"` // 该版本发布时间
+	ReplacedAt    int64                  `protobuf:"varint,10,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"`   // 被编辑替换的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	mi := &file_post_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PostRevision) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PostRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PostRevision) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PostRevision) GetEntities() []*TextEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *PostRevision) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *PostRevision) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *PostRevision) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PostRevision) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *PostRevision) GetPublishedAt() int64 {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return 0
+}
+
+func (x *PostRevision) GetReplacedAt() int64 {
+	if x != nil {
+		return x.ReplacedAt
+	}
+	return 0
+}
+
 type CreatePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	Images        []string               `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
 	Attachments   []string               `protobuf:"bytes,3,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"` // PUBLIC/FOLLOWERS/UNLISTED/PRIVATE
 	Pinned        bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	QuotedPostUid string                 `protobuf:"bytes,7,opt,name=quoted_post_uid,json=quotedPostUid,proto3" json:"quoted_post_uid,omitempty"` // 引用的帖子
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                      // NORMAL/DRAFT，默认 NORMAL
+	PublishAt     int64                  `protobuf:"varint,9,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`              // unix seconds，设置后定时发布
+	Poll          *CreatePollBody        `protobuf:"bytes,10,opt,name=poll,proto3" json:"poll,omitempty"`                                         // 附带投票
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePostRequest) Reset() {
 	*x = CreatePostRequest{}
-	mi := &file_post_proto_msgTypes[3]
+	mi := &file_post_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -367,7 +761,7 @@ func (x *CreatePostRequest) String() string {
 func (*CreatePostRequest) ProtoMessage() {}
 
 func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[3]
+	mi := &file_post_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +774,7 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
 func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{3}
+	return file_post_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreatePostRequest) GetText() string {
@@ -425,6 +819,94 @@ func (x *CreatePostRequest) GetPinned() bool {
 	return false
 }
 
+func (x *CreatePostRequest) GetQuotedPostUid() string {
+	if x != nil {
+		return x.QuotedPostUid
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetPoll() *CreatePollBody {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type CreatePollBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       []string               `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`                       // 2-10 个选项
+	Multiple      bool                   `protobuf:"varint,2,opt,name=multiple,proto3" json:"multiple,omitempty"`                    // 是否多选
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePollBody) Reset() {
+	*x = CreatePollBody{}
+	mi := &file_post_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePollBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePollBody) ProtoMessage() {}
+
+func (x *CreatePollBody) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePollBody.ProtoReflect.Descriptor instead.
+func (*CreatePollBody) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatePollBody) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreatePollBody) GetMultiple() bool {
+	if x != nil {
+		return x.Multiple
+	}
+	return false
+}
+
+func (x *CreatePollBody) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
 type CreatePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -434,7 +916,7 @@ type CreatePostResponse struct {
 
 func (x *CreatePostResponse) Reset() {
 	*x = CreatePostResponse{}
-	mi := &file_post_proto_msgTypes[4]
+	mi := &file_post_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +928,7 @@ func (x *CreatePostResponse) String() string {
 func (*CreatePostResponse) ProtoMessage() {}
 
 func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[4]
+	mi := &file_post_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +941,7 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
 func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{4}
+	return file_post_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreatePostResponse) GetUid() string {
@@ -479,7 +961,7 @@ type ListPostsRequest struct {
 
 func (x *ListPostsRequest) Reset() {
 	*x = ListPostsRequest{}
-	mi := &file_post_proto_msgTypes[5]
+	mi := &file_post_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +973,7 @@ func (x *ListPostsRequest) String() string {
 func (*ListPostsRequest) ProtoMessage() {}
 
 func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[5]
+	mi := &file_post_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +986,7 @@ func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
 func (*ListPostsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{5}
+	return file_post_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListPostsRequest) GetCursorCreatedAt() int64 {
@@ -532,7 +1014,7 @@ type ListPostsByAuthorRequest struct {
 
 func (x *ListPostsByAuthorRequest) Reset() {
 	*x = ListPostsByAuthorRequest{}
-	mi := &file_post_proto_msgTypes[6]
+	mi := &file_post_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -544,7 +1026,7 @@ func (x *ListPostsByAuthorRequest) String() string {
 func (*ListPostsByAuthorRequest) ProtoMessage() {}
 
 func (x *ListPostsByAuthorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[6]
+	mi := &file_post_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,7 +1039,7 @@ func (x *ListPostsByAuthorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostsByAuthorRequest.ProtoReflect.Descriptor instead.
 func (*ListPostsByAuthorRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{6}
+	return file_post_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListPostsByAuthorRequest) GetUid() string {
@@ -592,7 +1074,7 @@ type ListPostsResponse struct {
 
 func (x *ListPostsResponse) Reset() {
 	*x = ListPostsResponse{}
-	mi := &file_post_proto_msgTypes[7]
+	mi := &file_post_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +1086,7 @@ func (x *ListPostsResponse) String() string {
 func (*ListPostsResponse) ProtoMessage() {}
 
 func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[7]
+	mi := &file_post_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +1099,7 @@ func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
 func (*ListPostsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{7}
+	return file_post_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListPostsResponse) GetPosts() []*Post {
@@ -650,7 +1132,7 @@ type GetPostRequest struct {
 
 func (x *GetPostRequest) Reset() {
 	*x = GetPostRequest{}
-	mi := &file_post_proto_msgTypes[8]
+	mi := &file_post_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +1144,7 @@ func (x *GetPostRequest) String() string {
 func (*GetPostRequest) ProtoMessage() {}
 
 func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[8]
+	mi := &file_post_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +1157,7 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
 func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{8}
+	return file_post_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPostRequest) GetUid() string {
@@ -694,7 +1176,7 @@ type GetPostResponse struct {
 
 func (x *GetPostResponse) Reset() {
 	*x = GetPostResponse{}
-	mi := &file_post_proto_msgTypes[9]
+	mi := &file_post_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -706,7 +1188,7 @@ func (x *GetPostResponse) String() string {
 func (*GetPostResponse) ProtoMessage() {}
 
 func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[9]
+	mi := &file_post_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +1201,7 @@ func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
 func (*GetPostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{9}
+	return file_post_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetPostResponse) GetPost() *Post {
@@ -735,27 +1217,184 @@ type UpdatePostBody struct {
 	Images        []string               `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
 	Attachments   []string               `protobuf:"bytes,3,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"` // PUBLIC/FOLLOWERS/UNLISTED/PRIVATE
 	Pinned        bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                         // 仅草稿：NORMAL 立即发布，DRAFT 取消定时
+	PublishAt     int64                  `protobuf:"varint,8,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // 仅草稿：unix seconds，0 取消定时
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostBody) Reset() {
-	*x = UpdatePostBody{}
-	mi := &file_post_proto_msgTypes[10]
+func (x *UpdatePostBody) Reset() {
+	*x = UpdatePostBody{}
+	mi := &file_post_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostBody) ProtoMessage() {}
+
+func (x *UpdatePostBody) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostBody.ProtoReflect.Descriptor instead.
+func (*UpdatePostBody) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdatePostBody) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UpdatePostBody) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *UpdatePostBody) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *UpdatePostBody) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdatePostBody) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdatePostBody) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *UpdatePostBody) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdatePostBody) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+type UpdatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Post          *UpdatePostBody        `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	mi := &file_post_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdatePostRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetPost() *UpdatePostBody {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ListPostRevisionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uid             string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CursorCreatedAt int64                  `protobuf:"varint,2,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds, replaced_at of the last revision
+	CursorId        string                 `protobuf:"bytes,3,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionsRequest) Reset() {
+	*x = ListPostRevisionsRequest{}
+	mi := &file_post_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostBody) String() string {
+func (x *ListPostRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostBody) ProtoMessage() {}
+func (*ListPostRevisionsRequest) ProtoMessage() {}
 
-func (x *UpdatePostBody) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[10]
+func (x *ListPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,77 +1405,56 @@ func (x *UpdatePostBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostBody.ProtoReflect.Descriptor instead.
-func (*UpdatePostBody) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdatePostBody) GetText() string {
+func (x *ListPostRevisionsRequest) GetUid() string {
 	if x != nil {
-		return x.Text
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *UpdatePostBody) GetImages() []string {
-	if x != nil {
-		return x.Images
-	}
-	return nil
-}
-
-func (x *UpdatePostBody) GetAttachments() []string {
-	if x != nil {
-		return x.Attachments
-	}
-	return nil
-}
-
-func (x *UpdatePostBody) GetTags() []string {
+func (x *ListPostRevisionsRequest) GetCursorCreatedAt() int64 {
 	if x != nil {
-		return x.Tags
+		return x.CursorCreatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdatePostBody) GetVisibility() string {
+func (x *ListPostRevisionsRequest) GetCursorId() string {
 	if x != nil {
-		return x.Visibility
+		return x.CursorId
 	}
 	return ""
 }
 
-func (x *UpdatePostBody) GetPinned() bool {
-	if x != nil {
-		return x.Pinned
-	}
-	return false
-}
-
-type UpdatePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Post          *UpdatePostBody        `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListPostRevisionsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Revisions           []*PostRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextCursorCreatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_created_at,json=nextCursorCreatedAt,proto3" json:"next_cursor_created_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
-	mi := &file_post_proto_msgTypes[11]
+func (x *ListPostRevisionsResponse) Reset() {
+	*x = ListPostRevisionsResponse{}
+	mi := &file_post_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *ListPostRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*ListPostRevisionsResponse) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[11]
+func (x *ListPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,30 +1465,30 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdatePostRequest) GetUid() string {
+func (x *ListPostRevisionsResponse) GetRevisions() []*PostRevision {
 	if x != nil {
-		return x.Uid
+		return x.Revisions
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdatePostRequest) GetPost() *UpdatePostBody {
+func (x *ListPostRevisionsResponse) GetNextCursorCreatedAt() int64 {
 	if x != nil {
-		return x.Post
+		return x.NextCursorCreatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdatePostRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ListPostRevisionsResponse) GetNextCursorId() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.NextCursorId
 	}
-	return nil
+	return ""
 }
 
 type DeletePostRequest struct {
@@ -882,7 +1500,7 @@ type DeletePostRequest struct {
 
 func (x *DeletePostRequest) Reset() {
 	*x = DeletePostRequest{}
-	mi := &file_post_proto_msgTypes[12]
+	mi := &file_post_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -894,7 +1512,7 @@ func (x *DeletePostRequest) String() string {
 func (*DeletePostRequest) ProtoMessage() {}
 
 func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[12]
+	mi := &file_post_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,7 +1525,7 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
 func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{12}
+	return file_post_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeletePostRequest) GetUid() string {
@@ -927,7 +1545,7 @@ type LikePostRequest struct {
 
 func (x *LikePostRequest) Reset() {
 	*x = LikePostRequest{}
-	mi := &file_post_proto_msgTypes[13]
+	mi := &file_post_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -939,7 +1557,7 @@ func (x *LikePostRequest) String() string {
 func (*LikePostRequest) ProtoMessage() {}
 
 func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[13]
+	mi := &file_post_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1570,7 @@ func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
 func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{13}
+	return file_post_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LikePostRequest) GetUid() string {
@@ -978,7 +1596,7 @@ type LikePostResponse struct {
 
 func (x *LikePostResponse) Reset() {
 	*x = LikePostResponse{}
-	mi := &file_post_proto_msgTypes[14]
+	mi := &file_post_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +1608,7 @@ func (x *LikePostResponse) String() string {
 func (*LikePostResponse) ProtoMessage() {}
 
 func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[14]
+	mi := &file_post_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1621,7 @@ func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
 func (*LikePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{14}
+	return file_post_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *LikePostResponse) GetCount() int32 {
@@ -1023,7 +1641,7 @@ type CollectPostRequest struct {
 
 func (x *CollectPostRequest) Reset() {
 	*x = CollectPostRequest{}
-	mi := &file_post_proto_msgTypes[15]
+	mi := &file_post_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1035,7 +1653,7 @@ func (x *CollectPostRequest) String() string {
 func (*CollectPostRequest) ProtoMessage() {}
 
 func (x *CollectPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[15]
+	mi := &file_post_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,7 +1666,7 @@ func (x *CollectPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectPostRequest.ProtoReflect.Descriptor instead.
 func (*CollectPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{15}
+	return file_post_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CollectPostRequest) GetUid() string {
@@ -1074,7 +1692,7 @@ type CollectPostResponse struct {
 
 func (x *CollectPostResponse) Reset() {
 	*x = CollectPostResponse{}
-	mi := &file_post_proto_msgTypes[16]
+	mi := &file_post_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1704,7 @@ func (x *CollectPostResponse) String() string {
 func (*CollectPostResponse) ProtoMessage() {}
 
 func (x *CollectPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[16]
+	mi := &file_post_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1717,7 @@ func (x *CollectPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectPostResponse.ProtoReflect.Descriptor instead.
 func (*CollectPostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{16}
+	return file_post_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CollectPostResponse) GetCount() int32 {
@@ -1109,6 +1727,198 @@ func (x *CollectPostResponse) GetCount() int32 {
 	return 0
 }
 
+type RepostPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepostPostRequest) Reset() {
+	*x = RepostPostRequest{}
+	mi := &file_post_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepostPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostPostRequest) ProtoMessage() {}
+
+func (x *RepostPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostPostRequest.ProtoReflect.Descriptor instead.
+func (*RepostPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RepostPostRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RepostPostRequest) GetAction() ToggleAction {
+	if x != nil {
+		return x.Action
+	}
+	return ToggleAction_TOGGLE_ACTION_ADD
+}
+
+type RepostPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepostPostResponse) Reset() {
+	*x = RepostPostResponse{}
+	mi := &file_post_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepostPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostPostResponse) ProtoMessage() {}
+
+func (x *RepostPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostPostResponse.ProtoReflect.Descriptor instead.
+func (*RepostPostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RepostPostResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type VotePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`                 // 帖子 uid
+	Choices       []int32                `protobuf:"varint,2,rep,packed,name=choices,proto3" json:"choices,omitempty"` // 选项下标，单选时仅一个
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_post_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VotePollRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *VotePollRequest) GetChoices() []int32 {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+type VotePollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Poll          *Poll                  `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	mi := &file_post_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VotePollResponse) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
 var File_post_proto protoreflect.FileDescriptor
 
 const file_post_proto_rawDesc = "" +
@@ -1128,7 +1938,7 @@ const file_post_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12\x17\n" +
 	"\x04size\x18\x03 \x01(\x03B\x03\xe0A\x02R\x04size\x12&\n" +
 	"\fcontent_type\x18\x04 \x01(\tB\x03\xe0A\x02R\vcontentType\x12\x1f\n" +
-	"\bchecksum\x18\x05 \x01(\tB\x03\xe0A\x02R\bchecksum\"\xe0\x04\n" +
+	"\bchecksum\x18\x05 \x01(\tB\x03\xe0A\x02R\bchecksum\"\xb6\b\n" +
 	"\x04Post\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12-\n" +
 	"\x06author\x18\x02 \x01(\v2\x10.post.PostAuthorB\x03\xe0A\x02R\x06author\x12\x17\n" +
@@ -1152,7 +1962,60 @@ const file_post_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x10 \x01(\x03B\x03\xe0A\x02R\tcreatedAt\x12\"\n" +
 	"\n" +
-	"updated_at\x18\x11 \x01(\x03B\x03\xe0A\x02R\tupdatedAt\"\xb2\x01\n" +
+	"updated_at\x18\x11 \x01(\x03B\x03\xe0A\x02R\tupdatedAt\x12&\n" +
+	"\frepost_count\x18\x12 \x01(\x05B\x03\xe0A\x02R\vrepostCount\x12\x1f\n" +
+	"\breposted\x18\x13 \x01(\bB\x03\xe0A\x02R\breposted\x12\"\n" +
+	"\rrepost_of_uid\x18\x14 \x01(\tR\vrepostOfUid\x12'\n" +
+	"\trepost_of\x18\x15 \x01(\v2\n" +
+	".post.PostR\brepostOf\x12&\n" +
+	"\x0fquoted_post_uid\x18\x16 \x01(\tR\rquotedPostUid\x12+\n" +
+	"\vquoted_post\x18\x17 \x01(\v2\n" +
+	".post.PostR\n" +
+	"quotedPost\x12\x1c\n" +
+	"\ttombstone\x18\x18 \x01(\bR\ttombstone\x123\n" +
+	"\bentities\x18\x19 \x03(\v2\x12.common.TextEntityB\x03\xe0A\x02R\bentities\x12\x1b\n" +
+	"\x06edited\x18\x1a \x01(\bB\x03\xe0A\x02R\x06edited\x12\"\n" +
+	"\n" +
+	"edit_count\x18\x1b \x01(\x05B\x03\xe0A\x02R\teditCount\x12\x16\n" +
+	"\x06status\x18\x1c \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x1d \x01(\x03R\tpublishAt\x12\x1e\n" +
+	"\x04poll\x18\x1e \x01(\v2\n" +
+	".post.PollR\x04poll\"\xc3\x02\n" +
+	"\x04Poll\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
+	"\bmultiple\x18\x02 \x01(\bB\x03\xe0A\x02R\bmultiple\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03B\x03\xe0A\x02R\texpiresAt\x12\x1b\n" +
+	"\x06closed\x18\x04 \x01(\bB\x03\xe0A\x02R\x06closed\x12$\n" +
+	"\vvoter_count\x18\x05 \x01(\x05B\x03\xe0A\x02R\n" +
+	"voterCount\x12/\n" +
+	"\aoptions\x18\x06 \x03(\v2\x10.post.PollOptionB\x03\xe0A\x02R\aoptions\x12\x19\n" +
+	"\x05voted\x18\a \x01(\bB\x03\xe0A\x02R\x05voted\x12\"\n" +
+	"\n" +
+	"my_choices\x18\b \x03(\x05B\x03\xe0A\x02R\tmyChoices\x12,\n" +
+	"\x0fresults_visible\x18\t \x01(\bB\x03\xe0A\x02R\x0eresultsVisible\"d\n" +
+	"\n" +
+	"PollOption\x12\x19\n" +
+	"\x05index\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05index\x12\x17\n" +
+	"\x04text\x18\x02 \x01(\tB\x03\xe0A\x02R\x04text\x12\"\n" +
+	"\n" +
+	"vote_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\tvoteCount\"\xf6\x02\n" +
+	"\fPostRevision\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1f\n" +
+	"\brevision\x18\x02 \x01(\x05B\x03\xe0A\x02R\brevision\x12\x17\n" +
+	"\x04text\x18\x03 \x01(\tB\x03\xe0A\x02R\x04text\x123\n" +
+	"\bentities\x18\x04 \x03(\v2\x12.common.TextEntityB\x03\xe0A\x02R\bentities\x12\x1b\n" +
+	"\x06images\x18\x05 \x03(\tB\x03\xe0A\x02R\x06images\x127\n" +
+	"\vattachments\x18\x06 \x03(\v2\x10.post.AttachmentB\x03\xe0A\x02R\vattachments\x12\x17\n" +
+	"\x04tags\x18\a \x03(\tB\x03\xe0A\x02R\x04tags\x12#\n" +
+	"\n" +
+	"visibility\x18\b \x01(\tB\x03\xe0A\x02R\n" +
+	"visibility\x12&\n" +
+	"\fpublished_at\x18\t \x01(\x03B\x03\xe0A\x02R\vpublishedAt\x12$\n" +
+	"\vreplaced_at\x18\n" +
+	" \x01(\x03B\x03\xe0A\x02R\n" +
+	"replacedAt\"\xbb\x02\n" +
 	"\x11CreatePostRequest\x12\x17\n" +
 	"\x04text\x18\x01 \x01(\tB\x03\xe0A\x02R\x04text\x12\x16\n" +
 	"\x06images\x18\x02 \x03(\tR\x06images\x12 \n" +
@@ -1161,7 +2024,18 @@ const file_post_proto_rawDesc = "" +
 	"\n" +
 	"visibility\x18\x05 \x01(\tR\n" +
 	"visibility\x12\x16\n" +
-	"\x06pinned\x18\x06 \x01(\bR\x06pinned\"+\n" +
+	"\x06pinned\x18\x06 \x01(\bR\x06pinned\x12&\n" +
+	"\x0fquoted_post_uid\x18\a \x01(\tR\rquotedPostUid\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\x03R\tpublishAt\x12(\n" +
+	"\x04poll\x18\n" +
+	" \x01(\v2\x14.post.CreatePollBodyR\x04poll\"o\n" +
+	"\x0eCreatePollBody\x12\x1d\n" +
+	"\aoptions\x18\x01 \x03(\tB\x03\xe0A\x02R\aoptions\x12\x1a\n" +
+	"\bmultiple\x18\x02 \x01(\bR\bmultiple\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03B\x03\xe0A\x02R\texpiresAt\"+\n" +
 	"\x12CreatePostResponse\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"[\n" +
 	"\x10ListPostsRequest\x12*\n" +
@@ -1180,7 +2054,7 @@ const file_post_proto_rawDesc = "" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"6\n" +
 	"\x0fGetPostResponse\x12#\n" +
 	"\x04post\x18\x01 \x01(\v2\n" +
-	".post.PostB\x03\xe0A\x02R\x04post\"\xaa\x01\n" +
+	".post.PostB\x03\xe0A\x02R\x04post\"\xe1\x01\n" +
 	"\x0eUpdatePostBody\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
 	"\x06images\x18\x02 \x03(\tR\x06images\x12 \n" +
@@ -1189,12 +2063,23 @@ const file_post_proto_rawDesc = "" +
 	"\n" +
 	"visibility\x18\x05 \x01(\tR\n" +
 	"visibility\x12\x16\n" +
-	"\x06pinned\x18\x06 \x01(\bR\x06pinned\"\x9b\x01\n" +
+	"\x06pinned\x18\x06 \x01(\bR\x06pinned\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\b \x01(\x03R\tpublishAt\"\x9b\x01\n" +
 	"\x11UpdatePostRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12-\n" +
 	"\x04post\x18\x02 \x01(\v2\x14.post.UpdatePostBodyB\x03\xe0A\x02R\x04post\x12@\n" +
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
-	"updateMask\"*\n" +
+	"updateMask\"z\n" +
+	"\x18ListPostRevisionsRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12*\n" +
+	"\x11cursor_created_at\x18\x02 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x03 \x01(\tR\bcursorId\"\xb7\x01\n" +
+	"\x19ListPostRevisionsResponse\x125\n" +
+	"\trevisions\x18\x01 \x03(\v2\x12.post.PostRevisionB\x03\xe0A\x02R\trevisions\x128\n" +
+	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"*\n" +
 	"\x11DeletePostRequest\x12\x15\n" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\"V\n" +
 	"\x0fLikePostRequest\x12\x15\n" +
@@ -1206,22 +2091,39 @@ const file_post_proto_rawDesc = "" +
 	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
 	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"0\n" +
 	"\x13CollectPostResponse\x12\x19\n" +
-	"\x05count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05count2\xa7\b\n" +
+	"\x05count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05count\"X\n" +
+	"\x11RepostPostRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"/\n" +
+	"\x12RepostPostResponse\x12\x19\n" +
+	"\x05count\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05count\"G\n" +
+	"\x0fVotePollRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x1d\n" +
+	"\achoices\x18\x02 \x03(\x05B\x03\xe0A\x02R\achoices\"7\n" +
+	"\x10VotePollResponse\x12#\n" +
+	"\x04poll\x18\x01 \x01(\v2\n" +
+	".post.PollB\x03\xe0A\x02R\x04poll2\xb0\f\n" +
 	"\vPostService\x12Y\n" +
 	"\n" +
 	"CreatePost\x12\x17.post.CreatePostRequest\x1a\x18.post.CreatePostResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/posts\x12S\n" +
 	"\tListPosts\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/posts\x12o\n" +
 	"\x11ListPostsByAuthor\x12\x1e.post.ListPostsByAuthorRequest\x1a\x17.post.ListPostsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/users/{uid}/posts\x12X\n" +
-	"\vListMyPosts\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/me/posts\x12d\n" +
+	"\vListMyPosts\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/me/posts\x12Z\n" +
+	"\fListMyDrafts\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/me/drafts\x12`\n" +
+	"\x10ListHomeTimeline\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/me/timeline\x12d\n" +
 	"\x11ListMyCollections\x12\x16.post.ListPostsRequest\x1a\x17.post.ListPostsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/me/collections\x12S\n" +
 	"\aGetPost\x12\x14.post.GetPostRequest\x1a\x15.post.GetPostResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/posts/{uid}\x12X\n" +
 	"\tGetMyPost\x12\x14.post.GetPostRequest\x1a\x15.post.GetPostResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/me/posts/{uid}\x12`\n" +
 	"\n" +
-	"UpdatePost\x12\x17.post.UpdatePostRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x04post2\x13/api/v1/posts/{uid}\x12Z\n" +
+	"UpdatePost\x12\x17.post.UpdatePostRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x04post2\x13/api/v1/posts/{uid}\x12{\n" +
+	"\x11ListPostRevisions\x12\x1e.post.ListPostRevisionsRequest\x1a\x1f.post.ListPostRevisionsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/posts/{uid}/revisions\x12Z\n" +
 	"\n" +
 	"DeletePost\x12\x17.post.DeletePostRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/posts/{uid}\x12^\n" +
 	"\bLikePost\x12\x15.post.LikePostRequest\x1a\x16.post.LikePostResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/posts/{uid}/like\x12j\n" +
-	"\vCollectPost\x12\x18.post.CollectPostRequest\x1a\x19.post.CollectPostResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/posts/{uid}/collectB\x0fZ\raeibi/api;apib\x06proto3"
+	"\vCollectPost\x12\x18.post.CollectPostRequest\x1a\x19.post.CollectPostResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/posts/{uid}/collect\x12d\n" +
+	"\bVotePoll\x12\x15.post.VotePollRequest\x1a\x16.post.VotePollResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/posts/{uid}/poll/votes\x12f\n" +
+	"\n" +
+	"RepostPost\x12\x17.post.RepostPostRequest\x1a\x18.post.RepostPostResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/posts/{uid}/repostB\x0fZ\raeibi/api;apib\x06proto3"
 
 var (
 	file_post_proto_rawDescOnce sync.Once
@@ -1235,65 +2137,97 @@ func file_post_proto_rawDescGZIP() []byte {
 	return file_post_proto_rawDescData
 }
 
-var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_post_proto_goTypes = []any{
-	(*PostAuthor)(nil),               // 0: post.PostAuthor
-	(*Attachment)(nil),               // 1: post.Attachment
-	(*Post)(nil),                     // 2: post.Post
-	(*CreatePostRequest)(nil),        // 3: post.CreatePostRequest
-	(*CreatePostResponse)(nil),       // 4: post.CreatePostResponse
-	(*ListPostsRequest)(nil),         // 5: post.ListPostsRequest
-	(*ListPostsByAuthorRequest)(nil), // 6: post.ListPostsByAuthorRequest
-	(*ListPostsResponse)(nil),        // 7: post.ListPostsResponse
-	(*GetPostRequest)(nil),           // 8: post.GetPostRequest
-	(*GetPostResponse)(nil),          // 9: post.GetPostResponse
-	(*UpdatePostBody)(nil),           // 10: post.UpdatePostBody
-	(*UpdatePostRequest)(nil),        // 11: post.UpdatePostRequest
-	(*DeletePostRequest)(nil),        // 12: post.DeletePostRequest
-	(*LikePostRequest)(nil),          // 13: post.LikePostRequest
-	(*LikePostResponse)(nil),         // 14: post.LikePostResponse
-	(*CollectPostRequest)(nil),       // 15: post.CollectPostRequest
-	(*CollectPostResponse)(nil),      // 16: post.CollectPostResponse
-	(*fieldmaskpb.FieldMask)(nil),    // 17: google.protobuf.FieldMask
-	(ToggleAction)(0),                // 18: common.ToggleAction
-	(*emptypb.Empty)(nil),            // 19: google.protobuf.Empty
+	(*PostAuthor)(nil),                // 0: post.PostAuthor
+	(*Attachment)(nil),                // 1: post.Attachment
+	(*Post)(nil),                      // 2: post.Post
+	(*Poll)(nil),                      // 3: post.Poll
+	(*PollOption)(nil),                // 4: post.PollOption
+	(*PostRevision)(nil),              // 5: post.PostRevision
+	(*CreatePostRequest)(nil),         // 6: post.CreatePostRequest
+	(*CreatePollBody)(nil),            // 7: post.CreatePollBody
+	(*CreatePostResponse)(nil),        // 8: post.CreatePostResponse
+	(*ListPostsRequest)(nil),          // 9: post.ListPostsRequest
+	(*ListPostsByAuthorRequest)(nil),  // 10: post.ListPostsByAuthorRequest
+	(*ListPostsResponse)(nil),         // 11: post.ListPostsResponse
+	(*GetPostRequest)(nil),            // 12: post.GetPostRequest
+	(*GetPostResponse)(nil),           // 13: post.GetPostResponse
+	(*UpdatePostBody)(nil),            // 14: post.UpdatePostBody
+	(*UpdatePostRequest)(nil),         // 15: post.UpdatePostRequest
+	(*ListPostRevisionsRequest)(nil),  // 16: post.ListPostRevisionsRequest
+	(*ListPostRevisionsResponse)(nil), // 17: post.ListPostRevisionsResponse
+	(*DeletePostRequest)(nil),         // 18: post.DeletePostRequest
+	(*LikePostRequest)(nil),           // 19: post.LikePostRequest
+	(*LikePostResponse)(nil),          // 20: post.LikePostResponse
+	(*CollectPostRequest)(nil),        // 21: post.CollectPostRequest
+	(*CollectPostResponse)(nil),       // 22: post.CollectPostResponse
+	(*RepostPostRequest)(nil),         // 23: post.RepostPostRequest
+	(*RepostPostResponse)(nil),        // 24: post.RepostPostResponse
+	(*VotePollRequest)(nil),           // 25: post.VotePollRequest
+	(*VotePollResponse)(nil),          // 26: post.VotePollResponse
+	(*TextEntity)(nil),                // 27: common.TextEntity
+	(*fieldmaskpb.FieldMask)(nil),     // 28: google.protobuf.FieldMask
+	(ToggleAction)(0),                 // 29: common.ToggleAction
+	(*emptypb.Empty)(nil),             // 30: google.protobuf.Empty
 }
 var file_post_proto_depIdxs = []int32{
 	0,  // 0: post.Post.author:type_name -> post.PostAuthor
 	1,  // 1: post.Post.attachments:type_name -> post.Attachment
-	2,  // 2: post.ListPostsResponse.posts:type_name -> post.Post
-	2,  // 3: post.GetPostResponse.post:type_name -> post.Post
-	10, // 4: post.UpdatePostRequest.post:type_name -> post.UpdatePostBody
-	17, // 5: post.UpdatePostRequest.update_mask:type_name -> google.protobuf.FieldMask
-	18, // 6: post.LikePostRequest.action:type_name -> common.ToggleAction
-	18, // 7: post.CollectPostRequest.action:type_name -> common.ToggleAction
-	3,  // 8: post.PostService.CreatePost:input_type -> post.CreatePostRequest
-	5,  // 9: post.PostService.ListPosts:input_type -> post.ListPostsRequest
-	6,  // 10: post.PostService.ListPostsByAuthor:input_type -> post.ListPostsByAuthorRequest
-	5,  // 11: post.PostService.ListMyPosts:input_type -> post.ListPostsRequest
-	5,  // 12: post.PostService.ListMyCollections:input_type -> post.ListPostsRequest
-	8,  // 13: post.PostService.GetPost:input_type -> post.GetPostRequest
-	8,  // 14: post.PostService.GetMyPost:input_type -> post.GetPostRequest
-	11, // 15: post.PostService.UpdatePost:input_type -> post.UpdatePostRequest
-	12, // 16: post.PostService.DeletePost:input_type -> post.DeletePostRequest
-	13, // 17: post.PostService.LikePost:input_type -> post.LikePostRequest
-	15, // 18: post.PostService.CollectPost:input_type -> post.CollectPostRequest
-	4,  // 19: post.PostService.CreatePost:output_type -> post.CreatePostResponse
-	7,  // 20: post.PostService.ListPosts:output_type -> post.ListPostsResponse
-	7,  // 21: post.PostService.ListPostsByAuthor:output_type -> post.ListPostsResponse
-	7,  // 22: post.PostService.ListMyPosts:output_type -> post.ListPostsResponse
-	7,  // 23: post.PostService.ListMyCollections:output_type -> post.ListPostsResponse
-	9,  // 24: post.PostService.GetPost:output_type -> post.GetPostResponse
-	9,  // 25: post.PostService.GetMyPost:output_type -> post.GetPostResponse
-	19, // 26: post.PostService.UpdatePost:output_type -> google.protobuf.Empty
-	19, // 27: post.PostService.DeletePost:output_type -> google.protobuf.Empty
-	14, // 28: post.PostService.LikePost:output_type -> post.LikePostResponse
-	16, // 29: post.PostService.CollectPost:output_type -> post.CollectPostResponse
-	19, // [19:30] is the sub-list for method output_type
-	8,  // [8:19] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	2,  // 2: post.Post.repost_of:type_name -> post.Post
+	2,  // 3: post.Post.quoted_post:type_name -> post.Post
+	27, // 4: post.Post.entities:type_name -> common.TextEntity
+	3,  // 5: post.Post.poll:type_name -> post.Poll
+	4,  // 6: post.Poll.options:type_name -> post.PollOption
+	27, // 7: post.PostRevision.entities:type_name -> common.TextEntity
+	1,  // 8: post.PostRevision.attachments:type_name -> post.Attachment
+	7,  // 9: post.CreatePostRequest.poll:type_name -> post.CreatePollBody
+	2,  // 10: post.ListPostsResponse.posts:type_name -> post.Post
+	2,  // 11: post.GetPostResponse.post:type_name -> post.Post
+	14, // 12: post.UpdatePostRequest.post:type_name -> post.UpdatePostBody
+	28, // 13: post.UpdatePostRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 14: post.ListPostRevisionsResponse.revisions:type_name -> post.PostRevision
+	29, // 15: post.LikePostRequest.action:type_name -> common.ToggleAction
+	29, // 16: post.CollectPostRequest.action:type_name -> common.ToggleAction
+	29, // 17: post.RepostPostRequest.action:type_name -> common.ToggleAction
+	3,  // 18: post.VotePollResponse.poll:type_name -> post.Poll
+	6,  // 19: post.PostService.CreatePost:input_type -> post.CreatePostRequest
+	9,  // 20: post.PostService.ListPosts:input_type -> post.ListPostsRequest
+	10, // 21: post.PostService.ListPostsByAuthor:input_type -> post.ListPostsByAuthorRequest
+	9,  // 22: post.PostService.ListMyPosts:input_type -> post.ListPostsRequest
+	9,  // 23: post.PostService.ListMyDrafts:input_type -> post.ListPostsRequest
+	9,  // 24: post.PostService.ListHomeTimeline:input_type -> post.ListPostsRequest
+	9,  // 25: post.PostService.ListMyCollections:input_type -> post.ListPostsRequest
+	12, // 26: post.PostService.GetPost:input_type -> post.GetPostRequest
+	12, // 27: post.PostService.GetMyPost:input_type -> post.GetPostRequest
+	15, // 28: post.PostService.UpdatePost:input_type -> post.UpdatePostRequest
+	16, // 29: post.PostService.ListPostRevisions:input_type -> post.ListPostRevisionsRequest
+	18, // 30: post.PostService.DeletePost:input_type -> post.DeletePostRequest
+	19, // 31: post.PostService.LikePost:input_type -> post.LikePostRequest
+	21, // 32: post.PostService.CollectPost:input_type -> post.CollectPostRequest
+	25, // 33: post.PostService.VotePoll:input_type -> post.VotePollRequest
+	23, // 34: post.PostService.RepostPost:input_type -> post.RepostPostRequest
+	8,  // 35: post.PostService.CreatePost:output_type -> post.CreatePostResponse
+	11, // 36: post.PostService.ListPosts:output_type -> post.ListPostsResponse
+	11, // 37: post.PostService.ListPostsByAuthor:output_type -> post.ListPostsResponse
+	11, // 38: post.PostService.ListMyPosts:output_type -> post.ListPostsResponse
+	11, // 39: post.PostService.ListMyDrafts:output_type -> post.ListPostsResponse
+	11, // 40: post.PostService.ListHomeTimeline:output_type -> post.ListPostsResponse
+	11, // 41: post.PostService.ListMyCollections:output_type -> post.ListPostsResponse
+	13, // 42: post.PostService.GetPost:output_type -> post.GetPostResponse
+	13, // 43: post.PostService.GetMyPost:output_type -> post.GetPostResponse
+	30, // 44: post.PostService.UpdatePost:output_type -> google.protobuf.Empty
+	17, // 45: post.PostService.ListPostRevisions:output_type -> post.ListPostRevisionsResponse
+	30, // 46: post.PostService.DeletePost:output_type -> google.protobuf.Empty
+	20, // 47: post.PostService.LikePost:output_type -> post.LikePostResponse
+	22, // 48: post.PostService.CollectPost:output_type -> post.CollectPostResponse
+	26, // 49: post.PostService.VotePoll:output_type -> post.VotePollResponse
+	24, // 50: post.PostService.RepostPost:output_type -> post.RepostPostResponse
+	35, // [35:51] is the sub-list for method output_type
+	19, // [19:35] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_post_proto_init() }
@@ -1308,7 +2242,7 @@ func file_post_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_post_proto_rawDesc), len(file_post_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
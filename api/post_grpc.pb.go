@@ -24,13 +24,18 @@ const (
 	PostService_ListPosts_FullMethodName         = "/post.PostService/ListPosts"
 	PostService_ListPostsByAuthor_FullMethodName = "/post.PostService/ListPostsByAuthor"
 	PostService_ListMyPosts_FullMethodName       = "/post.PostService/ListMyPosts"
+	PostService_ListMyDrafts_FullMethodName      = "/post.PostService/ListMyDrafts"
+	PostService_ListHomeTimeline_FullMethodName  = "/post.PostService/ListHomeTimeline"
 	PostService_ListMyCollections_FullMethodName = "/post.PostService/ListMyCollections"
 	PostService_GetPost_FullMethodName           = "/post.PostService/GetPost"
 	PostService_GetMyPost_FullMethodName         = "/post.PostService/GetMyPost"
 	PostService_UpdatePost_FullMethodName        = "/post.PostService/UpdatePost"
+	PostService_ListPostRevisions_FullMethodName = "/post.PostService/ListPostRevisions"
 	PostService_DeletePost_FullMethodName        = "/post.PostService/DeletePost"
 	PostService_LikePost_FullMethodName          = "/post.PostService/LikePost"
 	PostService_CollectPost_FullMethodName       = "/post.PostService/CollectPost"
+	PostService_VotePoll_FullMethodName          = "/post.PostService/VotePoll"
+	PostService_RepostPost_FullMethodName        = "/post.PostService/RepostPost"
 )
 
 // PostServiceClient is the client API for PostService service.
@@ -47,6 +52,10 @@ type PostServiceClient interface {
 	ListPostsByAuthor(ctx context.Context, in *ListPostsByAuthorRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
 	// GET /api/v1/me/posts 当前用户发布的列表（含 PRIVATE）
 	ListMyPosts(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	// GET /api/v1/me/drafts 当前用户的草稿及定时发布的帖子
+	ListMyDrafts(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	// GET /api/v1/me/timeline 当前用户关注的人、关注的话题及自己发布的帖子列表
+	ListHomeTimeline(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
 	// GET /api/v1/me/collections 当前用户收藏的帖子列表
 	ListMyCollections(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
 	// GET /api/v1/posts/{uid} 详情
@@ -55,12 +64,18 @@ type PostServiceClient interface {
 	GetMyPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
 	// PATCH /api/v1/posts/{uid} 更新正文/媒体/标签/可见性
 	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/posts/{uid}/revisions 编辑历史（新→旧）
+	ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error)
 	// DELETE /api/v1/posts/{uid} 软删
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// POST /api/v1/posts/{uid}/like 点赞或取消赞
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
 	// POST /api/v1/posts/{uid}/collect 收藏或取消收藏
 	CollectPost(ctx context.Context, in *CollectPostRequest, opts ...grpc.CallOption) (*CollectPostResponse, error)
+	// POST /api/v1/posts/{uid}/poll/votes 投票（每人一次）
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	// POST /api/v1/posts/{uid}/repost 转发或取消转发
+	RepostPost(ctx context.Context, in *RepostPostRequest, opts ...grpc.CallOption) (*RepostPostResponse, error)
 }
 
 type postServiceClient struct {
@@ -111,6 +126,26 @@ func (c *postServiceClient) ListMyPosts(ctx context.Context, in *ListPostsReques
 	return out, nil
 }
 
+func (c *postServiceClient) ListMyDrafts(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListMyDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListHomeTimeline(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListHomeTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) ListMyCollections(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPostsResponse)
@@ -151,6 +186,16 @@ func (c *postServiceClient) UpdatePost(ctx context.Context, in *UpdatePostReques
 	return out, nil
 }
 
+func (c *postServiceClient) ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListPostRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -181,6 +226,26 @@ func (c *postServiceClient) CollectPost(ctx context.Context, in *CollectPostRequ
 	return out, nil
 }
 
+func (c *postServiceClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, PostService_VotePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RepostPost(ctx context.Context, in *RepostPostRequest, opts ...grpc.CallOption) (*RepostPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepostPostResponse)
+	err := c.cc.Invoke(ctx, PostService_RepostPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostServiceServer is the server API for PostService service.
 // All implementations must embed UnimplementedPostServiceServer
 // for forward compatibility.
@@ -195,6 +260,10 @@ type PostServiceServer interface {
 	ListPostsByAuthor(context.Context, *ListPostsByAuthorRequest) (*ListPostsResponse, error)
 	// GET /api/v1/me/posts 当前用户发布的列表（含 PRIVATE）
 	ListMyPosts(context.Context, *ListPostsRequest) (*ListPostsResponse, error)
+	// GET /api/v1/me/drafts 当前用户的草稿及定时发布的帖子
+	ListMyDrafts(context.Context, *ListPostsRequest) (*ListPostsResponse, error)
+	// GET /api/v1/me/timeline 当前用户关注的人、关注的话题及自己发布的帖子列表
+	ListHomeTimeline(context.Context, *ListPostsRequest) (*ListPostsResponse, error)
 	// GET /api/v1/me/collections 当前用户收藏的帖子列表
 	ListMyCollections(context.Context, *ListPostsRequest) (*ListPostsResponse, error)
 	// GET /api/v1/posts/{uid} 详情
@@ -203,12 +272,18 @@ type PostServiceServer interface {
 	GetMyPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
 	// PATCH /api/v1/posts/{uid} 更新正文/媒体/标签/可见性
 	UpdatePost(context.Context, *UpdatePostRequest) (*emptypb.Empty, error)
+	// GET /api/v1/posts/{uid}/revisions 编辑历史（新→旧）
+	ListPostRevisions(context.Context, *ListPostRevisionsRequest) (*ListPostRevisionsResponse, error)
 	// DELETE /api/v1/posts/{uid} 软删
 	DeletePost(context.Context, *DeletePostRequest) (*emptypb.Empty, error)
 	// POST /api/v1/posts/{uid}/like 点赞或取消赞
 	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
 	// POST /api/v1/posts/{uid}/collect 收藏或取消收藏
 	CollectPost(context.Context, *CollectPostRequest) (*CollectPostResponse, error)
+	// POST /api/v1/posts/{uid}/poll/votes 投票（每人一次）
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	// POST /api/v1/posts/{uid}/repost 转发或取消转发
+	RepostPost(context.Context, *RepostPostRequest) (*RepostPostResponse, error)
 	mustEmbedUnimplementedPostServiceServer()
 }
 
@@ -231,6 +306,12 @@ func (UnimplementedPostServiceServer) ListPostsByAuthor(context.Context, *ListPo
 func (UnimplementedPostServiceServer) ListMyPosts(context.Context, *ListPostsRequest) (*ListPostsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMyPosts not implemented")
 }
+func (UnimplementedPostServiceServer) ListMyDrafts(context.Context, *ListPostsRequest) (*ListPostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyDrafts not implemented")
+}
+func (UnimplementedPostServiceServer) ListHomeTimeline(context.Context, *ListPostsRequest) (*ListPostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHomeTimeline not implemented")
+}
 func (UnimplementedPostServiceServer) ListMyCollections(context.Context, *ListPostsRequest) (*ListPostsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMyCollections not implemented")
 }
@@ -243,6 +324,9 @@ func (UnimplementedPostServiceServer) GetMyPost(context.Context, *GetPostRequest
 func (UnimplementedPostServiceServer) UpdatePost(context.Context, *UpdatePostRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdatePost not implemented")
 }
+func (UnimplementedPostServiceServer) ListPostRevisions(context.Context, *ListPostRevisionsRequest) (*ListPostRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPostRevisions not implemented")
+}
 func (UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePost not implemented")
 }
@@ -252,6 +336,12 @@ func (UnimplementedPostServiceServer) LikePost(context.Context, *LikePostRequest
 func (UnimplementedPostServiceServer) CollectPost(context.Context, *CollectPostRequest) (*CollectPostResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CollectPost not implemented")
 }
+func (UnimplementedPostServiceServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedPostServiceServer) RepostPost(context.Context, *RepostPostRequest) (*RepostPostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RepostPost not implemented")
+}
 func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
 func (UnimplementedPostServiceServer) testEmbeddedByValue()                     {}
 
@@ -345,6 +435,42 @@ func _PostService_ListMyPosts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_ListMyDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListMyDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListMyDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListMyDrafts(ctx, req.(*ListPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListHomeTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListHomeTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListHomeTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListHomeTimeline(ctx, req.(*ListPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_ListMyCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPostsRequest)
 	if err := dec(in); err != nil {
@@ -417,6 +543,24 @@ func _PostService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_ListPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListPostRevisions(ctx, req.(*ListPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeletePostRequest)
 	if err := dec(in); err != nil {
@@ -471,6 +615,42 @@ func _PostService_CollectPost_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_VotePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RepostPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepostPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RepostPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RepostPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RepostPost(ctx, req.(*RepostPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PostService_ServiceDesc is the grpc.ServiceDesc for PostService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -494,6 +674,14 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMyPosts",
 			Handler:    _PostService_ListMyPosts_Handler,
 		},
+		{
+			MethodName: "ListMyDrafts",
+			Handler:    _PostService_ListMyDrafts_Handler,
+		},
+		{
+			MethodName: "ListHomeTimeline",
+			Handler:    _PostService_ListHomeTimeline_Handler,
+		},
 		{
 			MethodName: "ListMyCollections",
 			Handler:    _PostService_ListMyCollections_Handler,
@@ -510,6 +698,10 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePost",
 			Handler:    _PostService_UpdatePost_Handler,
 		},
+		{
+			MethodName: "ListPostRevisions",
+			Handler:    _PostService_ListPostRevisions_Handler,
+		},
 		{
 			MethodName: "DeletePost",
 			Handler:    _PostService_DeletePost_Handler,
@@ -522,6 +714,14 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollectPost",
 			Handler:    _PostService_CollectPost_Handler,
 		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _PostService_VotePoll_Handler,
+		},
+		{
+			MethodName: "RepostPost",
+			Handler:    _PostService_RepostPost_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "post.proto",
@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: relation.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Block
+type BlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_relation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_relation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *BlockRequest) GetAction() ToggleAction {
+	if x != nil {
+		return x.Action
+	}
+	return ToggleAction_TOGGLE_ACTION_ADD
+}
+
+// Mute
+type MuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	mi := &file_relation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequest) ProtoMessage() {}
+
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_relation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MuteRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *MuteRequest) GetAction() ToggleAction {
+	if x != nil {
+		return x.Action
+	}
+	return ToggleAction_TOGGLE_ACTION_ADD
+}
+
+// List
+type ListMyBlocksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CursorCreatedAt int64                  `protobuf:"varint,1,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,2,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMyBlocksRequest) Reset() {
+	*x = ListMyBlocksRequest{}
+	mi := &file_relation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBlocksRequest) ProtoMessage() {}
+
+func (x *ListMyBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_relation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMyBlocksRequest) GetCursorCreatedAt() int64 {
+	if x != nil {
+		return x.CursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListMyBlocksRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListMyBlocksResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Users               []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursorCreatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_created_at,json=nextCursorCreatedAt,proto3" json:"next_cursor_created_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListMyBlocksResponse) Reset() {
+	*x = ListMyBlocksResponse{}
+	mi := &file_relation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBlocksResponse) ProtoMessage() {}
+
+func (x *ListMyBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListMyBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_relation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListMyBlocksResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListMyBlocksResponse) GetNextCursorCreatedAt() int64 {
+	if x != nil {
+		return x.NextCursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListMyBlocksResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+type ListMyMutesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CursorCreatedAt int64                  `protobuf:"varint,1,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,2,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMyMutesRequest) Reset() {
+	*x = ListMyMutesRequest{}
+	mi := &file_relation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyMutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyMutesRequest) ProtoMessage() {}
+
+func (x *ListMyMutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyMutesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyMutesRequest) Descriptor() ([]byte, []int) {
+	return file_relation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMyMutesRequest) GetCursorCreatedAt() int64 {
+	if x != nil {
+		return x.CursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListMyMutesRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListMyMutesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Users               []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursorCreatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_created_at,json=nextCursorCreatedAt,proto3" json:"next_cursor_created_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListMyMutesResponse) Reset() {
+	*x = ListMyMutesResponse{}
+	mi := &file_relation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyMutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyMutesResponse) ProtoMessage() {}
+
+func (x *ListMyMutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyMutesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyMutesResponse) Descriptor() ([]byte, []int) {
+	return file_relation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMyMutesResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListMyMutesResponse) GetNextCursorCreatedAt() int64 {
+	if x != nil {
+		return x.NextCursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListMyMutesResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+var File_relation_proto protoreflect.FileDescriptor
+
+const file_relation_proto_rawDesc = "" +
+	"\n" +
+	"\x0erelation.proto\x12\brelation\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\"S\n" +
+	"\fBlockRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"R\n" +
+	"\vMuteRequest\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12,\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"^\n" +
+	"\x13ListMyBlocksRequest\x12*\n" +
+	"\x11cursor_created_at\x18\x01 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x02 \x01(\tR\bcursorId\"\xa4\x01\n" +
+	"\x14ListMyBlocksResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x128\n" +
+	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"]\n" +
+	"\x12ListMyMutesRequest\x12*\n" +
+	"\x11cursor_created_at\x18\x01 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x02 \x01(\tR\bcursorId\"\xa3\x01\n" +
+	"\x13ListMyMutesResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\f.common.UserB\x03\xe0A\x02R\x05users\x128\n" +
+	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId2\x9c\x03\n" +
+	"\x0fRelationService\x12]\n" +
+	"\x05Block\x12\x16.relation.BlockRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/users/{uid}/block\x12Z\n" +
+	"\x04Mute\x12\x15.relation.MuteRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/users/{uid}/mute\x12h\n" +
+	"\fListMyBlocks\x12\x1d.relation.ListMyBlocksRequest\x1a\x1e.relation.ListMyBlocksResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/me/blocks\x12d\n" +
+	"\vListMyMutes\x12\x1c.relation.ListMyMutesRequest\x1a\x1d.relation.ListMyMutesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/me/mutesB\x0fZ\raeibi/api;apib\x06proto3"
+
+var (
+	file_relation_proto_rawDescOnce sync.Once
+	file_relation_proto_rawDescData []byte
+)
+
+func file_relation_proto_rawDescGZIP() []byte {
+	file_relation_proto_rawDescOnce.Do(func() {
+		file_relation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_relation_proto_rawDesc), len(file_relation_proto_rawDesc)))
+	})
+	return file_relation_proto_rawDescData
+}
+
+var file_relation_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_relation_proto_goTypes = []any{
+	(*BlockRequest)(nil),         // 0: relation.BlockRequest
+	(*MuteRequest)(nil),          // 1: relation.MuteRequest
+	(*ListMyBlocksRequest)(nil),  // 2: relation.ListMyBlocksRequest
+	(*ListMyBlocksResponse)(nil), // 3: relation.ListMyBlocksResponse
+	(*ListMyMutesRequest)(nil),   // 4: relation.ListMyMutesRequest
+	(*ListMyMutesResponse)(nil),  // 5: relation.ListMyMutesResponse
+	(ToggleAction)(0),            // 6: common.ToggleAction
+	(*User)(nil),                 // 7: common.User
+	(*emptypb.Empty)(nil),        // 8: google.protobuf.Empty
+}
+var file_relation_proto_depIdxs = []int32{
+	6, // 0: relation.BlockRequest.action:type_name -> common.ToggleAction
+	6, // 1: relation.MuteRequest.action:type_name -> common.ToggleAction
+	7, // 2: relation.ListMyBlocksResponse.users:type_name -> common.User
+	7, // 3: relation.ListMyMutesResponse.users:type_name -> common.User
+	0, // 4: relation.RelationService.Block:input_type -> relation.BlockRequest
+	1, // 5: relation.RelationService.Mute:input_type -> relation.MuteRequest
+	2, // 6: relation.RelationService.ListMyBlocks:input_type -> relation.ListMyBlocksRequest
+	4, // 7: relation.RelationService.ListMyMutes:input_type -> relation.ListMyMutesRequest
+	8, // 8: relation.RelationService.Block:output_type -> google.protobuf.Empty
+	8, // 9: relation.RelationService.Mute:output_type -> google.protobuf.Empty
+	3, // 10: relation.RelationService.ListMyBlocks:output_type -> relation.ListMyBlocksResponse
+	5, // 11: relation.RelationService.ListMyMutes:output_type -> relation.ListMyMutesResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_relation_proto_init() }
+func file_relation_proto_init() {
+	if File_relation_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_relation_proto_rawDesc), len(file_relation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_relation_proto_goTypes,
+		DependencyIndexes: file_relation_proto_depIdxs,
+		MessageInfos:      file_relation_proto_msgTypes,
+	}.Build()
+	File_relation_proto = out.File
+	file_relation_proto_goTypes = nil
+	file_relation_proto_depIdxs = nil
+}
@@ -0,0 +1,248 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: relation.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RelationService_Block_FullMethodName        = "/relation.RelationService/Block"
+	RelationService_Mute_FullMethodName         = "/relation.RelationService/Mute"
+	RelationService_ListMyBlocks_FullMethodName = "/relation.RelationService/ListMyBlocks"
+	RelationService_ListMyMutes_FullMethodName  = "/relation.RelationService/ListMyMutes"
+)
+
+// RelationServiceClient is the client API for RelationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RelationService
+type RelationServiceClient interface {
+	// POST /api/v1/users/{uid}/block 拉黑或取消拉黑
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/users/{uid}/mute 屏蔽或取消屏蔽
+	Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GET /api/v1/me/blocks 黑名单
+	ListMyBlocks(ctx context.Context, in *ListMyBlocksRequest, opts ...grpc.CallOption) (*ListMyBlocksResponse, error)
+	// GET /api/v1/me/mutes 屏蔽列表
+	ListMyMutes(ctx context.Context, in *ListMyMutesRequest, opts ...grpc.CallOption) (*ListMyMutesResponse, error)
+}
+
+type relationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelationServiceClient(cc grpc.ClientConnInterface) RelationServiceClient {
+	return &relationServiceClient{cc}
+}
+
+func (c *relationServiceClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RelationService_Block_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RelationService_Mute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) ListMyBlocks(ctx context.Context, in *ListMyBlocksRequest, opts ...grpc.CallOption) (*ListMyBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyBlocksResponse)
+	err := c.cc.Invoke(ctx, RelationService_ListMyBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) ListMyMutes(ctx context.Context, in *ListMyMutesRequest, opts ...grpc.CallOption) (*ListMyMutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyMutesResponse)
+	err := c.cc.Invoke(ctx, RelationService_ListMyMutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelationServiceServer is the server API for RelationService service.
+// All implementations must embed UnimplementedRelationServiceServer
+// for forward compatibility.
+//
+// RelationService
+type RelationServiceServer interface {
+	// POST /api/v1/users/{uid}/block 拉黑或取消拉黑
+	Block(context.Context, *BlockRequest) (*emptypb.Empty, error)
+	// POST /api/v1/users/{uid}/mute 屏蔽或取消屏蔽
+	Mute(context.Context, *MuteRequest) (*emptypb.Empty, error)
+	// GET /api/v1/me/blocks 黑名单
+	ListMyBlocks(context.Context, *ListMyBlocksRequest) (*ListMyBlocksResponse, error)
+	// GET /api/v1/me/mutes 屏蔽列表
+	ListMyMutes(context.Context, *ListMyMutesRequest) (*ListMyMutesResponse, error)
+	mustEmbedUnimplementedRelationServiceServer()
+}
+
+// UnimplementedRelationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRelationServiceServer struct{}
+
+func (UnimplementedRelationServiceServer) Block(context.Context, *BlockRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedRelationServiceServer) Mute(context.Context, *MuteRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Mute not implemented")
+}
+func (UnimplementedRelationServiceServer) ListMyBlocks(context.Context, *ListMyBlocksRequest) (*ListMyBlocksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyBlocks not implemented")
+}
+func (UnimplementedRelationServiceServer) ListMyMutes(context.Context, *ListMyMutesRequest) (*ListMyMutesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyMutes not implemented")
+}
+func (UnimplementedRelationServiceServer) mustEmbedUnimplementedRelationServiceServer() {}
+func (UnimplementedRelationServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeRelationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationServiceServer will
+// result in compilation errors.
+type UnsafeRelationServiceServer interface {
+	mustEmbedUnimplementedRelationServiceServer()
+}
+
+func RegisterRelationServiceServer(s grpc.ServiceRegistrar, srv RelationServiceServer) {
+	// If the following call panics, it indicates UnimplementedRelationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RelationService_ServiceDesc, srv)
+}
+
+func _RelationService_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_Mute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).Mute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_Mute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).Mute(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_ListMyBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).ListMyBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_ListMyBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).ListMyBlocks(ctx, req.(*ListMyBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_ListMyMutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyMutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).ListMyMutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationService_ListMyMutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).ListMyMutes(ctx, req.(*ListMyMutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RelationService_ServiceDesc is the grpc.ServiceDesc for RelationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "relation.RelationService",
+	HandlerType: (*RelationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Block",
+			Handler:    _RelationService_Block_Handler,
+		},
+		{
+			MethodName: "Mute",
+			Handler:    _RelationService_Mute_Handler,
+		},
+		{
+			MethodName: "ListMyBlocks",
+			Handler:    _RelationService_ListMyBlocks_Handler,
+		},
+		{
+			MethodName: "ListMyMutes",
+			Handler:    _RelationService_ListMyMutes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "relation.proto",
+}
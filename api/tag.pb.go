@@ -0,0 +1,904 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: tag.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Models
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PostCount     int32                  `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	FollowerCount int32                  `protobuf:"varint,4,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	IsFollowing   bool                   `protobuf:"varint,5,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_tag_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tag) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *Tag) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *Tag) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+// Get
+type GetTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagRequest) Reset() {
+	*x = GetTagRequest{}
+	mi := &file_tag_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagRequest) ProtoMessage() {}
+
+func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
+func (*GetTagRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagResponse) Reset() {
+	*x = GetTagResponse{}
+	mi := &file_tag_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagResponse) ProtoMessage() {}
+
+func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagResponse.ProtoReflect.Descriptor instead.
+func (*GetTagResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// List
+type ListPostsByTagRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CursorCreatedAt int64                  `protobuf:"varint,2,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,3,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPostsByTagRequest) Reset() {
+	*x = ListPostsByTagRequest{}
+	mi := &file_tag_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsByTagRequest) ProtoMessage() {}
+
+func (x *ListPostsByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListPostsByTagRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPostsByTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListPostsByTagRequest) GetCursorCreatedAt() int64 {
+	if x != nil {
+		return x.CursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListPostsByTagRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type AutocompleteTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteTagsRequest) Reset() {
+	*x = AutocompleteTagsRequest{}
+	mi := &file_tag_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteTagsRequest) ProtoMessage() {}
+
+func (x *AutocompleteTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteTagsRequest.ProtoReflect.Descriptor instead.
+func (*AutocompleteTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AutocompleteTagsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type AutocompleteTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteTagsResponse) Reset() {
+	*x = AutocompleteTagsResponse{}
+	mi := &file_tag_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteTagsResponse) ProtoMessage() {}
+
+func (x *AutocompleteTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteTagsResponse.ProtoReflect.Descriptor instead.
+func (*AutocompleteTagsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AutocompleteTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListTrendingTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowHours   int32                  `protobuf:"varint,1,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"` // default 24, max 720
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingTagsRequest) Reset() {
+	*x = ListTrendingTagsRequest{}
+	mi := &file_tag_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingTagsRequest) ProtoMessage() {}
+
+func (x *ListTrendingTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTrendingTagsRequest) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+type ListTrendingTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingTagsResponse) Reset() {
+	*x = ListTrendingTagsResponse{}
+	mi := &file_tag_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingTagsResponse) ProtoMessage() {}
+
+func (x *ListTrendingTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingTagsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTrendingTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Follow
+type FollowTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action        ToggleAction           `protobuf:"varint,2,opt,name=action,proto3,enum=common.ToggleAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowTagRequest) Reset() {
+	*x = FollowTagRequest{}
+	mi := &file_tag_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowTagRequest) ProtoMessage() {}
+
+func (x *FollowTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowTagRequest.ProtoReflect.Descriptor instead.
+func (*FollowTagRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FollowTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FollowTagRequest) GetAction() ToggleAction {
+	if x != nil {
+		return x.Action
+	}
+	return ToggleAction_TOGGLE_ACTION_ADD
+}
+
+type FollowTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerCount int32                  `protobuf:"varint,1,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowTagResponse) Reset() {
+	*x = FollowTagResponse{}
+	mi := &file_tag_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowTagResponse) ProtoMessage() {}
+
+func (x *FollowTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowTagResponse.ProtoReflect.Descriptor instead.
+func (*FollowTagResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FollowTagResponse) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+type ListMyFollowedTagsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CursorCreatedAt int64                  `protobuf:"varint,1,opt,name=cursor_created_at,json=cursorCreatedAt,proto3" json:"cursor_created_at,omitempty"` // unix seconds
+	CursorId        string                 `protobuf:"bytes,2,opt,name=cursor_id,json=cursorId,proto3" json:"cursor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMyFollowedTagsRequest) Reset() {
+	*x = ListMyFollowedTagsRequest{}
+	mi := &file_tag_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyFollowedTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyFollowedTagsRequest) ProtoMessage() {}
+
+func (x *ListMyFollowedTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyFollowedTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyFollowedTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMyFollowedTagsRequest) GetCursorCreatedAt() int64 {
+	if x != nil {
+		return x.CursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListMyFollowedTagsRequest) GetCursorId() string {
+	if x != nil {
+		return x.CursorId
+	}
+	return ""
+}
+
+type ListMyFollowedTagsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Tags                []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	NextCursorCreatedAt int64                  `protobuf:"varint,2,opt,name=next_cursor_created_at,json=nextCursorCreatedAt,proto3" json:"next_cursor_created_at,omitempty"`
+	NextCursorId        string                 `protobuf:"bytes,3,opt,name=next_cursor_id,json=nextCursorId,proto3" json:"next_cursor_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListMyFollowedTagsResponse) Reset() {
+	*x = ListMyFollowedTagsResponse{}
+	mi := &file_tag_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyFollowedTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyFollowedTagsResponse) ProtoMessage() {}
+
+func (x *ListMyFollowedTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyFollowedTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyFollowedTagsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMyFollowedTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListMyFollowedTagsResponse) GetNextCursorCreatedAt() int64 {
+	if x != nil {
+		return x.NextCursorCreatedAt
+	}
+	return 0
+}
+
+func (x *ListMyFollowedTagsResponse) GetNextCursorId() string {
+	if x != nil {
+		return x.NextCursorId
+	}
+	return ""
+}
+
+// Admin
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_tag_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MergeTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_tag_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MergeTagsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MergeTagsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *MergeTagsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_tag_proto protoreflect.FileDescriptor
+
+const file_tag_proto_rawDesc = "" +
+	"\n" +
+	"\ttag.proto\x12\x03tag\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\fcommon.proto\x1a\n" +
+	"post.proto\"\xa3\x01\n" +
+	"\x03Tag\x12\x15\n" +
+	"\x03uid\x18\x01 \x01(\tB\x03\xe0A\x02R\x03uid\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12\"\n" +
+	"\n" +
+	"post_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\tpostCount\x12%\n" +
+	"\x0efollower_count\x18\x04 \x01(\x05R\rfollowerCount\x12!\n" +
+	"\fis_following\x18\x05 \x01(\bR\visFollowing\"(\n" +
+	"\rGetTagRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"1\n" +
+	"\x0eGetTagResponse\x12\x1f\n" +
+	"\x03tag\x18\x01 \x01(\v2\b.tag.TagB\x03\xe0A\x02R\x03tag\"y\n" +
+	"\x15ListPostsByTagRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12*\n" +
+	"\x11cursor_created_at\x18\x02 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x03 \x01(\tR\bcursorId\"6\n" +
+	"\x17AutocompleteTagsRequest\x12\x1b\n" +
+	"\x06prefix\x18\x01 \x01(\tB\x03\xe0A\x02R\x06prefix\"=\n" +
+	"\x18AutocompleteTagsResponse\x12!\n" +
+	"\x04tags\x18\x01 \x03(\v2\b.tag.TagB\x03\xe0A\x02R\x04tags\"<\n" +
+	"\x17ListTrendingTagsRequest\x12!\n" +
+	"\fwindow_hours\x18\x01 \x01(\x05R\vwindowHours\"=\n" +
+	"\x18ListTrendingTagsResponse\x12!\n" +
+	"\x04tags\x18\x01 \x03(\v2\b.tag.TagB\x03\xe0A\x02R\x04tags\"Y\n" +
+	"\x10FollowTagRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12,\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x14.common.ToggleActionR\x06action\"?\n" +
+	"\x11FollowTagResponse\x12*\n" +
+	"\x0efollower_count\x18\x01 \x01(\x05B\x03\xe0A\x02R\rfollowerCount\"d\n" +
+	"\x19ListMyFollowedTagsRequest\x12*\n" +
+	"\x11cursor_created_at\x18\x01 \x01(\x03R\x0fcursorCreatedAt\x12\x1b\n" +
+	"\tcursor_id\x18\x02 \x01(\tR\bcursorId\"\xa4\x01\n" +
+	"\x1aListMyFollowedTagsResponse\x12!\n" +
+	"\x04tags\x18\x01 \x03(\v2\b.tag.TagB\x03\xe0A\x02R\x04tags\x128\n" +
+	"\x16next_cursor_created_at\x18\x02 \x01(\x03B\x03\xe0A\x02R\x13nextCursorCreatedAt\x12)\n" +
+	"\x0enext_cursor_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fnextCursorId\"c\n" +
+	"\x10RenameTagRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1e\n" +
+	"\bnew_name\x18\x02 \x01(\tB\x03\xe0A\x02R\anewName\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"d\n" +
+	"\x10MergeTagsRequest\x12\x1b\n" +
+	"\x06source\x18\x01 \x01(\tB\x03\xe0A\x02R\x06source\x12\x1b\n" +
+	"\x06target\x18\x02 \x01(\tB\x03\xe0A\x02R\x06target\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason2\xc1\x06\n" +
+	"\n" +
+	"TagService\x12N\n" +
+	"\x06GetTag\x12\x12.tag.GetTagRequest\x1a\x13.tag.GetTagResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/tags/{name}\x12h\n" +
+	"\x0eListPostsByTag\x12\x1a.tag.ListPostsByTagRequest\x1a\x17.post.ListPostsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/tags/{name}/posts\x12e\n" +
+	"\x10AutocompleteTags\x12\x1c.tag.AutocompleteTagsRequest\x1a\x1d.tag.AutocompleteTagsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/tags\x12n\n" +
+	"\x10ListTrendingTags\x12\x1c.tag.ListTrendingTagsRequest\x1a\x1d.tag.ListTrendingTagsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/trending/tags\x12a\n" +
+	"\tFollowTag\x12\x15.tag.FollowTagRequest\x1a\x16.tag.FollowTagResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/tags/{name}/follow\x12n\n" +
+	"\x12ListMyFollowedTags\x12\x1e.tag.ListMyFollowedTagsRequest\x1a\x1f.tag.ListMyFollowedTagsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/me/tags\x12e\n" +
+	"\tRenameTag\x12\x15.tag.RenameTagRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/admin/tags/{name}/name\x12h\n" +
+	"\tMergeTags\x12\x15.tag.MergeTagsRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/tags/{source}/mergeB\x0fZ\raeibi/api;apib\x06proto3"
+
+var (
+	file_tag_proto_rawDescOnce sync.Once
+	file_tag_proto_rawDescData []byte
+)
+
+func file_tag_proto_rawDescGZIP() []byte {
+	file_tag_proto_rawDescOnce.Do(func() {
+		file_tag_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tag_proto_rawDesc), len(file_tag_proto_rawDesc)))
+	})
+	return file_tag_proto_rawDescData
+}
+
+var file_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_tag_proto_goTypes = []any{
+	(*Tag)(nil),                        // 0: tag.Tag
+	(*GetTagRequest)(nil),              // 1: tag.GetTagRequest
+	(*GetTagResponse)(nil),             // 2: tag.GetTagResponse
+	(*ListPostsByTagRequest)(nil),      // 3: tag.ListPostsByTagRequest
+	(*AutocompleteTagsRequest)(nil),    // 4: tag.AutocompleteTagsRequest
+	(*AutocompleteTagsResponse)(nil),   // 5: tag.AutocompleteTagsResponse
+	(*ListTrendingTagsRequest)(nil),    // 6: tag.ListTrendingTagsRequest
+	(*ListTrendingTagsResponse)(nil),   // 7: tag.ListTrendingTagsResponse
+	(*FollowTagRequest)(nil),           // 8: tag.FollowTagRequest
+	(*FollowTagResponse)(nil),          // 9: tag.FollowTagResponse
+	(*ListMyFollowedTagsRequest)(nil),  // 10: tag.ListMyFollowedTagsRequest
+	(*ListMyFollowedTagsResponse)(nil), // 11: tag.ListMyFollowedTagsResponse
+	(*RenameTagRequest)(nil),           // 12: tag.RenameTagRequest
+	(*MergeTagsRequest)(nil),           // 13: tag.MergeTagsRequest
+	(ToggleAction)(0),                  // 14: common.ToggleAction
+	(*ListPostsResponse)(nil),          // 15: post.ListPostsResponse
+	(*emptypb.Empty)(nil),              // 16: google.protobuf.Empty
+}
+var file_tag_proto_depIdxs = []int32{
+	0,  // 0: tag.GetTagResponse.tag:type_name -> tag.Tag
+	0,  // 1: tag.AutocompleteTagsResponse.tags:type_name -> tag.Tag
+	0,  // 2: tag.ListTrendingTagsResponse.tags:type_name -> tag.Tag
+	14, // 3: tag.FollowTagRequest.action:type_name -> common.ToggleAction
+	0,  // 4: tag.ListMyFollowedTagsResponse.tags:type_name -> tag.Tag
+	1,  // 5: tag.TagService.GetTag:input_type -> tag.GetTagRequest
+	3,  // 6: tag.TagService.ListPostsByTag:input_type -> tag.ListPostsByTagRequest
+	4,  // 7: tag.TagService.AutocompleteTags:input_type -> tag.AutocompleteTagsRequest
+	6,  // 8: tag.TagService.ListTrendingTags:input_type -> tag.ListTrendingTagsRequest
+	8,  // 9: tag.TagService.FollowTag:input_type -> tag.FollowTagRequest
+	10, // 10: tag.TagService.ListMyFollowedTags:input_type -> tag.ListMyFollowedTagsRequest
+	12, // 11: tag.TagService.RenameTag:input_type -> tag.RenameTagRequest
+	13, // 12: tag.TagService.MergeTags:input_type -> tag.MergeTagsRequest
+	2,  // 13: tag.TagService.GetTag:output_type -> tag.GetTagResponse
+	15, // 14: tag.TagService.ListPostsByTag:output_type -> post.ListPostsResponse
+	5,  // 15: tag.TagService.AutocompleteTags:output_type -> tag.AutocompleteTagsResponse
+	7,  // 16: tag.TagService.ListTrendingTags:output_type -> tag.ListTrendingTagsResponse
+	9,  // 17: tag.TagService.FollowTag:output_type -> tag.FollowTagResponse
+	11, // 18: tag.TagService.ListMyFollowedTags:output_type -> tag.ListMyFollowedTagsResponse
+	16, // 19: tag.TagService.RenameTag:output_type -> google.protobuf.Empty
+	16, // 20: tag.TagService.MergeTags:output_type -> google.protobuf.Empty
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_tag_proto_init() }
+func file_tag_proto_init() {
+	if File_tag_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_post_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tag_proto_rawDesc), len(file_tag_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tag_proto_goTypes,
+		DependencyIndexes: file_tag_proto_depIdxs,
+		MessageInfos:      file_tag_proto_msgTypes,
+	}.Build()
+	File_tag_proto = out.File
+	file_tag_proto_goTypes = nil
+	file_tag_proto_depIdxs = nil
+}
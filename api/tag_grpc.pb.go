@@ -0,0 +1,408 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: tag.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TagService_GetTag_FullMethodName             = "/tag.TagService/GetTag"
+	TagService_ListPostsByTag_FullMethodName     = "/tag.TagService/ListPostsByTag"
+	TagService_AutocompleteTags_FullMethodName   = "/tag.TagService/AutocompleteTags"
+	TagService_ListTrendingTags_FullMethodName   = "/tag.TagService/ListTrendingTags"
+	TagService_FollowTag_FullMethodName          = "/tag.TagService/FollowTag"
+	TagService_ListMyFollowedTags_FullMethodName = "/tag.TagService/ListMyFollowedTags"
+	TagService_RenameTag_FullMethodName          = "/tag.TagService/RenameTag"
+	TagService_MergeTags_FullMethodName          = "/tag.TagService/MergeTags"
+)
+
+// TagServiceClient is the client API for TagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TagService
+type TagServiceClient interface {
+	// GET /api/v1/tags/{name} 话题详情
+	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error)
+	// GET /api/v1/tags/{name}/posts 话题下的帖子
+	ListPostsByTag(ctx context.Context, in *ListPostsByTagRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	// GET /api/v1/tags 按前缀补全话题
+	AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...grpc.CallOption) (*AutocompleteTagsResponse, error)
+	// GET /api/v1/trending/tags 热门话题
+	ListTrendingTags(ctx context.Context, in *ListTrendingTagsRequest, opts ...grpc.CallOption) (*ListTrendingTagsResponse, error)
+	// POST /api/v1/tags/{name}/follow 关注或取消关注话题
+	FollowTag(ctx context.Context, in *FollowTagRequest, opts ...grpc.CallOption) (*FollowTagResponse, error)
+	// GET /api/v1/me/tags 关注的话题列表
+	ListMyFollowedTags(ctx context.Context, in *ListMyFollowedTagsRequest, opts ...grpc.CallOption) (*ListMyFollowedTagsResponse, error)
+	// PUT /api/v1/admin/tags/{name}/name 重命名话题
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// POST /api/v1/admin/tags/{source}/merge 合并话题
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type tagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagServiceClient(cc grpc.ClientConnInterface) TagServiceClient {
+	return &tagServiceClient{cc}
+}
+
+func (c *tagServiceClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagResponse)
+	err := c.cc.Invoke(ctx, TagService_GetTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListPostsByTag(ctx context.Context, in *ListPostsByTagRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListPostsByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...grpc.CallOption) (*AutocompleteTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutocompleteTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_AutocompleteTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListTrendingTags(ctx context.Context, in *ListTrendingTagsRequest, opts ...grpc.CallOption) (*ListTrendingTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrendingTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTrendingTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) FollowTag(ctx context.Context, in *FollowTagRequest, opts ...grpc.CallOption) (*FollowTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowTagResponse)
+	err := c.cc.Invoke(ctx, TagService_FollowTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListMyFollowedTags(ctx context.Context, in *ListMyFollowedTagsRequest, opts ...grpc.CallOption) (*ListMyFollowedTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyFollowedTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListMyFollowedTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TagService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TagService_MergeTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagServiceServer is the server API for TagService service.
+// All implementations must embed UnimplementedTagServiceServer
+// for forward compatibility.
+//
+// TagService
+type TagServiceServer interface {
+	// GET /api/v1/tags/{name} 话题详情
+	GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error)
+	// GET /api/v1/tags/{name}/posts 话题下的帖子
+	ListPostsByTag(context.Context, *ListPostsByTagRequest) (*ListPostsResponse, error)
+	// GET /api/v1/tags 按前缀补全话题
+	AutocompleteTags(context.Context, *AutocompleteTagsRequest) (*AutocompleteTagsResponse, error)
+	// GET /api/v1/trending/tags 热门话题
+	ListTrendingTags(context.Context, *ListTrendingTagsRequest) (*ListTrendingTagsResponse, error)
+	// POST /api/v1/tags/{name}/follow 关注或取消关注话题
+	FollowTag(context.Context, *FollowTagRequest) (*FollowTagResponse, error)
+	// GET /api/v1/me/tags 关注的话题列表
+	ListMyFollowedTags(context.Context, *ListMyFollowedTagsRequest) (*ListMyFollowedTagsResponse, error)
+	// PUT /api/v1/admin/tags/{name}/name 重命名话题
+	RenameTag(context.Context, *RenameTagRequest) (*emptypb.Empty, error)
+	// POST /api/v1/admin/tags/{source}/merge 合并话题
+	MergeTags(context.Context, *MergeTagsRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+// UnimplementedTagServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagServiceServer struct{}
+
+func (UnimplementedTagServiceServer) GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTag not implemented")
+}
+func (UnimplementedTagServiceServer) ListPostsByTag(context.Context, *ListPostsByTagRequest) (*ListPostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPostsByTag not implemented")
+}
+func (UnimplementedTagServiceServer) AutocompleteTags(context.Context, *AutocompleteTagsRequest) (*AutocompleteTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AutocompleteTags not implemented")
+}
+func (UnimplementedTagServiceServer) ListTrendingTags(context.Context, *ListTrendingTagsRequest) (*ListTrendingTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrendingTags not implemented")
+}
+func (UnimplementedTagServiceServer) FollowTag(context.Context, *FollowTagRequest) (*FollowTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FollowTag not implemented")
+}
+func (UnimplementedTagServiceServer) ListMyFollowedTags(context.Context, *ListMyFollowedTagsRequest) (*ListMyFollowedTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyFollowedTags not implemented")
+}
+func (UnimplementedTagServiceServer) RenameTag(context.Context, *RenameTagRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedTagServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
+func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagServiceServer will
+// result in compilation errors.
+type UnsafeTagServiceServer interface {
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+func RegisterTagServiceServer(s grpc.ServiceRegistrar, srv TagServiceServer) {
+	// If the following call panics, it indicates UnimplementedTagServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagService_ServiceDesc, srv)
+}
+
+func _TagService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_GetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).GetTag(ctx, req.(*GetTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListPostsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListPostsByTag(ctx, req.(*ListPostsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_AutocompleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).AutocompleteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_AutocompleteTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).AutocompleteTags(ctx, req.(*AutocompleteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTrendingTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTrendingTags(ctx, req.(*ListTrendingTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_FollowTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).FollowTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_FollowTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).FollowTag(ctx, req.(*FollowTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListMyFollowedTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyFollowedTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListMyFollowedTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListMyFollowedTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListMyFollowedTags(ctx, req.(*ListMyFollowedTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tag.TagService",
+	HandlerType: (*TagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTag",
+			Handler:    _TagService_GetTag_Handler,
+		},
+		{
+			MethodName: "ListPostsByTag",
+			Handler:    _TagService_ListPostsByTag_Handler,
+		},
+		{
+			MethodName: "AutocompleteTags",
+			Handler:    _TagService_AutocompleteTags_Handler,
+		},
+		{
+			MethodName: "ListTrendingTags",
+			Handler:    _TagService_ListTrendingTags_Handler,
+		},
+		{
+			MethodName: "FollowTag",
+			Handler:    _TagService_FollowTag_Handler,
+		},
+		{
+			MethodName: "ListMyFollowedTags",
+			Handler:    _TagService_ListMyFollowedTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _TagService_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _TagService_MergeTags_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tag.proto",
+}
@@ -2,21 +2,36 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"aeibi/internal/auth"
 	"aeibi/internal/config"
+	"aeibi/util"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 )
 
 // StartGateway starts the gRPC-Gateway HTTP server and returns it plus an error channel.
-func StartGateway(ctx context.Context, cfg *config.Config, registrars []ServiceRegistrar) (*http.Server, <-chan error, error) {
+func StartGateway(ctx context.Context, cfg *config.Config, keys *util.JWTKeySet, registrars []ServiceRegistrar) (*http.Server, <-chan error, error) {
 	mux := runtime.NewServeMux(
 		runtime.WithMetadata(auth.GatewayMetadataExtractor),
+		runtime.WithMarshalerOption(mimeEventStream, newSSEMarshaler()),
 	)
+	// Other services verify access tokens against the published public keys.
+	if err := mux.HandlePath(http.MethodGet, "/.well-known/jwks.json", func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		if err := json.NewEncoder(w).Encode(keys.JWKS(time.Now())); err != nil {
+			slog.Warn("write jwks", "error", err)
+		}
+	}); err != nil {
+		return nil, nil, fmt.Errorf("register jwks handler: %w", err)
+	}
 	for _, registrar := range registrars {
 		if registrar.RegisterGateway == nil {
 			continue
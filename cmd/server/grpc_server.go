@@ -7,14 +7,17 @@ import (
 
 	"aeibi/internal/auth"
 	"aeibi/internal/config"
+	"aeibi/util"
 
+	"github.com/casbin/casbin/v2"
 	"google.golang.org/grpc"
 )
 
 // StartGRPCServer starts the gRPC server and returns it plus an error channel.
-func StartGRPCServer(cfg *config.Config, registrars []ServiceRegistrar) (*grpc.Server, <-chan error, error) {
+func StartGRPCServer(cfg *config.Config, keys *util.JWTKeySet, denylist *auth.Denylist, enforcer casbin.IEnforcer, registrars []ServiceRegistrar) (*grpc.Server, <-chan error, error) {
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(auth.NewAuthUnaryServerInterceptor(cfg.Auth.JWTSecret)),
+		grpc.UnaryInterceptor(auth.NewAuthUnaryServerInterceptor(keys, denylist, enforcer)),
+		grpc.StreamInterceptor(auth.NewAuthStreamServerInterceptor(keys, denylist, enforcer)),
 	)
 	for _, registrar := range registrars {
 		if registrar.RegisterGRPC != nil {
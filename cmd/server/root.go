@@ -10,8 +10,10 @@ import (
 
 	"aeibi/api"
 	"aeibi/cmd/env"
+	"aeibi/internal/auth"
 	"aeibi/internal/config"
 	"aeibi/internal/controller"
+	"aeibi/internal/event"
 	"aeibi/internal/service"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -32,13 +34,55 @@ func Run(ctx context.Context, cfg *config.Config) error {
 		return err
 	}
 
+	enforcer, err := auth.NewEnforcer(dbConn, cfg.Auth.PolicyFile)
+	if err != nil {
+		return err
+	}
+
+	// Access token signing and verification keys
+	jwtKeys, err := auth.NewKeySet(cfg.Auth)
+	if err != nil {
+		return err
+	}
+
+	// Revoked access tokens; every instance caches the list and polls for additions
+	denylist := auth.NewDenylist(dbConn, cfg.Auth)
+	if err := denylist.Load(ctx); err != nil {
+		return err
+	}
+	go denylist.Run(ctx)
+
+	// Timeline fan-out workers
+	timelineSvc := service.NewTimelineService(dbConn, cfg.Timeline)
+	go timelineSvc.Run(ctx)
+
+	// Event pub/sub; the postgres backend relays events between instances via LISTEN/NOTIFY
+	eventHub := event.NewHub(cfg.Events.BufferSize)
+	var eventPublisher event.Publisher = eventHub
+	if cfg.Events.Backend == "postgres" {
+		broker := event.NewPostgresBroker(dbConn, cfg.Database.DSN, cfg.Events.Channel, eventHub)
+		go func() {
+			if err := broker.Run(ctx); err != nil {
+				slog.Error("event broker stopped", "error", err)
+			}
+		}()
+		eventPublisher = broker
+	}
+
+	// Notifications are written by the post, comment and follow services
+	notificationSvc := service.NewNotificationService(dbConn)
+
+	// Scheduled post publisher; replicas coordinate through row locks
+	schedulerSvc := service.NewSchedulerService(dbConn, cfg.Scheduler, timelineSvc, notificationSvc, eventPublisher)
+	go schedulerSvc.Run(ctx)
+
 	// Initialize service registrars
 
 	gatewayEndpoint := cfg.Server.GRPCAddr
 	gatewayDialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
 	// User service
-	userSvc := service.NewUserService(dbConn, ossClient, cfg)
+	userSvc := service.NewUserService(dbConn, ossClient, cfg, jwtKeys, denylist)
 	userHandler := controller.NewUserHandler(userSvc)
 	userRegistrar := ServiceRegistrar{
 		Name: "user",
@@ -51,7 +95,7 @@ func Run(ctx context.Context, cfg *config.Config) error {
 	}
 
 	// Follow service
-	followSvc := service.NewFollowService(dbConn)
+	followSvc := service.NewFollowService(dbConn, timelineSvc, notificationSvc, eventPublisher)
 	followHandler := controller.NewFollowHandler(followSvc)
 	followRegistrar := ServiceRegistrar{
 		Name: "follow",
@@ -64,7 +108,7 @@ func Run(ctx context.Context, cfg *config.Config) error {
 	}
 
 	// Post service
-	postSvc := service.NewPostService(dbConn, ossClient)
+	postSvc := service.NewPostService(dbConn, ossClient, timelineSvc, notificationSvc, eventPublisher)
 	postHandler := controller.NewPostHandler(postSvc)
 	postRegistrar := ServiceRegistrar{
 		Name: "post",
@@ -90,7 +134,7 @@ func Run(ctx context.Context, cfg *config.Config) error {
 	}
 
 	// Comment service
-	commentSvc := service.NewCommentService(dbConn)
+	commentSvc := service.NewCommentService(dbConn, notificationSvc, eventPublisher)
 	commentHandler := controller.NewCommentHandler(commentSvc)
 	commentRegistrar := ServiceRegistrar{
 		Name: "comment",
@@ -102,22 +146,133 @@ func Run(ctx context.Context, cfg *config.Config) error {
 		},
 	}
 
+	// Admin service
+	adminSvc := service.NewAdminService(dbConn, denylist)
+	adminHandler := controller.NewAdminHandler(adminSvc)
+	adminRegistrar := ServiceRegistrar{
+		Name: "admin",
+		RegisterGRPC: func(s *grpc.Server) {
+			api.RegisterAdminServiceServer(s, adminHandler)
+		},
+		RegisterGateway: func(ctx context.Context, mux *runtime.ServeMux) error {
+			return api.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, gatewayEndpoint, gatewayDialOpts)
+		},
+	}
+
+	// Report service
+	reportSvc := service.NewReportService(dbConn, adminSvc)
+	reportHandler := controller.NewReportHandler(reportSvc)
+	reportRegistrar := ServiceRegistrar{
+		Name: "report",
+		RegisterGRPC: func(s *grpc.Server) {
+			api.RegisterReportServiceServer(s, reportHandler)
+		},
+		RegisterGateway: func(ctx context.Context, mux *runtime.ServeMux) error {
+			return api.RegisterReportServiceHandlerFromEndpoint(ctx, mux, gatewayEndpoint, gatewayDialOpts)
+		},
+	}
+
+	// Notification service
+	notificationHandler := controller.NewNotificationHandler(notificationSvc)
+	notificationRegistrar := ServiceRegistrar{
+		Name: "notification",
+		RegisterGRPC: func(s *grpc.Server) {
+			api.RegisterNotificationServiceServer(s, notificationHandler)
+		},
+		RegisterGateway: func(ctx context.Context, mux *runtime.ServeMux) error {
+			return api.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, gatewayEndpoint, gatewayDialOpts)
+		},
+	}
+
+	// Event service
+	eventSvc := service.NewEventService(dbConn, eventHub, cfg.Events)
+	eventHandler := controller.NewEventHandler(eventSvc)
+	eventRegistrar := ServiceRegistrar{
+		Name: "event",
+		RegisterGRPC: func(s *grpc.Server) {
+			api.RegisterEventServiceServer(s, eventHandler)
+		},
+		RegisterGateway: func(ctx context.Context, mux *runtime.ServeMux) error {
+			return api.RegisterEventServiceHandlerFromEndpoint(ctx, mux, gatewayEndpoint, gatewayDialOpts)
+		},
+	}
+
+	// Search service
+	searchSvc := service.NewSearchService(dbConn)
+	searchHandler := controller.NewSearchHandler(searchSvc)
+	searchRegistrar := ServiceRegistrar{
+		Name: "search",
+		RegisterGRPC: func(s *grpc.Server) {
+			api.RegisterSearchServiceServer(s, searchHandler)
+		},
+		RegisterGateway: func(ctx context.Context, mux *runtime.ServeMux) error {
+			return api.RegisterSearchServiceHandlerFromEndpoint(ctx, mux, gatewayEndpoint, gatewayDialOpts)
+		},
+	}
+
+	// Tag service
+	tagSvc := service.NewTagService(dbConn, adminSvc)
+	tagHandler := controller.NewTagHandler(tagSvc)
+	tagRegistrar := ServiceRegistrar{
+		Name: "tag",
+		RegisterGRPC: func(s *grpc.Server) {
+			api.RegisterTagServiceServer(s, tagHandler)
+		},
+		RegisterGateway: func(ctx context.Context, mux *runtime.ServeMux) error {
+			return api.RegisterTagServiceHandlerFromEndpoint(ctx, mux, gatewayEndpoint, gatewayDialOpts)
+		},
+	}
+
+	// Message service
+	messageSvc := service.NewMessageService(dbConn, eventPublisher)
+	messageHandler := controller.NewMessageHandler(messageSvc)
+	messageRegistrar := ServiceRegistrar{
+		Name: "message",
+		RegisterGRPC: func(s *grpc.Server) {
+			api.RegisterMessageServiceServer(s, messageHandler)
+		},
+		RegisterGateway: func(ctx context.Context, mux *runtime.ServeMux) error {
+			return api.RegisterMessageServiceHandlerFromEndpoint(ctx, mux, gatewayEndpoint, gatewayDialOpts)
+		},
+	}
+
+	// Relation service
+	relationSvc := service.NewRelationService(dbConn, timelineSvc)
+	relationHandler := controller.NewRelationHandler(relationSvc)
+	relationRegistrar := ServiceRegistrar{
+		Name: "relation",
+		RegisterGRPC: func(s *grpc.Server) {
+			api.RegisterRelationServiceServer(s, relationHandler)
+		},
+		RegisterGateway: func(ctx context.Context, mux *runtime.ServeMux) error {
+			return api.RegisterRelationServiceHandlerFromEndpoint(ctx, mux, gatewayEndpoint, gatewayDialOpts)
+		},
+	}
+
 	registrars := []ServiceRegistrar{
 		userRegistrar,
 		followRegistrar,
 		postRegistrar,
 		fileRegistrar,
 		commentRegistrar,
+		adminRegistrar,
+		reportRegistrar,
+		notificationRegistrar,
+		eventRegistrar,
+		searchRegistrar,
+		tagRegistrar,
+		messageRegistrar,
+		relationRegistrar,
 	}
 
 	// Start gRPC server
-	grpcServer, grpcErrCh, err := StartGRPCServer(cfg, registrars)
+	grpcServer, grpcErrCh, err := StartGRPCServer(cfg, jwtKeys, denylist, enforcer, registrars)
 	if err != nil {
 		return err
 	}
 
 	// Start gRPC-Gateway HTTP server
-	httpServer, httpErrCh, err := StartGateway(ctx, cfg, registrars)
+	httpServer, httpErrCh, err := StartGateway(ctx, cfg, jwtKeys, registrars)
 	if err != nil {
 		grpcServer.GracefulStop()
 		return err
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const mimeEventStream = "text/event-stream"
+
+// sseMarshaler renders server-streaming responses as Server-Sent Events. The gateway
+// picks it when the client sends "Accept: text/event-stream", as EventSource does.
+type sseMarshaler struct {
+	runtime.JSONPb
+}
+
+func newSSEMarshaler() *sseMarshaler {
+	return &sseMarshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}
+}
+
+func (m *sseMarshaler) ContentType(_ any) string {
+	return mimeEventStream
+}
+
+func (m *sseMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
+// Marshal unwraps the gateway's {"result": ...} and {"error": ...} stream chunks into
+// one SSE frame. Messages with a Type field use it as the event name.
+func (m *sseMarshaler) Marshal(v any) ([]byte, error) {
+	name := "message"
+	switch chunk := v.(type) {
+	case map[string]any:
+		if result, ok := chunk["result"]; ok {
+			v = result
+		}
+	case map[string]proto.Message:
+		if errStatus, ok := chunk["error"]; ok {
+			name = "error"
+			v = errStatus
+		}
+	}
+	if typed, ok := v.(interface{ GetType() string }); ok && typed.GetType() != "" {
+		name = typed.GetType()
+	}
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	frame := make([]byte, 0, len(data)+len(name)+14)
+	frame = append(frame, "event: "...)
+	frame = append(frame, name...)
+	frame = append(frame, "\ndata: "...)
+	frame = append(frame, data...)
+	return frame, nil
+}
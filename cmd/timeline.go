@@ -0,0 +1,50 @@
+package main
+
+import (
+	"log/slog"
+
+	"aeibi/cmd/env"
+	"aeibi/internal/config"
+	"aeibi/internal/service"
+
+	"github.com/spf13/cobra"
+)
+
+var timelineCmd = &cobra.Command{
+	Use:   "timeline",
+	Short: "Manage materialized home timelines",
+}
+
+var timelineRebuildCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Rebuild timeline entries from posts and user follows",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPath, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return err
+		}
+
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return err
+		}
+
+		dbConn, err := env.InitDB(cmd.Context(), cfg.Database)
+		if err != nil {
+			return err
+		}
+		defer dbConn.Close()
+
+		inserted, err := service.NewTimelineService(dbConn, cfg.Timeline).Rebuild(cmd.Context())
+		if err != nil {
+			return err
+		}
+		slog.Info("timeline rebuilt", "entries", inserted)
+		return nil
+	},
+}
+
+func init() {
+	timelineCmd.AddCommand(timelineRebuildCmd)
+	rootCmd.AddCommand(timelineCmd)
+}
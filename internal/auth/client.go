@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientFromContext returns the caller's user agent and IP address. Requests proxied by
+// the HTTP gateway carry the browser's values in forwarded metadata; direct gRPC calls
+// fall back to the gRPC user agent and the peer address.
+func ClientFromContext(ctx context.Context) (userAgent, ip string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("grpcgateway-user-agent"); len(values) > 0 {
+		userAgent = values[0]
+	} else if values := md.Get("user-agent"); len(values) > 0 {
+		userAgent = values[0]
+	}
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		ip = strings.TrimSpace(strings.Split(values[0], ",")[0])
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	return userAgent, ip
+}
@@ -1,15 +1,22 @@
 package auth
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type contextKey string
 
 const authInfoKey contextKey = "auth-info"
 
 type AuthInfo struct {
-	Subject string
-	Object  string
-	Action  string
+	Subject   string
+	SessionID string
+	TokenID   string
+	ExpiresAt time.Time
+	Role      string
+	Object    string
+	Action    string
 }
 
 func WithAuthInfo(ctx context.Context, info AuthInfo) context.Context {
@@ -36,3 +43,12 @@ func SubjectFromContext(ctx context.Context) (string, bool) {
 	}
 	return info.Subject, true
 }
+
+// SessionFromContext returns the session the caller's access token was issued for.
+func SessionFromContext(ctx context.Context) (string, bool) {
+	info, ok := FromContext(ctx)
+	if !ok || info.SessionID == "" {
+		return "", false
+	}
+	return info.SessionID, true
+}
@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"aeibi/internal/config"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+)
+
+const defaultDenylistSyncInterval = 5 * time.Second
+
+// denylistSyncOverlap is how far before the newest entry already seen a sync reads again,
+// so entries committed late by other instances are not skipped.
+const denylistSyncOverlap = time.Minute
+
+// Denylist rejects access tokens before they expire. Entries are written to Postgres and
+// cached in memory; every instance polls for entries added elsewhere, so a revocation
+// takes effect on other instances within one sync interval.
+type Denylist struct {
+	db       *db.Queries
+	ttl      time.Duration
+	interval time.Duration
+
+	mu       sync.RWMutex
+	entries  map[denialKey]denial
+	lastSeen time.Time
+}
+
+type denialKey struct {
+	kind  db.AccessTokenDenialKind
+	value string
+}
+
+type denial struct {
+	deniedAt  time.Time
+	expiresAt time.Time
+}
+
+func NewDenylist(dbx *sql.DB, cfg config.AuthConfig) *Denylist {
+	d := &Denylist{
+		db:       db.New(dbx),
+		ttl:      cfg.JWTTTL,
+		interval: cfg.DenylistSyncInterval,
+		entries:  make(map[denialKey]denial),
+	}
+	if d.interval <= 0 {
+		d.interval = defaultDenylistSyncInterval
+	}
+	return d
+}
+
+// Load reads the entries that are still in force. Call it before serving requests.
+func (d *Denylist) Load(ctx context.Context) error {
+	rows, err := d.db.ListAccessTokenDenials(ctx, time.Time{})
+	if err != nil {
+		return fmt.Errorf("load access token denylist: %w", err)
+	}
+	d.merge(rows)
+	return nil
+}
+
+// Run syncs entries added by other instances every interval and drops expired ones. It
+// blocks until ctx is done.
+func (d *Denylist) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.sync(ctx); err != nil {
+				slog.Warn("sync access token denylist", "error", err)
+			}
+		}
+	}
+}
+
+func (d *Denylist) sync(ctx context.Context) error {
+	d.mu.RLock()
+	since := d.lastSeen.Add(-denylistSyncOverlap)
+	d.mu.RUnlock()
+	rows, err := d.db.ListAccessTokenDenials(ctx, since)
+	if err != nil {
+		return fmt.Errorf("list access token denials: %w", err)
+	}
+	d.merge(rows)
+
+	now := time.Now()
+	d.mu.Lock()
+	for key, entry := range d.entries {
+		if !entry.expiresAt.After(now) {
+			delete(d.entries, key)
+		}
+	}
+	d.mu.Unlock()
+
+	if err := d.db.DeleteExpiredAccessTokenDenials(ctx); err != nil {
+		return fmt.Errorf("delete expired access token denials: %w", err)
+	}
+	return nil
+}
+
+func (d *Denylist) merge(rows []db.AccessTokenDenial) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, row := range rows {
+		// Token iat claims have whole-second precision, so compare at that precision.
+		d.entries[denialKey{kind: row.Kind, value: row.Value}] = denial{
+			deniedAt:  row.DeniedAt.Truncate(time.Second),
+			expiresAt: row.ExpiresAt,
+		}
+		if row.DeniedAt.After(d.lastSeen) {
+			d.lastSeen = row.DeniedAt
+		}
+	}
+}
+
+// Denied reports whether claims belong to a revoked token, session or user. User entries
+// only match tokens issued in whole seconds before the revocation, so a login in the
+// same second as the revocation is unaffected.
+func (d *Denylist) Denied(claims *util.JWTClaims) bool {
+	now := time.Now()
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if entry, ok := d.entries[denialKey{kind: db.AccessTokenDenialKindTOKEN, value: claims.ID}]; ok && claims.ID != "" && entry.expiresAt.After(now) {
+		return true
+	}
+	if entry, ok := d.entries[denialKey{kind: db.AccessTokenDenialKindSESSION, value: claims.SessionID}]; ok && claims.SessionID != "" && entry.expiresAt.After(now) {
+		return true
+	}
+	if entry, ok := d.entries[denialKey{kind: db.AccessTokenDenialKindUSER, value: claims.Subject}]; ok && entry.expiresAt.After(now) {
+		return claims.IssuedAt == nil || claims.IssuedAt.Before(entry.deniedAt)
+	}
+	return false
+}
+
+// DenyToken revokes the single access token with jti until it expires.
+func (d *Denylist) DenyToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	return d.deny(ctx, db.AccessTokenDenialKindTOKEN, jti, expiresAt)
+}
+
+// DenySessions revokes every access token issued for the given sessions.
+func (d *Denylist) DenySessions(ctx context.Context, sessionIDs ...string) error {
+	for _, sessionID := range sessionIDs {
+		if err := d.deny(ctx, db.AccessTokenDenialKindSESSION, sessionID, time.Now().Add(d.ttl)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DenyUser revokes every access token issued to uid so far.
+func (d *Denylist) DenyUser(ctx context.Context, uid string) error {
+	return d.deny(ctx, db.AccessTokenDenialKindUSER, uid, time.Now().Add(d.ttl))
+}
+
+func (d *Denylist) deny(ctx context.Context, kind db.AccessTokenDenialKind, value string, expiresAt time.Time) error {
+	deniedAt, err := d.db.DenyAccessTokens(ctx, db.DenyAccessTokensParams{
+		Kind:      kind,
+		Value:     value,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("deny access tokens: %w", err)
+	}
+	d.merge([]db.AccessTokenDenial{{
+		Kind:      kind,
+		Value:     value,
+		DeniedAt:  deniedAt,
+		ExpiresAt: expiresAt,
+	}})
+	return nil
+}
@@ -11,6 +11,9 @@ func GatewayMetadataExtractor(_ context.Context, req *http.Request) metadata.MD
 	md := metadata.MD{}
 	if authHeader := req.Header.Get("Authorization"); authHeader != "" {
 		md.Append(metadataAuthorizationKey, authHeader)
+	} else if token := req.URL.Query().Get("access_token"); token != "" && req.Header.Get("Accept") == "text/event-stream" {
+		// EventSource cannot set headers, so event streams may pass the token in the query.
+		md.Append(metadataAuthorizationKey, "Bearer "+token)
 	}
 	return md
 }
@@ -6,32 +6,83 @@ import (
 
 	"aeibi/util"
 
+	"github.com/casbin/casbin/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const metadataAuthorizationKey = "authorization"
 
-func NewAuthUnaryServerInterceptor(secret string) grpc.UnaryServerInterceptor {
+func NewAuthUnaryServerInterceptor(keys *util.JWTKeySet, denylist *Denylist, enforcer casbin.IEnforcer) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-		md, _ := metadata.FromIncomingContext(ctx)
-		accessToken := ""
-		for _, authHeader := range md.Get(metadataAuthorizationKey) {
-			if strings.HasPrefix(strings.ToLower(authHeader), "bearer ") {
-				accessToken = strings.TrimSpace(authHeader[7:])
-			}
+		ctx, err = authorize(ctx, info.FullMethod, keys, denylist, enforcer)
+		if err != nil {
+			return nil, err
 		}
-		claims, err := util.ParseJWT(accessToken, secret)
-		authInfo := AuthInfo{
-			Subject: "",
-			Object:  info.FullMethod,
-			Action:  "CALL",
+		return handler(ctx, req)
+	}
+}
+
+func NewAuthStreamServerInterceptor(keys *util.JWTKeySet, denylist *Denylist, enforcer casbin.IEnforcer) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), info.FullMethod, keys, denylist, enforcer)
+		if err != nil {
+			return err
 		}
-		if err == nil && claims != nil {
-			authInfo.Subject = claims.Subject
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authServerStream overrides the stream context with one carrying AuthInfo.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize resolves the caller's role from the bearer token unless it has been revoked,
+// enforces the policy for fullMethod and returns ctx with the caller's AuthInfo attached.
+func authorize(ctx context.Context, fullMethod string, keys *util.JWTKeySet, denylist *Denylist, enforcer casbin.IEnforcer) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	accessToken := ""
+	for _, authHeader := range md.Get(metadataAuthorizationKey) {
+		if strings.HasPrefix(strings.ToLower(authHeader), "bearer ") {
+			accessToken = strings.TrimSpace(authHeader[7:])
 		}
-		// TODO: Casbin Auth
-		ctx = WithAuthInfo(ctx, authInfo)
-		return handler(ctx, req)
 	}
+	claims, err := util.ParseJWT(accessToken, keys)
+	authInfo := AuthInfo{
+		Subject: "",
+		Role:    RoleAnonymous,
+		Object:  fullMethod,
+		Action:  ActionCall,
+	}
+	// Revoked tokens are treated like expired ones: the caller is anonymous.
+	if err == nil && claims != nil && !denylist.Denied(claims) {
+		authInfo.Subject = claims.Subject
+		authInfo.SessionID = claims.SessionID
+		authInfo.TokenID = claims.ID
+		if claims.ExpiresAt != nil {
+			authInfo.ExpiresAt = claims.ExpiresAt.Time
+		}
+		if claims.Role != "" {
+			authInfo.Role = claims.Role
+		}
+	}
+	allowed, err := enforcer.Enforce(authInfo.Role, authInfo.Object, authInfo.Action)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "authorization failed")
+	}
+	if !allowed {
+		if authInfo.Subject == "" {
+			return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+		}
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return WithAuthInfo(ctx, authInfo), nil
 }
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"fmt"
+	"os"
+
+	"aeibi/internal/config"
+	"aeibi/util"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// NewKeySet loads the access token keys from cfg. Without jwt_keys it falls back to the
+// HS256 jwt_secret, which every verifier then has to share.
+func NewKeySet(cfg config.AuthConfig) (*util.JWTKeySet, error) {
+	if len(cfg.JWTKeys) == 0 {
+		keys, err := util.NewHMACKeySet(cfg.JWTSecret)
+		if err != nil {
+			return nil, fmt.Errorf("load jwt secret: %w", err)
+		}
+		return keys, nil
+	}
+	keys := make([]util.JWTKey, 0, len(cfg.JWTKeys))
+	for _, kc := range cfg.JWTKeys {
+		key, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("load jwt key %q: %w", kc.Kid, err)
+		}
+		keys = append(keys, key)
+	}
+	keySet, err := util.NewJWTKeySet(keys)
+	if err != nil {
+		return nil, fmt.Errorf("load jwt keys: %w", err)
+	}
+	return keySet, nil
+}
+
+func loadKey(kc config.JWTKeyConfig) (util.JWTKey, error) {
+	key := util.JWTKey{
+		ID:         kc.Kid,
+		ActivateAt: kc.ActivateAt,
+		RetireAt:   kc.RetireAt,
+	}
+	var private, public []byte
+	var err error
+	if kc.PrivateKeyFile != "" {
+		if private, err = os.ReadFile(kc.PrivateKeyFile); err != nil {
+			return key, fmt.Errorf("read private key: %w", err)
+		}
+	}
+	if kc.PublicKeyFile != "" {
+		if public, err = os.ReadFile(kc.PublicKeyFile); err != nil {
+			return key, fmt.Errorf("read public key: %w", err)
+		}
+	}
+	if private == nil && public == nil {
+		return key, fmt.Errorf("private_key_file or public_key_file is required")
+	}
+
+	switch kc.Algorithm {
+	case "RS256":
+		key.Method = jwt.SigningMethodRS256
+		if private != nil {
+			priv, err := jwt.ParseRSAPrivateKeyFromPEM(private)
+			if err != nil {
+				return key, fmt.Errorf("parse private key: %w", err)
+			}
+			key.SignKey = priv
+			key.VerifyKey = &priv.PublicKey
+		}
+		if public != nil {
+			pub, err := jwt.ParseRSAPublicKeyFromPEM(public)
+			if err != nil {
+				return key, fmt.Errorf("parse public key: %w", err)
+			}
+			if priv, ok := key.SignKey.(*rsa.PrivateKey); ok && !priv.PublicKey.Equal(pub) {
+				return key, fmt.Errorf("public key does not match private key")
+			}
+			key.VerifyKey = pub
+		}
+	case "EdDSA":
+		key.Method = jwt.SigningMethodEdDSA
+		if private != nil {
+			priv, err := jwt.ParseEdPrivateKeyFromPEM(private)
+			if err != nil {
+				return key, fmt.Errorf("parse private key: %w", err)
+			}
+			key.SignKey = priv
+			key.VerifyKey = priv.(crypto.Signer).Public()
+		}
+		if public != nil {
+			pub, err := jwt.ParseEdPublicKeyFromPEM(public)
+			if err != nil {
+				return key, fmt.Errorf("parse public key: %w", err)
+			}
+			if derived, ok := key.VerifyKey.(ed25519.PublicKey); ok && !derived.Equal(pub) {
+				return key, fmt.Errorf("public key does not match private key")
+			}
+			key.VerifyKey = pub
+		}
+	default:
+		return key, fmt.Errorf("unsupported algorithm %q", kc.Algorithm)
+	}
+	return key, nil
+}
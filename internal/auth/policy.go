@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"aeibi/internal/repository/db"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
+)
+
+// RoleAnonymous is the casbin subject used for requests without a valid access token.
+const RoleAnonymous = "ANONYMOUS"
+
+// ActionCall is the casbin action for invoking an RPC.
+const ActionCall = "CALL"
+
+// policyModel matches the role carried in the access token against gRPC full method
+// names. Roles inherit through "g" rules, e.g. HOST > ADMIN > USER > ANONYMOUS.
+const policyModel = `
+[request_definition]
+r = sub, obj, act
+
+[policy_definition]
+p = sub, obj, act
+
+[role_definition]
+g = _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub) && keyMatch(r.obj, p.obj) && r.act == p.act
+`
+
+// NewEnforcer builds the casbin enforcer. Policies are read from policyFile when it is
+// set, otherwise from the casbin_rules table.
+func NewEnforcer(dbx *sql.DB, policyFile string) (*casbin.SyncedEnforcer, error) {
+	m, err := model.NewModelFromString(policyModel)
+	if err != nil {
+		return nil, fmt.Errorf("load policy model: %w", err)
+	}
+	var adapter persist.Adapter
+	if policyFile != "" {
+		adapter = fileadapter.NewAdapter(policyFile)
+	} else {
+		adapter = &dbAdapter{db: db.New(dbx)}
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m, adapter)
+	if err != nil {
+		return nil, fmt.Errorf("init enforcer: %w", err)
+	}
+	return enforcer, nil
+}
+
+// dbAdapter is a read-only casbin adapter backed by the casbin_rules table.
+type dbAdapter struct {
+	db *db.Queries
+}
+
+var errReadOnlyAdapter = errors.New("policy adapter is read-only")
+
+func (a *dbAdapter) LoadPolicy(m model.Model) error {
+	rows, err := a.db.ListCasbinRules(context.Background())
+	if err != nil {
+		return fmt.Errorf("list casbin rules: %w", err)
+	}
+	for _, row := range rows {
+		rule := []string{row.Ptype, row.V0, row.V1, row.V2, row.V3, row.V4, row.V5}
+		for len(rule) > 1 && rule[len(rule)-1] == "" {
+			rule = rule[:len(rule)-1]
+		}
+		if err := persist.LoadPolicyArray(rule, m); err != nil {
+			return fmt.Errorf("load casbin rule: %w", err)
+		}
+	}
+	return nil
+}
+
+func (a *dbAdapter) SavePolicy(model.Model) error {
+	return errReadOnlyAdapter
+}
+
+func (a *dbAdapter) AddPolicy(string, string, []string) error {
+	return errReadOnlyAdapter
+}
+
+func (a *dbAdapter) RemovePolicy(string, string, []string) error {
+	return errReadOnlyAdapter
+}
+
+func (a *dbAdapter) RemoveFilteredPolicy(string, string, int, ...string) error {
+	return errReadOnlyAdapter
+}
@@ -9,10 +9,13 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	OSS      OSSConfig      `mapstructure:"oss"`
-	Auth     AuthConfig     `mapstructure:"auth"`
+	Server    ServerConfig    `mapstructure:"server"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	OSS       OSSConfig       `mapstructure:"oss"`
+	Auth      AuthConfig      `mapstructure:"auth"`
+	Timeline  TimelineConfig  `mapstructure:"timeline"`
+	Events    EventsConfig    `mapstructure:"events"`
+	Scheduler SchedulerConfig `mapstructure:"scheduler"`
 }
 
 type ServerConfig struct {
@@ -34,10 +37,43 @@ type OSSConfig struct {
 }
 
 type AuthConfig struct {
-	JWTSecret  string        `mapstructure:"jwt_secret"`
-	JWTIssuer  string        `mapstructure:"jwt_issuer"`
-	JWTTTL     time.Duration `mapstructure:"jwt_ttl"`
-	RefreshTTL time.Duration `mapstructure:"refresh_ttl"`
+	JWTSecret  string         `mapstructure:"jwt_secret"` // HS256 secret, used only when jwt_keys is empty
+	JWTKeys    []JWTKeyConfig `mapstructure:"jwt_keys"`
+	JWTIssuer  string         `mapstructure:"jwt_issuer"`
+	JWTTTL     time.Duration  `mapstructure:"jwt_ttl"`
+	RefreshTTL time.Duration  `mapstructure:"refresh_ttl"`
+	PolicyFile string         `mapstructure:"policy_file"`
+
+	DenylistSyncInterval time.Duration `mapstructure:"denylist_sync_interval"` // how often revocations from other instances are picked up
+	RefreshReuseGrace    time.Duration `mapstructure:"refresh_reuse_grace"`    // a rotated refresh token presented again within this returns the current one
+}
+
+type JWTKeyConfig struct {
+	Kid            string    `mapstructure:"kid"`
+	Algorithm      string    `mapstructure:"algorithm"`        // RS256 or EdDSA
+	PrivateKeyFile string    `mapstructure:"private_key_file"` // PEM; omit for keys that only verify
+	PublicKeyFile  string    `mapstructure:"public_key_file"`  // PEM; derived from the private key when omitted
+	ActivateAt     time.Time `mapstructure:"activate_at"`      // signing starts; published in the JWKS before that
+	RetireAt       time.Time `mapstructure:"retire_at"`        // tokens signed with the key are rejected from then on
+}
+
+type TimelineConfig struct {
+	FanoutThreshold int           `mapstructure:"fanout_threshold"`
+	MaxEntries      int           `mapstructure:"max_entries"`
+	Workers         int           `mapstructure:"workers"`
+	PollInterval    time.Duration `mapstructure:"poll_interval"` // how often idle workers check the fan-out outbox
+}
+
+type EventsConfig struct {
+	Backend    string        `mapstructure:"backend"` // memory or postgres
+	Channel    string        `mapstructure:"channel"`
+	BufferSize int           `mapstructure:"buffer_size"`
+	Heartbeat  time.Duration `mapstructure:"heartbeat"`
+}
+
+type SchedulerConfig struct {
+	Interval  time.Duration `mapstructure:"interval"`   // how often due scheduled posts are published
+	BatchSize int           `mapstructure:"batch_size"` // posts published per round
 }
 
 func Load(path string) (*Config, error) {
@@ -52,7 +88,10 @@ func Load(path string) (*Config, error) {
 	}
 
 	var cfg Config
-	if err := v.Unmarshal(&cfg, viper.DecodeHook(mapstructure.StringToTimeDurationHookFunc())); err != nil {
+	if err := v.Unmarshal(&cfg, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToTimeHookFunc(time.RFC3339),
+	))); err != nil {
 		return nil, fmt.Errorf("decode config: %w", err)
 	}
 	return &cfg, nil
@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/service"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AdminHandler struct {
+	api.UnimplementedAdminServiceServer
+	svc *service.AdminService
+}
+
+func NewAdminHandler(svc *service.AdminService) *AdminHandler {
+	return &AdminHandler{svc: svc}
+}
+
+func (h *AdminHandler) SuspendUser(ctx context.Context, req *api.SuspendUserRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.SuspendUser(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) RestoreUser(ctx context.Context, req *api.RestoreUserRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RestoreUser(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) SetUserRole(ctx context.Context, req *api.SetUserRoleRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if req.Role != "ADMIN" && req.Role != "USER" {
+		return nil, status.Error(codes.InvalidArgument, "role must be ADMIN or USER")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.SetUserRole(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) ArchivePost(ctx context.Context, req *api.ArchivePostRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.ArchivePost(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) RestorePost(ctx context.Context, req *api.RestorePostRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RestorePost(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) ListPostRevisions(ctx context.Context, req *api.ListPostRevisionsRequest) (*api.ListPostRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	return h.svc.ListPostRevisions(ctx, req)
+}
+
+func (h *AdminHandler) ArchiveComment(ctx context.Context, req *api.ArchiveCommentRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.ArchiveComment(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) RestoreComment(ctx context.Context, req *api.RestoreCommentRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RestoreComment(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AdminHandler) ListArchivedUsers(ctx context.Context, req *api.ListArchivedRequest) (*api.ListArchivedUsersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorArchivedAt == 0 && req.CursorId != "") || (req.CursorArchivedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	return h.svc.ListArchivedUsers(ctx, req)
+}
+
+func (h *AdminHandler) ListArchivedPosts(ctx context.Context, req *api.ListArchivedRequest) (*api.ListArchivedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorArchivedAt == 0 && req.CursorId != "") || (req.CursorArchivedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	return h.svc.ListArchivedPosts(ctx, req)
+}
+
+func (h *AdminHandler) ListArchivedComments(ctx context.Context, req *api.ListArchivedRequest) (*api.ListArchivedCommentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorArchivedAt == 0 && req.CursorId != "") || (req.CursorArchivedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	return h.svc.ListArchivedComments(ctx, req)
+}
+
+func (h *AdminHandler) ListAuditLogs(ctx context.Context, req *api.ListAuditLogsRequest) (*api.ListAuditLogsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	return h.svc.ListAuditLogs(ctx, req)
+}
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type EventHandler struct {
+	api.UnimplementedEventServiceServer
+	svc *service.EventService
+}
+
+func NewEventHandler(svc *service.EventService) *EventHandler {
+	return &EventHandler{svc: svc}
+}
+
+func (h *EventHandler) StreamEvents(req *api.StreamEventsRequest, stream grpc.ServerStreamingServer[api.Event]) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	ctx := stream.Context()
+	uid, _ := auth.SubjectFromContext(ctx)
+	if uid == "" && req.PostUid == "" {
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.StreamEvents(ctx, uid, req, stream.Send); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type FollowHandler struct {
@@ -63,3 +64,65 @@ func (h *FollowHandler) ListMyFollowing(ctx context.Context, req *api.ListMyFoll
 	}
 	return h.svc.ListMyFollowing(ctx, uid, req)
 }
+
+func (h *FollowHandler) ListIncomingFollowRequests(ctx context.Context, req *api.ListIncomingFollowRequestsRequest) (*api.ListIncomingFollowRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListIncomingFollowRequests(ctx, uid, req)
+}
+
+func (h *FollowHandler) ListOutgoingFollowRequests(ctx context.Context, req *api.ListOutgoingFollowRequestsRequest) (*api.ListOutgoingFollowRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListOutgoingFollowRequests(ctx, uid, req)
+}
+
+func (h *FollowHandler) ApproveFollowRequest(ctx context.Context, req *api.ApproveFollowRequestRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.ApproveFollowRequest(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *FollowHandler) RejectFollowRequest(ctx context.Context, req *api.RejectFollowRequestRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RejectFollowRequest(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
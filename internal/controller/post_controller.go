@@ -5,6 +5,9 @@ import (
 	"aeibi/internal/auth"
 	"aeibi/internal/service"
 	"context"
+	"slices"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,6 +30,32 @@ func (h *PostHandler) CreatePost(ctx context.Context, req *api.CreatePostRequest
 	if req.Text == "" {
 		return nil, status.Error(codes.InvalidArgument, "content is required")
 	}
+	switch req.Visibility {
+	case "", "PUBLIC", "PRIVATE", "FOLLOWERS", "UNLISTED":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "visibility is invalid")
+	}
+	switch req.Status {
+	case "", "NORMAL", "DRAFT":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status is invalid")
+	}
+	if req.PublishAt != 0 && req.PublishAt <= time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "publish_at must be in the future")
+	}
+	if req.Poll != nil {
+		if len(req.Poll.Options) < 2 || len(req.Poll.Options) > 10 {
+			return nil, status.Error(codes.InvalidArgument, "poll must have 2 to 10 options")
+		}
+		for _, option := range req.Poll.Options {
+			if strings.TrimSpace(option) == "" {
+				return nil, status.Error(codes.InvalidArgument, "poll option is required")
+			}
+		}
+		if req.Poll.ExpiresAt <= max(time.Now().Unix(), req.PublishAt) {
+			return nil, status.Error(codes.InvalidArgument, "poll expires_at must be after the post is published")
+		}
+	}
 	uid, ok := auth.SubjectFromContext(ctx)
 	if !ok || uid == "" {
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
@@ -84,6 +113,34 @@ func (h *PostHandler) ListMyPosts(ctx context.Context, req *api.ListPostsRequest
 	return h.svc.ListMyPosts(ctx, uid, req)
 }
 
+func (h *PostHandler) ListMyDrafts(ctx context.Context, req *api.ListPostsRequest) (*api.ListPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListMyDrafts(ctx, uid, req)
+}
+
+func (h *PostHandler) ListHomeTimeline(ctx context.Context, req *api.ListPostsRequest) (*api.ListPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListHomeTimeline(ctx, uid, req)
+}
+
 func (h *PostHandler) ListMyCollections(ctx context.Context, req *api.ListPostsRequest) (*api.ListPostsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -125,6 +182,23 @@ func (h *PostHandler) UpdatePost(ctx context.Context, req *api.UpdatePostRequest
 	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
 	}
+	if slices.Contains(req.UpdateMask.Paths, "visibility") {
+		switch req.Post.Visibility {
+		case "PUBLIC", "PRIVATE", "FOLLOWERS", "UNLISTED":
+		default:
+			return nil, status.Error(codes.InvalidArgument, "visibility is invalid")
+		}
+	}
+	if slices.Contains(req.UpdateMask.Paths, "status") {
+		switch req.Post.Status {
+		case "NORMAL", "DRAFT":
+		default:
+			return nil, status.Error(codes.InvalidArgument, "status is invalid")
+		}
+	}
+	if slices.Contains(req.UpdateMask.Paths, "publish_at") && req.Post.PublishAt != 0 && req.Post.PublishAt <= time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "publish_at must be in the future")
+	}
 	uid, ok := auth.SubjectFromContext(ctx)
 	if !ok || uid == "" {
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
@@ -135,6 +209,20 @@ func (h *PostHandler) UpdatePost(ctx context.Context, req *api.UpdatePostRequest
 	return &emptypb.Empty{}, nil
 }
 
+func (h *PostHandler) ListPostRevisions(ctx context.Context, req *api.ListPostRevisionsRequest) (*api.ListPostRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.ListPostRevisions(ctx, viewerUid, req)
+}
+
 func (h *PostHandler) DeletePost(ctx context.Context, req *api.DeletePostRequest) (*emptypb.Empty, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -179,3 +267,34 @@ func (h *PostHandler) CollectPost(ctx context.Context, req *api.CollectPostReque
 	}
 	return h.svc.CollectPost(ctx, uid, req)
 }
+
+func (h *PostHandler) RepostPost(ctx context.Context, req *api.RepostPostRequest) (*api.RepostPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.RepostPost(ctx, uid, req)
+}
+
+func (h *PostHandler) VotePoll(ctx context.Context, req *api.VotePollRequest) (*api.VotePollResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if len(req.Choices) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "choices is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.VotePoll(ctx, uid, req)
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/service"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ReportHandler struct {
+	api.UnimplementedReportServiceServer
+	svc *service.ReportService
+}
+
+func NewReportHandler(svc *service.ReportService) *ReportHandler {
+	return &ReportHandler{svc: svc}
+}
+
+func (h *ReportHandler) CreateReport(ctx context.Context, req *api.CreateReportRequest) (*api.CreateReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	switch req.TargetType {
+	case "POST", "COMMENT", "USER":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "target_type must be POST, COMMENT or USER")
+	}
+	if req.TargetUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_uid is required")
+	}
+	switch req.Reason {
+	case "SPAM", "HARASSMENT", "HATE", "VIOLENCE", "SEXUAL", "MISINFORMATION", "OTHER":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "reason is invalid")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if req.TargetType == "USER" && req.TargetUid == uid {
+		return nil, status.Error(codes.InvalidArgument, "cannot report yourself")
+	}
+	return h.svc.CreateReport(ctx, uid, req)
+}
+
+func (h *ReportHandler) ListReports(ctx context.Context, req *api.ListReportsRequest) (*api.ListReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	switch req.Status {
+	case "", "OPEN", "CLAIMED", "RESOLVED", "DISMISSED":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status is invalid")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	return h.svc.ListReports(ctx, req)
+}
+
+func (h *ReportHandler) ClaimReport(ctx context.Context, req *api.ClaimReportRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.ClaimReport(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ReportHandler) ResolveReport(ctx context.Context, req *api.ResolveReportRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.ResolveReport(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ReportHandler) DismissReport(ctx context.Context, req *api.DismissReportRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.DismissReport(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/service"
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SearchHandler struct {
+	api.UnimplementedSearchServiceServer
+	svc *service.SearchService
+}
+
+func NewSearchHandler(svc *service.SearchService) *SearchHandler {
+	return &SearchHandler{svc: svc}
+}
+
+func (h *SearchHandler) SearchPosts(ctx context.Context, req *api.SearchRequest) (*api.SearchPostsResponse, error) {
+	if err := validateSearchRequest(req); err != nil {
+		return nil, err
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.SearchPosts(ctx, viewerUid, req)
+}
+
+func (h *SearchHandler) SearchComments(ctx context.Context, req *api.SearchRequest) (*api.SearchCommentsResponse, error) {
+	if err := validateSearchRequest(req); err != nil {
+		return nil, err
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.SearchComments(ctx, viewerUid, req)
+}
+
+func (h *SearchHandler) SearchUsers(ctx context.Context, req *api.SearchRequest) (*api.SearchUsersResponse, error) {
+	if err := validateSearchRequest(req); err != nil {
+		return nil, err
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.SearchUsers(ctx, viewerUid, req)
+}
+
+func validateSearchRequest(req *api.SearchRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		return status.Error(codes.InvalidArgument, "query is required")
+	}
+	if req.CreatedAfter != 0 && req.CreatedBefore != 0 && req.CreatedAfter >= req.CreatedBefore {
+		return status.Error(codes.InvalidArgument, "created_after must be before created_before")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/service"
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type TagHandler struct {
+	api.UnimplementedTagServiceServer
+	svc *service.TagService
+}
+
+func NewTagHandler(svc *service.TagService) *TagHandler {
+	return &TagHandler{svc: svc}
+}
+
+func (h *TagHandler) GetTag(ctx context.Context, req *api.GetTagRequest) (*api.GetTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.GetTag(ctx, viewerUid, req)
+}
+
+func (h *TagHandler) ListPostsByTag(ctx context.Context, req *api.ListPostsByTagRequest) (*api.ListPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	viewerUid, _ := auth.SubjectFromContext(ctx)
+	return h.svc.ListPostsByTag(ctx, viewerUid, req)
+}
+
+func (h *TagHandler) AutocompleteTags(ctx context.Context, req *api.AutocompleteTagsRequest) (*api.AutocompleteTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Prefix) == "" {
+		return nil, status.Error(codes.InvalidArgument, "prefix is required")
+	}
+	return h.svc.AutocompleteTags(ctx, req)
+}
+
+func (h *TagHandler) ListTrendingTags(ctx context.Context, req *api.ListTrendingTagsRequest) (*api.ListTrendingTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.WindowHours < 0 {
+		return nil, status.Error(codes.InvalidArgument, "window_hours must not be negative")
+	}
+	return h.svc.ListTrendingTags(ctx, req)
+}
+
+func (h *TagHandler) FollowTag(ctx context.Context, req *api.FollowTagRequest) (*api.FollowTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.FollowTag(ctx, uid, req)
+}
+
+func (h *TagHandler) ListMyFollowedTags(ctx context.Context, req *api.ListMyFollowedTagsRequest) (*api.ListMyFollowedTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if (req.CursorCreatedAt == 0 && req.CursorId != "") || (req.CursorCreatedAt != 0 && req.CursorId == "") {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.ListMyFollowedTags(ctx, uid, req)
+}
+
+func (h *TagHandler) RenameTag(ctx context.Context, req *api.RenameTagRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if strings.TrimSpace(req.NewName) == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_name is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RenameTag(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *TagHandler) MergeTags(ctx context.Context, req *api.MergeTagsRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimSpace(req.Source) == "" {
+		return nil, status.Error(codes.InvalidArgument, "source is required")
+	}
+	if strings.TrimSpace(req.Target) == "" {
+		return nil, status.Error(codes.InvalidArgument, "target is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.MergeTags(ctx, uid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
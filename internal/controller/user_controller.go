@@ -5,6 +5,7 @@ import (
 	"aeibi/internal/auth"
 	"aeibi/internal/service"
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -47,6 +48,20 @@ func (h *UserHandler) GetUser(ctx context.Context, req *api.GetUserRequest) (*ap
 	return h.svc.GetUser(ctx, viewerUid, req)
 }
 
+func (h *UserHandler) AutocompleteUsers(ctx context.Context, req *api.AutocompleteUsersRequest) (*api.AutocompleteUsersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if strings.TrimLeft(strings.TrimSpace(req.Prefix), "@") == "" {
+		return nil, status.Error(codes.InvalidArgument, "prefix is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return h.svc.AutocompleteUsers(ctx, uid, req)
+}
+
 func (h *UserHandler) GetMe(ctx context.Context, _ *emptypb.Empty) (*api.GetMeResponse, error) {
 	uid, ok := auth.SubjectFromContext(ctx)
 	if !ok {
@@ -78,6 +93,27 @@ func (h *UserHandler) UpdateMe(ctx context.Context, req *api.UpdateMeRequest) (*
 	return &emptypb.Empty{}, nil
 }
 
+func (h *UserHandler) ChangePassword(ctx context.Context, req *api.ChangePasswordRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.OldPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "old_password is required")
+	}
+	if req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	sessionUid, _ := auth.SessionFromContext(ctx)
+	if err := h.svc.ChangePassword(ctx, uid, sessionUid, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (h *UserHandler) Login(ctx context.Context, req *api.LoginRequest) (*api.LoginResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -88,7 +124,8 @@ func (h *UserHandler) Login(ctx context.Context, req *api.LoginRequest) (*api.Lo
 	if req.Password == "" {
 		return nil, status.Error(codes.InvalidArgument, "password is required")
 	}
-	return h.svc.Login(ctx, req)
+	userAgent, ip := auth.ClientFromContext(ctx)
+	return h.svc.Login(ctx, req, userAgent, ip)
 }
 
 func (h *UserHandler) RefreshToken(ctx context.Context, req *api.RefreshTokenRequest) (*api.RefreshTokenResponse, error) {
@@ -98,5 +135,62 @@ func (h *UserHandler) RefreshToken(ctx context.Context, req *api.RefreshTokenReq
 	if req.RefreshToken == "" {
 		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
 	}
-	return h.svc.RefreshToken(ctx, req)
+	userAgent, ip := auth.ClientFromContext(ctx)
+	return h.svc.RefreshToken(ctx, req, userAgent, ip)
+}
+
+func (h *UserHandler) Logout(ctx context.Context, req *api.LogoutRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+	info, _ := auth.FromContext(ctx)
+	if err := h.svc.Logout(ctx, req.RefreshToken, info.TokenID, info.ExpiresAt); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) ListMySessions(ctx context.Context, _ *emptypb.Empty) (*api.ListMySessionsResponse, error) {
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	sessionUid, _ := auth.SessionFromContext(ctx)
+	return h.svc.ListMySessions(ctx, uid, sessionUid)
+}
+
+func (h *UserHandler) RevokeSession(ctx context.Context, req *api.RevokeSessionRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if err := h.svc.RevokeSession(ctx, uid, req.Uid); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) RevokeAllOtherSessions(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	uid, ok := auth.SubjectFromContext(ctx)
+	if !ok || uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	sessionUid, ok := auth.SessionFromContext(ctx)
+	if !ok {
+		// Tokens issued before sessions existed cannot tell which session to keep.
+		return nil, status.Error(codes.FailedPrecondition, "access token has no session")
+	}
+	if err := h.svc.RevokeAllOtherSessions(ctx, uid, sessionUid); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
 }
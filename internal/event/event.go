@@ -0,0 +1,163 @@
+package event
+
+import (
+	"aeibi/api"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Event types carried in api.Event.Type.
+const (
+	TypeNotification = "NOTIFICATION"
+	TypeComment      = "COMMENT"
+	TypeCounter      = "COUNTER"
+	TypeMessage      = "MESSAGE"
+	TypeMessageRead  = "MESSAGE_READ"
+	TypeHeartbeat    = "HEARTBEAT"
+)
+
+// Event is a message routed to stream subscribers. UserUid addresses a single user;
+// otherwise PostUid addresses everyone viewing that post.
+type Event struct {
+	UserUid string
+	PostUid string
+	Payload *api.Event
+}
+
+// Notification addresses a new notification to its recipient.
+func Notification(recipientUid string, n *api.NotificationEvent) Event {
+	return Event{
+		UserUid: recipientUid,
+		Payload: &api.Event{Type: TypeNotification, Notification: n, CreatedAt: time.Now().Unix()},
+	}
+}
+
+// Comment announces a new comment or reply to viewers of its post.
+func Comment(c *api.CommentEvent) Event {
+	return Event{
+		PostUid: c.PostUid,
+		Payload: &api.Event{Type: TypeComment, Comment: c, CreatedAt: time.Now().Unix()},
+	}
+}
+
+// Counter announces a changed counter to viewers of postUid. targetType is POST or
+// COMMENT and counter is LIKE, COLLECTION, COMMENT, REPLY or REPOST.
+func Counter(postUid, targetType, targetUid, counter string, count int32) Event {
+	return Event{
+		PostUid: postUid,
+		Payload: &api.Event{
+			Type: TypeCounter,
+			Counter: &api.CounterEvent{
+				TargetType: targetType,
+				TargetUid:  targetUid,
+				Counter:    counter,
+				Count:      count,
+			},
+			CreatedAt: time.Now().Unix(),
+		},
+	}
+}
+
+// Message delivers a new direct message to one conversation member.
+func Message(recipientUid string, m *api.Message) Event {
+	return Event{
+		UserUid: recipientUid,
+		Payload: &api.Event{Type: TypeMessage, Message: m, CreatedAt: time.Now().Unix()},
+	}
+}
+
+// MessageRead tells a conversation member that another member has read up to a message.
+func MessageRead(recipientUid string, r *api.MessageReadEvent) Event {
+	return Event{
+		UserUid: recipientUid,
+		Payload: &api.Event{Type: TypeMessageRead, MessageRead: r, CreatedAt: time.Now().Unix()},
+	}
+}
+
+// Publisher delivers events to subscribers, possibly on other server instances.
+// Services publish only after their transaction has committed.
+type Publisher interface {
+	Publish(ctx context.Context, events ...Event)
+}
+
+// Subscription receives the events matching its user and post.
+type Subscription struct {
+	userUid string
+	postUid string
+	ch      chan *api.Event
+}
+
+// C returns the channel events are delivered on.
+func (s *Subscription) C() <-chan *api.Event {
+	return s.ch
+}
+
+func (s *Subscription) matches(ev Event) bool {
+	if ev.UserUid != "" {
+		return ev.UserUid == s.userUid
+	}
+	return ev.PostUid != "" && ev.PostUid == s.postUid
+}
+
+// Hub is the in-process pub/sub that fans events out to local subscribers.
+type Hub struct {
+	mu         sync.RWMutex
+	subs       map[*Subscription]struct{}
+	bufferSize int
+}
+
+func NewHub(bufferSize int) *Hub {
+	if bufferSize <= 0 {
+		bufferSize = 64
+	}
+	return &Hub{
+		subs:       make(map[*Subscription]struct{}),
+		bufferSize: bufferSize,
+	}
+}
+
+// Subscribe registers a subscriber for userUid's events and, when postUid is set,
+// events about that post.
+func (h *Hub) Subscribe(userUid, postUid string) *Subscription {
+	sub := &Subscription{
+		userUid: userUid,
+		postUid: postUid,
+		ch:      make(chan *api.Event, h.bufferSize),
+	}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	delete(h.subs, sub)
+	h.mu.Unlock()
+}
+
+// Publish dispatches events to local subscribers only.
+func (h *Hub) Publish(_ context.Context, events ...Event) {
+	for _, ev := range events {
+		h.Dispatch(ev)
+	}
+}
+
+// Dispatch delivers ev to every matching subscriber without blocking; a subscriber
+// whose buffer is full misses the event.
+func (h *Hub) Dispatch(ev Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subs {
+		if !sub.matches(ev) {
+			continue
+		}
+		select {
+		case sub.ch <- ev.Payload:
+		default:
+			slog.Warn("event subscriber is lagging, dropping event", "user", sub.userUid, "type", ev.Payload.GetType())
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package event
+
+import (
+	"aeibi/api"
+	"aeibi/internal/repository/db"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const defaultChannel = "aeibi_events"
+
+// maxNotifyPayload stays below Postgres' 8000 byte NOTIFY payload limit.
+const maxNotifyPayload = 7900
+
+// envelope is the NOTIFY payload shared between server instances.
+type envelope struct {
+	UserUid string          `json:"user_uid,omitempty"`
+	PostUid string          `json:"post_uid,omitempty"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// PostgresBroker publishes events with NOTIFY and dispatches everything received on
+// the channel, including its own notifications, to the local hub. Every instance
+// sharing the database therefore sees every event.
+type PostgresBroker struct {
+	db      *db.Queries
+	dsn     string
+	channel string
+	hub     *Hub
+}
+
+func NewPostgresBroker(dbx *sql.DB, dsn, channel string, hub *Hub) *PostgresBroker {
+	if channel == "" {
+		channel = defaultChannel
+	}
+	return &PostgresBroker{
+		db:      db.New(dbx),
+		dsn:     dsn,
+		channel: channel,
+		hub:     hub,
+	}
+}
+
+func (b *PostgresBroker) Publish(ctx context.Context, events ...Event) {
+	for _, ev := range events {
+		payload, err := protojson.Marshal(ev.Payload)
+		if err != nil {
+			slog.Warn("marshal event", "error", err)
+			continue
+		}
+		data, err := json.Marshal(envelope{UserUid: ev.UserUid, PostUid: ev.PostUid, Payload: payload})
+		if err != nil {
+			slog.Warn("marshal event", "error", err)
+			continue
+		}
+		if len(data) > maxNotifyPayload {
+			slog.Warn("event too large for NOTIFY, delivering locally", "type", ev.Payload.GetType(), "size", len(data))
+			b.hub.Dispatch(ev)
+			continue
+		}
+		if err := b.db.NotifyEvent(ctx, db.NotifyEventParams{Channel: b.channel, Payload: string(data)}); err != nil {
+			slog.Warn("notify event", "error", err)
+		}
+	}
+}
+
+// Run listens on the channel and blocks until ctx is done.
+func (b *PostgresBroker) Run(ctx context.Context) error {
+	listener := pq.NewListener(b.dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			slog.Warn("event listener", "event", ev, "error", err)
+		}
+	})
+	defer listener.Close()
+	if err := listener.Listen(b.channel); err != nil {
+		return fmt.Errorf("listen %s: %w", b.channel, err)
+	}
+
+	ping := time.NewTicker(time.Minute)
+	defer ping.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ping.C:
+			if err := listener.Ping(); err != nil {
+				slog.Warn("event listener ping", "error", err)
+			}
+		case n := <-listener.NotificationChannel():
+			// A nil notification follows a reconnect; events sent meanwhile are lost.
+			if n == nil {
+				continue
+			}
+			var env envelope
+			if err := json.Unmarshal([]byte(n.Extra), &env); err != nil {
+				slog.Warn("decode event", "error", err)
+				continue
+			}
+			payload := &api.Event{}
+			if err := protojson.Unmarshal(env.Payload, payload); err != nil {
+				slog.Warn("decode event", "error", err)
+				continue
+			}
+			b.hub.Dispatch(Event{UserUid: env.UserUid, PostUid: env.PostUid, Payload: payload})
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: access_token_denial.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const deleteExpiredAccessTokenDenials = `-- name: DeleteExpiredAccessTokenDenials :exec
+DELETE FROM access_token_denials
+WHERE expires_at <= now()
+`
+
+func (q *Queries) DeleteExpiredAccessTokenDenials(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredAccessTokenDenials)
+	return err
+}
+
+const denyAccessTokens = `-- name: DenyAccessTokens :one
+INSERT INTO access_token_denials (kind, value, expires_at)
+VALUES ($1, $2, $3) ON CONFLICT (kind, value) DO
+UPDATE
+SET denied_at = now(),
+  expires_at = GREATEST(
+    access_token_denials.expires_at,
+    EXCLUDED.expires_at
+  )
+RETURNING denied_at
+`
+
+type DenyAccessTokensParams struct {
+	Kind      AccessTokenDenialKind
+	Value     string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) DenyAccessTokens(ctx context.Context, arg DenyAccessTokensParams) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, denyAccessTokens, arg.Kind, arg.Value, arg.ExpiresAt)
+	var denied_at time.Time
+	err := row.Scan(&denied_at)
+	return denied_at, err
+}
+
+const listAccessTokenDenials = `-- name: ListAccessTokenDenials :many
+SELECT kind,
+  value,
+  denied_at,
+  expires_at
+FROM access_token_denials
+WHERE denied_at > $1
+  AND expires_at > now()
+`
+
+func (q *Queries) ListAccessTokenDenials(ctx context.Context, since time.Time) ([]AccessTokenDenial, error) {
+	rows, err := q.db.QueryContext(ctx, listAccessTokenDenials, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccessTokenDenial
+	for rows.Next() {
+		var i AccessTokenDenial
+		if err := rows.Scan(
+			&i.Kind,
+			&i.Value,
+			&i.DeniedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
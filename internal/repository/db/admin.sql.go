@@ -0,0 +1,519 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: admin.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const createAdminAuditLog = `-- name: CreateAdminAuditLog :exec
+INSERT INTO admin_audit_logs (
+    actor_uid,
+    action,
+    target_type,
+    target_uid,
+    reason,
+    detail
+  )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+  )
+`
+
+type CreateAdminAuditLogParams struct {
+	ActorUid   uuid.UUID
+	Action     AdminAction
+	TargetType AdminTargetType
+	TargetUid  uuid.UUID
+	Reason     string
+	Detail     string
+}
+
+func (q *Queries) CreateAdminAuditLog(ctx context.Context, arg CreateAdminAuditLogParams) error {
+	_, err := q.db.ExecContext(ctx, createAdminAuditLog,
+		arg.ActorUid,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetUid,
+		arg.Reason,
+		arg.Detail,
+	)
+	return err
+}
+
+const getCommentMetaForAdmin = `-- name: GetCommentMetaForAdmin :one
+SELECT post_uid,
+  author_uid,
+  root_uid,
+  status
+FROM post_comments
+WHERE uid = $1
+LIMIT 1
+`
+
+type GetCommentMetaForAdminRow struct {
+	PostUid   uuid.UUID
+	AuthorUid uuid.UUID
+	RootUid   uuid.UUID
+	Status    CommentStatus
+}
+
+func (q *Queries) GetCommentMetaForAdmin(ctx context.Context, uid uuid.UUID) (GetCommentMetaForAdminRow, error) {
+	row := q.db.QueryRowContext(ctx, getCommentMetaForAdmin, uid)
+	var i GetCommentMetaForAdminRow
+	err := row.Scan(
+		&i.PostUid,
+		&i.AuthorUid,
+		&i.RootUid,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getUserRoleAndStatus = `-- name: GetUserRoleAndStatus :one
+SELECT uid,
+  role,
+  status
+FROM users
+WHERE uid = $1
+`
+
+type GetUserRoleAndStatusRow struct {
+	Uid    uuid.UUID
+	Role   UserRole
+	Status UserStatus
+}
+
+func (q *Queries) GetUserRoleAndStatus(ctx context.Context, uid uuid.UUID) (GetUserRoleAndStatusRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserRoleAndStatus, uid)
+	var i GetUserRoleAndStatusRow
+	err := row.Scan(&i.Uid, &i.Role, &i.Status)
+	return i, err
+}
+
+const listAdminAuditLogs = `-- name: ListAdminAuditLogs :many
+SELECT l.uid,
+  l.actor_uid,
+  u.nickname AS actor_nickname,
+  l.action,
+  l.target_type,
+  l.target_uid,
+  l.reason,
+  l.detail,
+  l.created_at
+FROM admin_audit_logs l
+  LEFT JOIN users u ON u.uid = l.actor_uid
+WHERE (
+    $1::uuid IS NULL
+    OR l.target_uid = $1::uuid
+  )
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (l.created_at, l.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY l.created_at DESC,
+  l.uid DESC
+LIMIT 20
+`
+
+type ListAdminAuditLogsParams struct {
+	TargetUid       uuid.NullUUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListAdminAuditLogsRow struct {
+	Uid           uuid.UUID
+	ActorUid      uuid.UUID
+	ActorNickname sql.NullString
+	Action        AdminAction
+	TargetType    AdminTargetType
+	TargetUid     uuid.UUID
+	Reason        string
+	Detail        string
+	CreatedAt     time.Time
+}
+
+func (q *Queries) ListAdminAuditLogs(ctx context.Context, arg ListAdminAuditLogsParams) ([]ListAdminAuditLogsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAdminAuditLogs, arg.TargetUid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAdminAuditLogsRow
+	for rows.Next() {
+		var i ListAdminAuditLogsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.ActorUid,
+			&i.ActorNickname,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetUid,
+			&i.Reason,
+			&i.Detail,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArchivedComments = `-- name: ListArchivedComments :many
+SELECT c.uid,
+  c.post_uid,
+  c.author_uid,
+  u.nickname AS author_nickname,
+  c.root_uid,
+  c.content,
+  c.images,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  LEFT JOIN users u ON u.uid = c.author_uid
+WHERE c.status = 'ARCHIVED'::comment_status
+  AND (
+    (
+      $1::timestamptz IS NULL
+      AND $2::uuid IS NULL
+    )
+    OR (c.updated_at, c.uid) < (
+      $1::timestamptz,
+      $2::uuid
+    )
+  )
+ORDER BY c.updated_at DESC,
+  c.uid DESC
+LIMIT 20
+`
+
+type ListArchivedCommentsParams struct {
+	CursorArchivedAt sql.NullTime
+	CursorID         uuid.NullUUID
+}
+
+type ListArchivedCommentsRow struct {
+	Uid            uuid.UUID
+	PostUid        uuid.UUID
+	AuthorUid      uuid.UUID
+	AuthorNickname sql.NullString
+	RootUid        uuid.UUID
+	Content        string
+	Images         []string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (q *Queries) ListArchivedComments(ctx context.Context, arg ListArchivedCommentsParams) ([]ListArchivedCommentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArchivedComments, arg.CursorArchivedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArchivedCommentsRow
+	for rows.Next() {
+		var i ListArchivedCommentsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PostUid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.RootUid,
+			&i.Content,
+			pq.Array(&i.Images),
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArchivedPosts = `-- name: ListArchivedPosts :many
+SELECT p.uid,
+  p.author,
+  u.nickname AS author_nickname,
+  p.text,
+  p.images,
+  p.visibility,
+  p.created_at,
+  p.updated_at
+FROM posts p
+  LEFT JOIN users u ON u.uid = p.author
+WHERE p.status = 'ARCHIVED'::post_status
+  AND (
+    (
+      $1::timestamptz IS NULL
+      AND $2::uuid IS NULL
+    )
+    OR (p.updated_at, p.uid) < (
+      $1::timestamptz,
+      $2::uuid
+    )
+  )
+ORDER BY p.updated_at DESC,
+  p.uid DESC
+LIMIT 20
+`
+
+type ListArchivedPostsParams struct {
+	CursorArchivedAt sql.NullTime
+	CursorID         uuid.NullUUID
+}
+
+type ListArchivedPostsRow struct {
+	Uid            uuid.UUID
+	Author         uuid.UUID
+	AuthorNickname sql.NullString
+	Text           string
+	Images         []string
+	Visibility     PostVisibility
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (q *Queries) ListArchivedPosts(ctx context.Context, arg ListArchivedPostsParams) ([]ListArchivedPostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArchivedPosts, arg.CursorArchivedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArchivedPostsRow
+	for rows.Next() {
+		var i ListArchivedPostsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorNickname,
+			&i.Text,
+			pq.Array(&i.Images),
+			&i.Visibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArchivedUsers = `-- name: ListArchivedUsers :many
+SELECT uid,
+  username,
+  role,
+  email,
+  nickname,
+  avatar_url,
+  followers_count,
+  following_count,
+  updated_at
+FROM users
+WHERE status = 'ARCHIVED'::user_status
+  AND (
+    (
+      $1::timestamptz IS NULL
+      AND $2::uuid IS NULL
+    )
+    OR (updated_at, uid) < (
+      $1::timestamptz,
+      $2::uuid
+    )
+  )
+ORDER BY updated_at DESC,
+  uid DESC
+LIMIT 20
+`
+
+type ListArchivedUsersParams struct {
+	CursorArchivedAt sql.NullTime
+	CursorID         uuid.NullUUID
+}
+
+type ListArchivedUsersRow struct {
+	Uid            uuid.UUID
+	Username       string
+	Role           UserRole
+	Email          string
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	UpdatedAt      time.Time
+}
+
+func (q *Queries) ListArchivedUsers(ctx context.Context, arg ListArchivedUsersParams) ([]ListArchivedUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArchivedUsers, arg.CursorArchivedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArchivedUsersRow
+	for rows.Next() {
+		var i ListArchivedUsersRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Username,
+			&i.Role,
+			&i.Email,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setCommentStatus = `-- name: SetCommentStatus :execrows
+UPDATE post_comments
+SET status = $1,
+  updated_at = now()
+WHERE uid = $2
+  AND status <> $1
+`
+
+type SetCommentStatusParams struct {
+	Status CommentStatus
+	Uid    uuid.UUID
+}
+
+func (q *Queries) SetCommentStatus(ctx context.Context, arg SetCommentStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setCommentStatus, arg.Status, arg.Uid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setPostStatus = `-- name: SetPostStatus :one
+WITH changed AS (
+  UPDATE posts cp
+  SET status = $1,
+    updated_at = now()
+  WHERE cp.uid = $2
+    AND cp.status <> $1
+  RETURNING cp.repost_of_uid,
+    cp.status
+),
+reposts AS (
+  UPDATE posts o
+  SET repost_count = GREATEST(
+      o.repost_count + CASE
+        WHEN c.status = 'NORMAL'::post_status THEN 1
+        ELSE -1
+      END,
+      0
+    )
+  FROM changed c
+  WHERE o.uid = c.repost_of_uid
+  RETURNING 1
+)
+SELECT count(*)
+FROM changed
+`
+
+type SetPostStatusParams struct {
+	Status PostStatus
+	Uid    uuid.UUID
+}
+
+func (q *Queries) SetPostStatus(ctx context.Context, arg SetPostStatusParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, setPostStatus, arg.Status, arg.Uid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const setUserRole = `-- name: SetUserRole :execrows
+UPDATE users
+SET role = $1,
+  updated_at = now()
+WHERE uid = $2
+  AND role <> $1
+`
+
+type SetUserRoleParams struct {
+	Role UserRole
+	Uid  uuid.UUID
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setUserRole, arg.Role, arg.Uid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setUserStatus = `-- name: SetUserStatus :execrows
+UPDATE users
+SET status = $1,
+  updated_at = now()
+WHERE uid = $2
+  AND status <> $1
+`
+
+type SetUserStatusParams struct {
+	Status UserStatus
+	Uid    uuid.UUID
+}
+
+func (q *Queries) SetUserStatus(ctx context.Context, arg SetUserStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setUserStatus, arg.Status, arg.Uid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,63 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: casbin_rule.sql
+
+package db
+
+import (
+	"context"
+)
+
+const listCasbinRules = `-- name: ListCasbinRules :many
+SELECT ptype,
+  v0,
+  v1,
+  v2,
+  v3,
+  v4,
+  v5
+FROM casbin_rules
+ORDER BY id
+`
+
+type ListCasbinRulesRow struct {
+	Ptype string
+	V0    string
+	V1    string
+	V2    string
+	V3    string
+	V4    string
+	V5    string
+}
+
+func (q *Queries) ListCasbinRules(ctx context.Context) ([]ListCasbinRulesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCasbinRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCasbinRulesRow
+	for rows.Next() {
+		var i ListCasbinRulesRow
+		if err := rows.Scan(
+			&i.Ptype,
+			&i.V0,
+			&i.V1,
+			&i.V2,
+			&i.V3,
+			&i.V4,
+			&i.V5,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
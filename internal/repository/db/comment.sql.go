@@ -81,6 +81,7 @@ INSERT INTO post_comments (
     parent_uid,
     reply_to_author_uid,
     content,
+    entities,
     images,
     ip
   )
@@ -93,7 +94,8 @@ VALUES (
     $6,
     $7,
     $8,
-    $9
+    $9,
+    $10
   )
 RETURNING id,
   uid
@@ -107,6 +109,7 @@ type CreateCommentParams struct {
 	ParentUid        uuid.NullUUID
 	ReplyToAuthorUid uuid.NullUUID
 	Content          string
+	Entities         string
 	Images           []string
 	Ip               string
 }
@@ -125,6 +128,7 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		arg.ParentUid,
 		arg.ReplyToAuthorUid,
 		arg.Content,
+		arg.Entities,
 		pq.Array(arg.Images),
 		arg.Ip,
 	)
@@ -231,6 +235,7 @@ SELECT c.uid,
   c.parent_uid,
   c.reply_to_author_uid,
   c.content,
+  c.entities,
   c.images,
   c.reply_count,
   c.like_count,
@@ -246,6 +251,18 @@ FROM post_comments c
 WHERE c.status = 'NORMAL'::comment_status
   AND c.root_uid = $2
   AND c.root_uid <> c.uid
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = $1::uuid
+      AND ub.blocked_uid = c.author_uid
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_mutes um
+    WHERE um.muter_uid = $1::uuid
+      AND um.muted_uid = c.author_uid
+  )
 ORDER BY c.created_at ASC,
   c.uid ASC
 LIMIT 10 OFFSET ($3::int - 1) * 10
@@ -267,6 +284,7 @@ type ListRepliesRow struct {
 	ParentUid        uuid.NullUUID
 	ReplyToAuthorUid uuid.NullUUID
 	Content          string
+	Entities         string
 	Images           []string
 	ReplyCount       int32
 	LikeCount        int32
@@ -295,6 +313,7 @@ func (q *Queries) ListReplies(ctx context.Context, arg ListRepliesParams) ([]Lis
 			&i.ParentUid,
 			&i.ReplyToAuthorUid,
 			&i.Content,
+			&i.Entities,
 			pq.Array(&i.Images),
 			&i.ReplyCount,
 			&i.LikeCount,
@@ -326,6 +345,7 @@ SELECT c.uid,
   c.parent_uid,
   c.reply_to_author_uid,
   c.content,
+  c.entities,
   c.images,
   c.reply_count,
   c.like_count,
@@ -340,6 +360,18 @@ FROM post_comments c
 WHERE c.status = 'NORMAL'::comment_status
   AND c.post_uid = $2
   AND c.parent_uid IS NULL
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = $1::uuid
+      AND ub.blocked_uid = c.author_uid
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_mutes um
+    WHERE um.muter_uid = $1::uuid
+      AND um.muted_uid = c.author_uid
+  )
   AND (
     (
       $3::timestamptz IS NULL
@@ -372,6 +404,7 @@ type ListTopCommentsRow struct {
 	ParentUid        uuid.NullUUID
 	ReplyToAuthorUid uuid.NullUUID
 	Content          string
+	Entities         string
 	Images           []string
 	ReplyCount       int32
 	LikeCount        int32
@@ -404,6 +437,7 @@ func (q *Queries) ListTopComments(ctx context.Context, arg ListTopCommentsParams
 			&i.ParentUid,
 			&i.ReplyToAuthorUid,
 			&i.Content,
+			&i.Entities,
 			pq.Array(&i.Images),
 			&i.ReplyCount,
 			&i.LikeCount,
@@ -62,6 +62,80 @@ func (q *Queries) AddFollow(ctx context.Context, arg AddFollowParams) (AddFollow
 	return i, err
 }
 
+const createFollowRequest = `-- name: CreateFollowRequest :execrows
+INSERT INTO follow_requests (requester_uid, target_uid)
+SELECT $1,
+  $2
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM user_follows
+    WHERE follower_uid = $1
+      AND followee_uid = $2
+  ) ON CONFLICT DO NOTHING
+`
+
+type CreateFollowRequestParams struct {
+	RequesterUid uuid.UUID
+	TargetUid    uuid.UUID
+}
+
+func (q *Queries) CreateFollowRequest(ctx context.Context, arg CreateFollowRequestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createFollowRequest, arg.RequesterUid, arg.TargetUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteFollowRequest = `-- name: DeleteFollowRequest :execrows
+DELETE FROM follow_requests
+WHERE requester_uid = $1
+  AND target_uid = $2
+`
+
+type DeleteFollowRequestParams struct {
+	RequesterUid uuid.UUID
+	TargetUid    uuid.UUID
+}
+
+func (q *Queries) DeleteFollowRequest(ctx context.Context, arg DeleteFollowRequestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteFollowRequest, arg.RequesterUid, arg.TargetUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getFollowCounts = `-- name: GetFollowCounts :one
+SELECT (
+    SELECT following_count
+    FROM users
+    WHERE users.uid = $1
+  )::int4 AS following_count,
+  (
+    SELECT followers_count
+    FROM users
+    WHERE users.uid = $2
+  )::int4 AS followers_count
+`
+
+type GetFollowCountsParams struct {
+	FollowerUid uuid.UUID
+	FolloweeUid uuid.UUID
+}
+
+type GetFollowCountsRow struct {
+	FollowingCount int32
+	FollowersCount int32
+}
+
+func (q *Queries) GetFollowCounts(ctx context.Context, arg GetFollowCountsParams) (GetFollowCountsRow, error) {
+	row := q.db.QueryRowContext(ctx, getFollowCounts, arg.FollowerUid, arg.FolloweeUid)
+	var i GetFollowCountsRow
+	err := row.Scan(&i.FollowingCount, &i.FollowersCount)
+	return i, err
+}
+
 const isFollowing = `-- name: IsFollowing :one
 SELECT EXISTS(
   SELECT 1
@@ -83,6 +157,20 @@ func (q *Queries) IsFollowing(ctx context.Context, arg IsFollowingParams) (bool,
 	return is_following, err
 }
 
+const isPrivateUser = `-- name: IsPrivateUser :one
+SELECT is_private
+FROM users
+WHERE uid = $1
+  AND status = 'NORMAL'::user_status
+`
+
+func (q *Queries) IsPrivateUser(ctx context.Context, uid uuid.UUID) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isPrivateUser, uid)
+	var is_private bool
+	err := row.Scan(&is_private)
+	return is_private, err
+}
+
 const listFollowers = `-- name: ListFollowers :many
 SELECT uf.created_at AS followed_at,
   u.uid,
@@ -243,6 +331,166 @@ func (q *Queries) ListFollowing(ctx context.Context, arg ListFollowingParams) ([
 	return items, nil
 }
 
+const listIncomingFollowRequests = `-- name: ListIncomingFollowRequests :many
+SELECT fr.created_at AS requested_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  u.status,
+  u.created_at
+FROM follow_requests fr
+  JOIN users u ON u.uid = fr.requester_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE fr.target_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (fr.created_at, fr.requester_uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY fr.created_at DESC,
+  fr.requester_uid DESC
+LIMIT 20
+`
+
+type ListIncomingFollowRequestsParams struct {
+	Uid             uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListIncomingFollowRequestsRow struct {
+	RequestedAt    time.Time
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	Status         UserStatus
+	CreatedAt      time.Time
+}
+
+func (q *Queries) ListIncomingFollowRequests(ctx context.Context, arg ListIncomingFollowRequestsParams) ([]ListIncomingFollowRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listIncomingFollowRequests, arg.Uid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListIncomingFollowRequestsRow
+	for rows.Next() {
+		var i ListIncomingFollowRequestsRow
+		if err := rows.Scan(
+			&i.RequestedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutgoingFollowRequests = `-- name: ListOutgoingFollowRequests :many
+SELECT fr.created_at AS requested_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  u.status,
+  u.created_at
+FROM follow_requests fr
+  JOIN users u ON u.uid = fr.target_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE fr.requester_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (fr.created_at, fr.target_uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY fr.created_at DESC,
+  fr.target_uid DESC
+LIMIT 20
+`
+
+type ListOutgoingFollowRequestsParams struct {
+	Uid             uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListOutgoingFollowRequestsRow struct {
+	RequestedAt    time.Time
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	Status         UserStatus
+	CreatedAt      time.Time
+}
+
+func (q *Queries) ListOutgoingFollowRequests(ctx context.Context, arg ListOutgoingFollowRequestsParams) ([]ListOutgoingFollowRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOutgoingFollowRequests, arg.Uid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOutgoingFollowRequestsRow
+	for rows.Next() {
+		var i ListOutgoingFollowRequestsRow
+		if err := rows.Scan(
+			&i.RequestedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const removeFollow = `-- name: RemoveFollow :one
 WITH deleted AS (
   DELETE FROM user_follows
@@ -0,0 +1,127 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mention.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const getMentionableUsers = `-- name: GetMentionableUsers :many
+SELECT u.uid,
+  u.username
+FROM users u
+WHERE u.username = ANY($1::text [])
+  AND u.status = 'NORMAL'::user_status
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = u.uid
+      AND ub.blocked_uid = $2
+  )
+`
+
+type GetMentionableUsersParams struct {
+	Usernames []string
+	AuthorUid uuid.UUID
+}
+
+type GetMentionableUsersRow struct {
+	Uid      uuid.UUID
+	Username string
+}
+
+func (q *Queries) GetMentionableUsers(ctx context.Context, arg GetMentionableUsersParams) ([]GetMentionableUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMentionableUsers, pq.Array(arg.Usernames), arg.AuthorUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMentionableUsersRow
+	for rows.Next() {
+		var i GetMentionableUsersRow
+		if err := rows.Scan(&i.Uid, &i.Username); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const syncMentions = `-- name: SyncMentions :many
+WITH input AS (
+  SELECT DISTINCT unnest($5::uuid []) AS mentioned_uid
+),
+del AS (
+  DELETE FROM mentions m
+  WHERE m.source_type = $1
+    AND m.source_uid = $2
+    AND NOT EXISTS (
+      SELECT 1
+      FROM input i
+      WHERE i.mentioned_uid = m.mentioned_uid
+    )
+)
+INSERT INTO mentions (
+    source_type,
+    source_uid,
+    mentioned_uid,
+    author_uid,
+    post_uid
+  )
+SELECT $1,
+  $2,
+  mentioned_uid,
+  $3,
+  $4
+FROM input ON CONFLICT DO NOTHING
+RETURNING mentioned_uid
+`
+
+type SyncMentionsParams struct {
+	SourceType    MentionSource
+	SourceUid     uuid.UUID
+	AuthorUid     uuid.UUID
+	PostUid       uuid.UUID
+	MentionedUids []uuid.UUID
+}
+
+func (q *Queries) SyncMentions(ctx context.Context, arg SyncMentionsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, syncMentions,
+		arg.SourceType,
+		arg.SourceUid,
+		arg.AuthorUid,
+		arg.PostUid,
+		pq.Array(arg.MentionedUids),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var mentioned_uid uuid.UUID
+		if err := rows.Scan(&mentioned_uid); err != nil {
+			return nil, err
+		}
+		items = append(items, mentioned_uid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,997 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: message.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const addConversationMember = `-- name: AddConversationMember :execrows
+INSERT INTO conversation_members (conversation_uid, user_uid, role)
+VALUES ($1, $2, $3) ON CONFLICT DO NOTHING
+`
+
+type AddConversationMemberParams struct {
+	ConversationUid uuid.UUID
+	UserUid         uuid.UUID
+	Role            ConversationRole
+}
+
+func (q *Queries) AddConversationMember(ctx context.Context, arg AddConversationMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addConversationMember, arg.ConversationUid, arg.UserUid, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countConversationMembers = `-- name: CountConversationMembers :one
+SELECT count(*)::integer AS member_count
+FROM conversation_members
+WHERE conversation_uid = $1
+`
+
+func (q *Queries) CountConversationMembers(ctx context.Context, conversationUid uuid.UUID) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countConversationMembers, conversationUid)
+	var member_count int32
+	err := row.Scan(&member_count)
+	return member_count, err
+}
+
+const countUnreadMessages = `-- name: CountUnreadMessages :one
+SELECT COALESCE(sum(unread_count), 0)::integer AS unread_count
+FROM conversation_members
+WHERE user_uid = $1
+`
+
+func (q *Queries) CountUnreadMessages(ctx context.Context, userUid uuid.UUID) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countUnreadMessages, userUid)
+	var unread_count int32
+	err := row.Scan(&unread_count)
+	return unread_count, err
+}
+
+const createGroupConversation = `-- name: CreateGroupConversation :one
+INSERT INTO conversations (type, name, avatar_url)
+VALUES ('GROUP'::conversation_type, $1, $2)
+RETURNING uid
+`
+
+type CreateGroupConversationParams struct {
+	Name      string
+	AvatarUrl string
+}
+
+func (q *Queries) CreateGroupConversation(ctx context.Context, arg CreateGroupConversationParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, createGroupConversation, arg.Name, arg.AvatarUrl)
+	var uid uuid.UUID
+	err := row.Scan(&uid)
+	return uid, err
+}
+
+const createMessage = `-- name: CreateMessage :one
+WITH inserted AS (
+  INSERT INTO messages (conversation_uid, sender_uid, content, images)
+  VALUES ($1, $2, $3, $4)
+  RETURNING uid,
+    sender_uid,
+    created_at
+)
+SELECT i.uid,
+  i.created_at,
+  u.nickname AS sender_nickname,
+  u.avatar_url AS sender_avatar_url
+FROM inserted i
+  JOIN users u ON u.uid = i.sender_uid
+`
+
+type CreateMessageParams struct {
+	ConversationUid uuid.UUID
+	SenderUid       uuid.UUID
+	Content         string
+	Images          []string
+}
+
+type CreateMessageRow struct {
+	Uid             uuid.UUID
+	CreatedAt       time.Time
+	SenderNickname  string
+	SenderAvatarUrl string
+}
+
+func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (CreateMessageRow, error) {
+	row := q.db.QueryRowContext(ctx, createMessage,
+		arg.ConversationUid,
+		arg.SenderUid,
+		arg.Content,
+		pq.Array(arg.Images),
+	)
+	var i CreateMessageRow
+	err := row.Scan(
+		&i.Uid,
+		&i.CreatedAt,
+		&i.SenderNickname,
+		&i.SenderAvatarUrl,
+	)
+	return i, err
+}
+
+const createSystemMessage = `-- name: CreateSystemMessage :one
+WITH inserted AS (
+  INSERT INTO messages (
+      conversation_uid,
+      sender_uid,
+      type,
+      system_action,
+      target_uid,
+      content
+    )
+  VALUES (
+      $1,
+      $2,
+      'SYSTEM'::message_type,
+      $3::system_message_action,
+      $4::uuid,
+      $5
+    )
+  RETURNING uid,
+    sender_uid,
+    created_at
+)
+SELECT i.uid,
+  i.created_at,
+  u.nickname AS sender_nickname,
+  u.avatar_url AS sender_avatar_url
+FROM inserted i
+  JOIN users u ON u.uid = i.sender_uid
+`
+
+type CreateSystemMessageParams struct {
+	ConversationUid uuid.UUID
+	SenderUid       uuid.UUID
+	SystemAction    SystemMessageAction
+	TargetUid       uuid.NullUUID
+	Content         string
+}
+
+type CreateSystemMessageRow struct {
+	Uid             uuid.UUID
+	CreatedAt       time.Time
+	SenderNickname  string
+	SenderAvatarUrl string
+}
+
+func (q *Queries) CreateSystemMessage(ctx context.Context, arg CreateSystemMessageParams) (CreateSystemMessageRow, error) {
+	row := q.db.QueryRowContext(ctx, createSystemMessage,
+		arg.ConversationUid,
+		arg.SenderUid,
+		arg.SystemAction,
+		arg.TargetUid,
+		arg.Content,
+	)
+	var i CreateSystemMessageRow
+	err := row.Scan(
+		&i.Uid,
+		&i.CreatedAt,
+		&i.SenderNickname,
+		&i.SenderAvatarUrl,
+	)
+	return i, err
+}
+
+const getConversation = `-- name: GetConversation :one
+SELECT c.uid,
+  c.type,
+  c.name,
+  c.avatar_url,
+  (
+    SELECT count(*)
+    FROM conversation_members cm
+    WHERE cm.conversation_uid = c.uid
+  )::integer AS member_count,
+  c.last_message_at,
+  c.created_at,
+  m.role,
+  m.unread_count,
+  m.last_read_message_uid,
+  m.last_read_at,
+  -- the peer is only joined for DIRECT conversations
+  COALESCE(peer.user_uid, '00000000-0000-0000-0000-000000000000'::uuid)::uuid AS peer_uid,
+  COALESCE(peer.nickname, '')::text AS peer_nickname,
+  COALESCE(peer.avatar_url, '')::text AS peer_avatar_url,
+  peer.last_read_message_uid AS peer_last_read_message_uid,
+  peer.last_read_at AS peer_last_read_at,
+  lm.uid AS last_message_uid,
+  lm.sender_uid AS last_message_sender_uid,
+  lm.content AS last_message_content,
+  lm.images AS last_message_images,
+  lm.type AS last_message_type,
+  lm.system_action AS last_message_system_action,
+  lm.target_uid AS last_message_target_uid,
+  lm.created_at AS last_message_created_at
+FROM conversation_members m
+  JOIN conversations c ON c.uid = m.conversation_uid
+  LEFT JOIN LATERAL (
+    SELECT pm.user_uid,
+      pu.nickname,
+      pu.avatar_url,
+      pm.last_read_message_uid,
+      pm.last_read_at
+    FROM conversation_members pm
+      JOIN users pu ON pu.uid = pm.user_uid
+    WHERE pm.conversation_uid = c.uid
+      AND pm.user_uid <> m.user_uid
+    LIMIT 1
+  ) peer ON c.type = 'DIRECT'::conversation_type
+  LEFT JOIN messages lm ON lm.uid = c.last_message_uid
+WHERE m.user_uid = $1
+  AND c.uid = $2
+`
+
+type GetConversationParams struct {
+	UserUid         uuid.UUID
+	ConversationUid uuid.UUID
+}
+
+type GetConversationRow struct {
+	Uid                     uuid.UUID
+	Type                    ConversationType
+	Name                    string
+	AvatarUrl               string
+	MemberCount             int32
+	LastMessageAt           time.Time
+	CreatedAt               time.Time
+	Role                    ConversationRole
+	UnreadCount             int32
+	LastReadMessageUid      uuid.NullUUID
+	LastReadAt              sql.NullTime
+	PeerUid                 uuid.UUID
+	PeerNickname            string
+	PeerAvatarUrl           string
+	PeerLastReadMessageUid  uuid.NullUUID
+	PeerLastReadAt          sql.NullTime
+	LastMessageUid          uuid.NullUUID
+	LastMessageSenderUid    uuid.NullUUID
+	LastMessageContent      sql.NullString
+	LastMessageImages       []string
+	LastMessageType         NullMessageType
+	LastMessageSystemAction NullSystemMessageAction
+	LastMessageTargetUid    uuid.NullUUID
+	LastMessageCreatedAt    sql.NullTime
+}
+
+func (q *Queries) GetConversation(ctx context.Context, arg GetConversationParams) (GetConversationRow, error) {
+	row := q.db.QueryRowContext(ctx, getConversation, arg.UserUid, arg.ConversationUid)
+	var i GetConversationRow
+	err := row.Scan(
+		&i.Uid,
+		&i.Type,
+		&i.Name,
+		&i.AvatarUrl,
+		&i.MemberCount,
+		&i.LastMessageAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.UnreadCount,
+		&i.LastReadMessageUid,
+		&i.LastReadAt,
+		&i.PeerUid,
+		&i.PeerNickname,
+		&i.PeerAvatarUrl,
+		&i.PeerLastReadMessageUid,
+		&i.PeerLastReadAt,
+		&i.LastMessageUid,
+		&i.LastMessageSenderUid,
+		&i.LastMessageContent,
+		pq.Array(&i.LastMessageImages),
+		&i.LastMessageType,
+		&i.LastMessageSystemAction,
+		&i.LastMessageTargetUid,
+		&i.LastMessageCreatedAt,
+	)
+	return i, err
+}
+
+const getConversationMemberRole = `-- name: GetConversationMemberRole :one
+SELECT role
+FROM conversation_members
+WHERE conversation_uid = $1
+  AND user_uid = $2
+`
+
+type GetConversationMemberRoleParams struct {
+	ConversationUid uuid.UUID
+	UserUid         uuid.UUID
+}
+
+func (q *Queries) GetConversationMemberRole(ctx context.Context, arg GetConversationMemberRoleParams) (ConversationRole, error) {
+	row := q.db.QueryRowContext(ctx, getConversationMemberRole, arg.ConversationUid, arg.UserUid)
+	var role ConversationRole
+	err := row.Scan(&role)
+	return role, err
+}
+
+const getConversationMembership = `-- name: GetConversationMembership :one
+SELECT c.type,
+  m.role
+FROM conversations c
+  JOIN conversation_members m ON m.conversation_uid = c.uid
+WHERE c.uid = $1
+  AND m.user_uid = $2
+`
+
+type GetConversationMembershipParams struct {
+	ConversationUid uuid.UUID
+	UserUid         uuid.UUID
+}
+
+type GetConversationMembershipRow struct {
+	Type ConversationType
+	Role ConversationRole
+}
+
+func (q *Queries) GetConversationMembership(ctx context.Context, arg GetConversationMembershipParams) (GetConversationMembershipRow, error) {
+	row := q.db.QueryRowContext(ctx, getConversationMembership, arg.ConversationUid, arg.UserUid)
+	var i GetConversationMembershipRow
+	err := row.Scan(&i.Type, &i.Role)
+	return i, err
+}
+
+const getDirectPeer = `-- name: GetDirectPeer :one
+SELECT user_uid
+FROM conversation_members
+WHERE conversation_uid = $1
+  AND user_uid <> $2
+LIMIT 1
+`
+
+type GetDirectPeerParams struct {
+	ConversationUid uuid.UUID
+	UserUid         uuid.UUID
+}
+
+func (q *Queries) GetDirectPeer(ctx context.Context, arg GetDirectPeerParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, getDirectPeer, arg.ConversationUid, arg.UserUid)
+	var user_uid uuid.UUID
+	err := row.Scan(&user_uid)
+	return user_uid, err
+}
+
+const getMessagePermission = `-- name: GetMessagePermission :one
+SELECT message_permission
+FROM users
+WHERE uid = $1
+`
+
+func (q *Queries) GetMessagePermission(ctx context.Context, uid uuid.UUID) (MessagePermission, error) {
+	row := q.db.QueryRowContext(ctx, getMessagePermission, uid)
+	var message_permission MessagePermission
+	err := row.Scan(&message_permission)
+	return message_permission, err
+}
+
+const getMessagingTarget = `-- name: GetMessagingTarget :one
+SELECT u.status,
+  u.message_permission,
+  EXISTS (
+    SELECT 1
+    FROM user_follows uf
+    WHERE uf.follower_uid = u.uid
+      AND uf.followee_uid = $1
+  )::boolean AS follows_sender,
+  EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = u.uid
+      AND ub.blocked_uid = $1
+  )::boolean AS blocked_sender
+FROM users u
+WHERE u.uid = $2
+`
+
+type GetMessagingTargetParams struct {
+	SenderUid uuid.UUID
+	Uid       uuid.UUID
+}
+
+type GetMessagingTargetRow struct {
+	Status            UserStatus
+	MessagePermission MessagePermission
+	FollowsSender     bool
+	BlockedSender     bool
+}
+
+func (q *Queries) GetMessagingTarget(ctx context.Context, arg GetMessagingTargetParams) (GetMessagingTargetRow, error) {
+	row := q.db.QueryRowContext(ctx, getMessagingTarget, arg.SenderUid, arg.Uid)
+	var i GetMessagingTargetRow
+	err := row.Scan(
+		&i.Status,
+		&i.MessagePermission,
+		&i.FollowsSender,
+		&i.BlockedSender,
+	)
+	return i, err
+}
+
+const getReadTarget = `-- name: GetReadTarget :one
+SELECT uid,
+  created_at
+FROM messages
+WHERE conversation_uid = $1
+  AND (
+    $2::uuid IS NULL
+    OR uid = $2::uuid
+  )
+ORDER BY created_at DESC,
+  uid DESC
+LIMIT 1
+`
+
+type GetReadTargetParams struct {
+	ConversationUid uuid.UUID
+	MessageUid      uuid.NullUUID
+}
+
+type GetReadTargetRow struct {
+	Uid       uuid.UUID
+	CreatedAt time.Time
+}
+
+func (q *Queries) GetReadTarget(ctx context.Context, arg GetReadTargetParams) (GetReadTargetRow, error) {
+	row := q.db.QueryRowContext(ctx, getReadTarget, arg.ConversationUid, arg.MessageUid)
+	var i GetReadTargetRow
+	err := row.Scan(&i.Uid, &i.CreatedAt)
+	return i, err
+}
+
+const incrementUnreadMessages = `-- name: IncrementUnreadMessages :many
+UPDATE conversation_members
+SET unread_count = unread_count + 1
+WHERE conversation_uid = $1
+  AND user_uid <> $2
+RETURNING user_uid
+`
+
+type IncrementUnreadMessagesParams struct {
+	ConversationUid uuid.UUID
+	SenderUid       uuid.UUID
+}
+
+func (q *Queries) IncrementUnreadMessages(ctx context.Context, arg IncrementUnreadMessagesParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, incrementUnreadMessages, arg.ConversationUid, arg.SenderUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var user_uid uuid.UUID
+		if err := rows.Scan(&user_uid); err != nil {
+			return nil, err
+		}
+		items = append(items, user_uid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConversationMemberUids = `-- name: ListConversationMemberUids :many
+SELECT user_uid
+FROM conversation_members
+WHERE conversation_uid = $1
+`
+
+func (q *Queries) ListConversationMemberUids(ctx context.Context, conversationUid uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listConversationMemberUids, conversationUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var user_uid uuid.UUID
+		if err := rows.Scan(&user_uid); err != nil {
+			return nil, err
+		}
+		items = append(items, user_uid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConversationMembers = `-- name: ListConversationMembers :many
+SELECT m.user_uid,
+  u.nickname,
+  u.avatar_url,
+  m.role,
+  m.joined_at
+FROM conversation_members m
+  JOIN users u ON u.uid = m.user_uid
+WHERE m.conversation_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (m.joined_at, m.user_uid) > (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY m.joined_at,
+  m.user_uid
+LIMIT 20
+`
+
+type ListConversationMembersParams struct {
+	ConversationUid uuid.UUID
+	CursorJoinedAt  sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListConversationMembersRow struct {
+	UserUid   uuid.UUID
+	Nickname  string
+	AvatarUrl string
+	Role      ConversationRole
+	JoinedAt  time.Time
+}
+
+func (q *Queries) ListConversationMembers(ctx context.Context, arg ListConversationMembersParams) ([]ListConversationMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listConversationMembers, arg.ConversationUid, arg.CursorJoinedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListConversationMembersRow
+	for rows.Next() {
+		var i ListConversationMembersRow
+		if err := rows.Scan(
+			&i.UserUid,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.Role,
+			&i.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConversations = `-- name: ListConversations :many
+SELECT c.uid,
+  c.type,
+  c.name,
+  c.avatar_url,
+  (
+    SELECT count(*)
+    FROM conversation_members cm
+    WHERE cm.conversation_uid = c.uid
+  )::integer AS member_count,
+  c.last_message_at,
+  c.created_at,
+  m.role,
+  m.unread_count,
+  m.last_read_message_uid,
+  m.last_read_at,
+  -- the peer is only joined for DIRECT conversations
+  COALESCE(peer.user_uid, '00000000-0000-0000-0000-000000000000'::uuid)::uuid AS peer_uid,
+  COALESCE(peer.nickname, '')::text AS peer_nickname,
+  COALESCE(peer.avatar_url, '')::text AS peer_avatar_url,
+  peer.last_read_message_uid AS peer_last_read_message_uid,
+  peer.last_read_at AS peer_last_read_at,
+  lm.uid AS last_message_uid,
+  lm.sender_uid AS last_message_sender_uid,
+  lm.content AS last_message_content,
+  lm.images AS last_message_images,
+  lm.type AS last_message_type,
+  lm.system_action AS last_message_system_action,
+  lm.target_uid AS last_message_target_uid,
+  lm.created_at AS last_message_created_at
+FROM conversation_members m
+  JOIN conversations c ON c.uid = m.conversation_uid
+  LEFT JOIN LATERAL (
+    SELECT pm.user_uid,
+      pu.nickname,
+      pu.avatar_url,
+      pm.last_read_message_uid,
+      pm.last_read_at
+    FROM conversation_members pm
+      JOIN users pu ON pu.uid = pm.user_uid
+    WHERE pm.conversation_uid = c.uid
+      AND pm.user_uid <> m.user_uid
+    LIMIT 1
+  ) peer ON c.type = 'DIRECT'::conversation_type
+  LEFT JOIN messages lm ON lm.uid = c.last_message_uid
+WHERE m.user_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (c.last_message_at, c.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY c.last_message_at DESC,
+  c.uid DESC
+LIMIT 20
+`
+
+type ListConversationsParams struct {
+	UserUid         uuid.UUID
+	CursorUpdatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListConversationsRow struct {
+	Uid                     uuid.UUID
+	Type                    ConversationType
+	Name                    string
+	AvatarUrl               string
+	MemberCount             int32
+	LastMessageAt           time.Time
+	CreatedAt               time.Time
+	Role                    ConversationRole
+	UnreadCount             int32
+	LastReadMessageUid      uuid.NullUUID
+	LastReadAt              sql.NullTime
+	PeerUid                 uuid.UUID
+	PeerNickname            string
+	PeerAvatarUrl           string
+	PeerLastReadMessageUid  uuid.NullUUID
+	PeerLastReadAt          sql.NullTime
+	LastMessageUid          uuid.NullUUID
+	LastMessageSenderUid    uuid.NullUUID
+	LastMessageContent      sql.NullString
+	LastMessageImages       []string
+	LastMessageType         NullMessageType
+	LastMessageSystemAction NullSystemMessageAction
+	LastMessageTargetUid    uuid.NullUUID
+	LastMessageCreatedAt    sql.NullTime
+}
+
+func (q *Queries) ListConversations(ctx context.Context, arg ListConversationsParams) ([]ListConversationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listConversations, arg.UserUid, arg.CursorUpdatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListConversationsRow
+	for rows.Next() {
+		var i ListConversationsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Type,
+			&i.Name,
+			&i.AvatarUrl,
+			&i.MemberCount,
+			&i.LastMessageAt,
+			&i.CreatedAt,
+			&i.Role,
+			&i.UnreadCount,
+			&i.LastReadMessageUid,
+			&i.LastReadAt,
+			&i.PeerUid,
+			&i.PeerNickname,
+			&i.PeerAvatarUrl,
+			&i.PeerLastReadMessageUid,
+			&i.PeerLastReadAt,
+			&i.LastMessageUid,
+			&i.LastMessageSenderUid,
+			&i.LastMessageContent,
+			pq.Array(&i.LastMessageImages),
+			&i.LastMessageType,
+			&i.LastMessageSystemAction,
+			&i.LastMessageTargetUid,
+			&i.LastMessageCreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMessages = `-- name: ListMessages :many
+SELECT msg.uid,
+  msg.conversation_uid,
+  msg.sender_uid,
+  u.nickname AS sender_nickname,
+  u.avatar_url AS sender_avatar_url,
+  msg.content,
+  msg.images,
+  msg.type,
+  msg.system_action,
+  msg.target_uid,
+  msg.created_at
+FROM messages msg
+  JOIN users u ON u.uid = msg.sender_uid
+WHERE msg.conversation_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (msg.created_at, msg.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY msg.created_at DESC,
+  msg.uid DESC
+LIMIT 20
+`
+
+type ListMessagesParams struct {
+	ConversationUid uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListMessagesRow struct {
+	Uid             uuid.UUID
+	ConversationUid uuid.UUID
+	SenderUid       uuid.UUID
+	SenderNickname  string
+	SenderAvatarUrl string
+	Content         string
+	Images          []string
+	Type            MessageType
+	SystemAction    NullSystemMessageAction
+	TargetUid       uuid.NullUUID
+	CreatedAt       time.Time
+}
+
+func (q *Queries) ListMessages(ctx context.Context, arg ListMessagesParams) ([]ListMessagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMessages, arg.ConversationUid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMessagesRow
+	for rows.Next() {
+		var i ListMessagesRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.ConversationUid,
+			&i.SenderUid,
+			&i.SenderNickname,
+			&i.SenderAvatarUrl,
+			&i.Content,
+			pq.Array(&i.Images),
+			&i.Type,
+			&i.SystemAction,
+			&i.TargetUid,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockConversation = `-- name: LockConversation :one
+SELECT type,
+  name,
+  avatar_url
+FROM conversations
+WHERE uid = $1 FOR
+UPDATE
+`
+
+type LockConversationRow struct {
+	Type      ConversationType
+	Name      string
+	AvatarUrl string
+}
+
+func (q *Queries) LockConversation(ctx context.Context, uid uuid.UUID) (LockConversationRow, error) {
+	row := q.db.QueryRowContext(ctx, lockConversation, uid)
+	var i LockConversationRow
+	err := row.Scan(&i.Type, &i.Name, &i.AvatarUrl)
+	return i, err
+}
+
+const markConversationRead = `-- name: MarkConversationRead :execrows
+UPDATE conversation_members m
+SET last_read_message_uid = $1::uuid,
+  last_read_at = $2::timestamptz,
+  unread_count = (
+    SELECT count(*)
+    FROM messages msg
+    WHERE msg.conversation_uid = m.conversation_uid
+      AND msg.sender_uid <> m.user_uid
+      AND msg.type = 'TEXT'::message_type
+      AND (msg.created_at, msg.uid) > (
+        $2::timestamptz,
+        $1::uuid
+      )
+  )
+WHERE m.conversation_uid = $3
+  AND m.user_uid = $4
+  AND (
+    m.last_read_at IS NULL
+    OR (m.last_read_at, m.last_read_message_uid) < (
+      $2::timestamptz,
+      $1::uuid
+    )
+  )
+`
+
+type MarkConversationReadParams struct {
+	MessageUid       uuid.UUID
+	MessageCreatedAt time.Time
+	ConversationUid  uuid.UUID
+	UserUid          uuid.UUID
+}
+
+func (q *Queries) MarkConversationRead(ctx context.Context, arg MarkConversationReadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markConversationRead,
+		arg.MessageUid,
+		arg.MessageCreatedAt,
+		arg.ConversationUid,
+		arg.UserUid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const removeConversationMember = `-- name: RemoveConversationMember :execrows
+DELETE FROM conversation_members
+WHERE conversation_uid = $1
+  AND user_uid = $2
+`
+
+type RemoveConversationMemberParams struct {
+	ConversationUid uuid.UUID
+	UserUid         uuid.UUID
+}
+
+func (q *Queries) RemoveConversationMember(ctx context.Context, arg RemoveConversationMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeConversationMember, arg.ConversationUid, arg.UserUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setConversationMemberRole = `-- name: SetConversationMemberRole :execrows
+UPDATE conversation_members
+SET role = $1
+WHERE conversation_uid = $2
+  AND user_uid = $3
+  AND role <> $1
+`
+
+type SetConversationMemberRoleParams struct {
+	Role            ConversationRole
+	ConversationUid uuid.UUID
+	UserUid         uuid.UUID
+}
+
+func (q *Queries) SetConversationMemberRole(ctx context.Context, arg SetConversationMemberRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setConversationMemberRole, arg.Role, arg.ConversationUid, arg.UserUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setMessagePermission = `-- name: SetMessagePermission :exec
+UPDATE users
+SET message_permission = $1,
+  updated_at = now()
+WHERE uid = $2
+`
+
+type SetMessagePermissionParams struct {
+	MessagePermission MessagePermission
+	Uid               uuid.UUID
+}
+
+func (q *Queries) SetMessagePermission(ctx context.Context, arg SetMessagePermissionParams) error {
+	_, err := q.db.ExecContext(ctx, setMessagePermission, arg.MessagePermission, arg.Uid)
+	return err
+}
+
+const touchConversation = `-- name: TouchConversation :exec
+UPDATE conversations
+SET last_message_uid = $1,
+  last_message_at = $2,
+  updated_at = now()
+WHERE uid = $3
+`
+
+type TouchConversationParams struct {
+	LastMessageUid uuid.NullUUID
+	LastMessageAt  time.Time
+	Uid            uuid.UUID
+}
+
+func (q *Queries) TouchConversation(ctx context.Context, arg TouchConversationParams) error {
+	_, err := q.db.ExecContext(ctx, touchConversation, arg.LastMessageUid, arg.LastMessageAt, arg.Uid)
+	return err
+}
+
+const updateGroupConversation = `-- name: UpdateGroupConversation :exec
+UPDATE conversations
+SET name = $1,
+  avatar_url = $2,
+  updated_at = now()
+WHERE uid = $3
+`
+
+type UpdateGroupConversationParams struct {
+	Name      string
+	AvatarUrl string
+	Uid       uuid.UUID
+}
+
+func (q *Queries) UpdateGroupConversation(ctx context.Context, arg UpdateGroupConversationParams) error {
+	_, err := q.db.ExecContext(ctx, updateGroupConversation, arg.Name, arg.AvatarUrl, arg.Uid)
+	return err
+}
+
+const upsertDirectConversation = `-- name: UpsertDirectConversation :one
+INSERT INTO conversations (type, direct_key)
+VALUES ('DIRECT'::conversation_type, $1::text) ON CONFLICT (direct_key) DO
+UPDATE
+SET direct_key = EXCLUDED.direct_key
+RETURNING uid,
+  type
+`
+
+type UpsertDirectConversationRow struct {
+	Uid  uuid.UUID
+	Type ConversationType
+}
+
+func (q *Queries) UpsertDirectConversation(ctx context.Context, directKey string) (UpsertDirectConversationRow, error) {
+	row := q.db.QueryRowContext(ctx, upsertDirectConversation, directKey)
+	var i UpsertDirectConversationRow
+	err := row.Scan(&i.Uid, &i.Type)
+	return i, err
+}
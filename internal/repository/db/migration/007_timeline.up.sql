@@ -0,0 +1,12 @@
+-- timeline entries (fan-out-on-write home timeline)
+CREATE TABLE timeline_entries (
+    owner_uid uuid NOT NULL,
+    post_uid uuid NOT NULL REFERENCES posts(uid) ON DELETE CASCADE,
+    author_uid uuid NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (owner_uid, post_uid)
+);
+CREATE INDEX idx_timeline_entries_owner_keyset ON timeline_entries (owner_uid, created_at DESC, post_uid DESC);
+CREATE INDEX idx_timeline_entries_owner_author ON timeline_entries (owner_uid, author_uid);
+CREATE INDEX idx_posts_author_keyset_normal ON posts (author, created_at DESC, uid DESC)
+WHERE status = 'NORMAL'::post_status;
@@ -0,0 +1,35 @@
+-- casbin rules table
+CREATE TABLE casbin_rules (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    ptype text NOT NULL,
+    v0 text NOT NULL DEFAULT '',
+    v1 text NOT NULL DEFAULT '',
+    v2 text NOT NULL DEFAULT '',
+    v3 text NOT NULL DEFAULT '',
+    v4 text NOT NULL DEFAULT '',
+    v5 text NOT NULL DEFAULT '',
+    UNIQUE (ptype, v0, v1, v2, v3, v4, v5)
+);
+-- role inheritance: HOST > ADMIN > USER > ANONYMOUS
+INSERT INTO casbin_rules (ptype, v0, v1)
+VALUES ('g', 'USER', 'ANONYMOUS'),
+  ('g', 'ADMIN', 'USER'),
+  ('g', 'HOST', 'ADMIN');
+-- default policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'ANONYMOUS', '/user.UserService/CreateUser', 'CALL'),
+  ('p', 'ANONYMOUS', '/user.UserService/GetUser', 'CALL'),
+  ('p', 'ANONYMOUS', '/user.UserService/Login', 'CALL'),
+  ('p', 'ANONYMOUS', '/user.UserService/RefreshToken', 'CALL'),
+  ('p', 'ANONYMOUS', '/post.PostService/GetPost', 'CALL'),
+  ('p', 'ANONYMOUS', '/post.PostService/ListPosts', 'CALL'),
+  ('p', 'ANONYMOUS', '/post.PostService/ListPostsByAuthor', 'CALL'),
+  ('p', 'ANONYMOUS', '/comment.CommentService/ListTopComments', 'CALL'),
+  ('p', 'ANONYMOUS', '/comment.CommentService/ListReplies', 'CALL'),
+  ('p', 'ANONYMOUS', '/file.FileService/GetFileMeta', 'CALL'),
+  ('p', 'ANONYMOUS', '/file.FileService/GetFile', 'CALL'),
+  ('p', 'USER', '/user.UserService/*', 'CALL'),
+  ('p', 'USER', '/post.PostService/*', 'CALL'),
+  ('p', 'USER', '/comment.CommentService/*', 'CALL'),
+  ('p', 'USER', '/follow.FollowService/*', 'CALL'),
+  ('p', 'USER', '/file.FileService/*', 'CALL');
@@ -0,0 +1,34 @@
+-- admin audit logs table
+CREATE TYPE admin_action AS ENUM (
+    'SUSPEND_USER',
+    'RESTORE_USER',
+    'SET_USER_ROLE',
+    'ARCHIVE_POST',
+    'RESTORE_POST',
+    'ARCHIVE_COMMENT',
+    'RESTORE_COMMENT'
+);
+CREATE TYPE admin_target_type AS ENUM ('USER', 'POST', 'COMMENT');
+CREATE TABLE admin_audit_logs (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    actor_uid uuid NOT NULL,
+    action admin_action NOT NULL,
+    target_type admin_target_type NOT NULL,
+    target_uid uuid NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    detail text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_admin_audit_logs_keyset ON admin_audit_logs (created_at DESC, uid DESC);
+CREATE INDEX idx_admin_audit_logs_target ON admin_audit_logs (target_type, target_uid);
+-- archived content listings
+CREATE INDEX idx_users_keyset_archived ON users (updated_at DESC, uid DESC)
+WHERE status = 'ARCHIVED'::user_status;
+CREATE INDEX idx_posts_keyset_archived ON posts (updated_at DESC, uid DESC)
+WHERE status = 'ARCHIVED'::post_status;
+CREATE INDEX idx_post_comments_keyset_archived ON post_comments (updated_at DESC, uid DESC)
+WHERE status = 'ARCHIVED'::comment_status;
+-- admin policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'ADMIN', '/admin.AdminService/*', 'CALL');
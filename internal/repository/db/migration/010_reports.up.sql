@@ -0,0 +1,35 @@
+-- reports table
+CREATE TYPE report_target_type AS ENUM ('POST', 'COMMENT', 'USER');
+CREATE TYPE report_reason AS ENUM (
+    'SPAM',
+    'HARASSMENT',
+    'HATE',
+    'VIOLENCE',
+    'SEXUAL',
+    'MISINFORMATION',
+    'OTHER'
+);
+CREATE TYPE report_status AS ENUM ('OPEN', 'CLAIMED', 'RESOLVED', 'DISMISSED');
+CREATE TABLE reports (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    reporter_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    target_type report_target_type NOT NULL,
+    target_uid uuid NOT NULL,
+    reason report_reason NOT NULL,
+    detail text NOT NULL DEFAULT '',
+    status report_status NOT NULL DEFAULT 'OPEN',
+    handler_uid uuid REFERENCES users(uid) ON DELETE SET NULL,
+    note text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+-- one pending report per reporter and target
+CREATE UNIQUE INDEX uniq_reports_pending_reporter_target ON reports (reporter_uid, target_type, target_uid)
+WHERE status IN ('OPEN'::report_status, 'CLAIMED'::report_status);
+CREATE INDEX idx_reports_status_keyset ON reports (status, created_at DESC, uid DESC);
+CREATE INDEX idx_reports_target ON reports (target_type, target_uid);
+-- report policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'USER', '/report.ReportService/CreateReport', 'CALL'),
+    ('p', 'ADMIN', '/report.ReportService/*', 'CALL');
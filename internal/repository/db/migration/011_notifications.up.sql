@@ -0,0 +1,42 @@
+-- notifications table
+CREATE TYPE notification_type AS ENUM (
+    'POST_LIKE',
+    'COMMENT_LIKE',
+    'COMMENT',
+    'REPLY',
+    'FOLLOW'
+);
+CREATE TABLE notifications (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    recipient_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    type notification_type NOT NULL,
+    target_uid uuid NOT NULL,
+    post_uid uuid REFERENCES posts(uid) ON DELETE CASCADE,
+    actor_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    actor_count integer NOT NULL DEFAULT 1,
+    read_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+-- likes and follows on the same target collapse into one unread notification
+CREATE UNIQUE INDEX uniq_notifications_unread_group ON notifications (recipient_uid, type, target_uid)
+WHERE read_at IS NULL
+    AND type IN (
+        'POST_LIKE'::notification_type,
+        'COMMENT_LIKE'::notification_type,
+        'FOLLOW'::notification_type
+    );
+CREATE INDEX idx_notifications_recipient_keyset ON notifications (recipient_uid, updated_at DESC, uid DESC);
+CREATE INDEX idx_notifications_recipient_unread ON notifications (recipient_uid)
+WHERE read_at IS NULL;
+-- distinct actors of a grouped notification
+CREATE TABLE notification_actors (
+    notification_uid uuid NOT NULL REFERENCES notifications(uid) ON DELETE CASCADE,
+    actor_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (notification_uid, actor_uid)
+);
+-- notification policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'USER', '/notification.NotificationService/*', 'CALL');
@@ -0,0 +1,3 @@
+-- event stream policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'ANONYMOUS', '/event.EventService/StreamEvents', 'CALL');
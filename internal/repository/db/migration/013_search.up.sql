@@ -0,0 +1,22 @@
+-- full-text search
+-- The 'simple' configuration lowercases tokens without stemming. Chinese text has no
+-- word boundaries, so CJK queries fall back to trigram substring matching.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+ALTER TABLE posts
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (to_tsvector('simple', text)) STORED;
+CREATE INDEX idx_posts_search_vector ON posts USING GIN (search_vector);
+CREATE INDEX idx_posts_text_trgm ON posts USING GIN (text gin_trgm_ops);
+ALTER TABLE post_comments
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED;
+CREATE INDEX idx_post_comments_search_vector ON post_comments USING GIN (search_vector);
+CREATE INDEX idx_post_comments_content_trgm ON post_comments USING GIN (content gin_trgm_ops);
+ALTER TABLE users
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('simple', username || ' ' || nickname)
+    ) STORED;
+CREATE INDEX idx_users_search_vector ON users USING GIN (search_vector);
+CREATE INDEX idx_users_nickname_trgm ON users USING GIN (nickname gin_trgm_ops);
+CREATE INDEX idx_users_username_trgm ON users USING GIN (username gin_trgm_ops);
+-- search policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'ANONYMOUS', '/search.SearchService/*', 'CALL');
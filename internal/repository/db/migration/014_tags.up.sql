@@ -0,0 +1,24 @@
+-- tag browsing
+ALTER TABLE tags
+ADD COLUMN uid uuid NOT NULL UNIQUE DEFAULT gen_random_uuid();
+CREATE INDEX idx_tags_name_prefix ON tags (lower(name) text_pattern_ops);
+CREATE INDEX idx_posts_created_at_public ON posts (created_at DESC)
+WHERE status = 'NORMAL'::post_status
+    AND visibility = 'PUBLIC'::post_visibility;
+-- names of merged or renamed tags, resolved to the surviving tag by UpsertPostTags
+CREATE TABLE tag_aliases (
+    name text PRIMARY KEY,
+    tag_id integer NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_tag_aliases_tag_id ON tag_aliases (tag_id);
+ALTER TYPE admin_action ADD VALUE 'MERGE_TAG';
+ALTER TYPE admin_action ADD VALUE 'RENAME_TAG';
+ALTER TYPE admin_target_type ADD VALUE 'TAG';
+-- tag policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'ANONYMOUS', '/tag.TagService/GetTag', 'CALL'),
+    ('p', 'ANONYMOUS', '/tag.TagService/ListPostsByTag', 'CALL'),
+    ('p', 'ANONYMOUS', '/tag.TagService/AutocompleteTags', 'CALL'),
+    ('p', 'ANONYMOUS', '/tag.TagService/ListTrendingTags', 'CALL'),
+    ('p', 'ADMIN', '/tag.TagService/*', 'CALL');
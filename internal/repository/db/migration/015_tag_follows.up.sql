@@ -0,0 +1,13 @@
+-- tag follows
+CREATE TABLE tag_follows (
+    user_uid uuid NOT NULL,
+    tag_id integer NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_uid, tag_id)
+);
+CREATE INDEX idx_tag_follows_user_created_at ON tag_follows (user_uid, created_at DESC);
+CREATE INDEX idx_tag_follows_tag_id ON tag_follows (tag_id);
+-- tag follow policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'USER', '/tag.TagService/FollowTag', 'CALL'),
+    ('p', 'USER', '/tag.TagService/ListMyFollowedTags', 'CALL');
@@ -0,0 +1,40 @@
+-- direct messages
+CREATE TYPE conversation_type AS ENUM ('DIRECT');
+CREATE TYPE message_permission AS ENUM ('EVERYONE', 'FOLLOWING', 'NOBODY');
+ALTER TABLE users
+ADD COLUMN message_permission message_permission NOT NULL DEFAULT 'EVERYONE';
+CREATE TABLE conversations (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    type conversation_type NOT NULL,
+    -- "<lower uid>:<higher uid>" for DIRECT conversations, so each pair has exactly one
+    direct_key text UNIQUE,
+    last_message_uid uuid,
+    last_message_at timestamptz NOT NULL DEFAULT now(),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE TABLE conversation_members (
+    conversation_uid uuid NOT NULL REFERENCES conversations(uid) ON DELETE CASCADE,
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    -- read receipt: the newest message this member has read
+    last_read_message_uid uuid,
+    last_read_at timestamptz,
+    unread_count integer NOT NULL DEFAULT 0,
+    joined_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (conversation_uid, user_uid)
+);
+CREATE INDEX idx_conversation_members_user_uid ON conversation_members (user_uid);
+CREATE TABLE messages (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    conversation_uid uuid NOT NULL REFERENCES conversations(uid) ON DELETE CASCADE,
+    sender_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    content text NOT NULL DEFAULT '',
+    images text [] NOT NULL DEFAULT ARRAY []::text [],
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_messages_conversation_keyset ON messages (conversation_uid, created_at DESC, uid DESC);
+-- message policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'USER', '/chat.MessageService/*', 'CALL');
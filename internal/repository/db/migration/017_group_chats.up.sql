@@ -0,0 +1,23 @@
+-- group chats
+ALTER TYPE conversation_type ADD VALUE 'GROUP';
+CREATE TYPE conversation_role AS ENUM ('OWNER', 'ADMIN', 'MEMBER');
+CREATE TYPE message_type AS ENUM ('TEXT', 'SYSTEM');
+CREATE TYPE system_message_action AS ENUM (
+    'GROUP_CREATED',
+    'GROUP_UPDATED',
+    'MEMBER_ADDED',
+    'MEMBER_REMOVED',
+    'MEMBER_LEFT',
+    'ROLE_CHANGED'
+);
+ALTER TABLE conversations
+ADD COLUMN name text NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url text NOT NULL DEFAULT '';
+ALTER TABLE conversation_members
+ADD COLUMN role conversation_role NOT NULL DEFAULT 'MEMBER';
+CREATE INDEX idx_conversation_members_joined ON conversation_members (conversation_uid, joined_at, user_uid);
+-- system messages record membership changes; sender_uid is the acting user
+ALTER TABLE messages
+ADD COLUMN type message_type NOT NULL DEFAULT 'TEXT',
+    ADD COLUMN system_action system_message_action,
+    ADD COLUMN target_uid uuid;
@@ -0,0 +1,22 @@
+-- user blocks
+CREATE TABLE user_blocks (
+    blocker_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    blocked_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (blocker_uid, blocked_uid),
+    CHECK (blocker_uid <> blocked_uid)
+);
+CREATE INDEX idx_user_blocks_blocker_created_at ON user_blocks (blocker_uid, created_at DESC, blocked_uid DESC);
+CREATE INDEX idx_user_blocks_blocked_uid ON user_blocks (blocked_uid);
+-- user mutes
+CREATE TABLE user_mutes (
+    muter_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    muted_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (muter_uid, muted_uid),
+    CHECK (muter_uid <> muted_uid)
+);
+CREATE INDEX idx_user_mutes_muter_created_at ON user_mutes (muter_uid, created_at DESC, muted_uid DESC);
+-- relation policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'USER', '/relation.RelationService/*', 'CALL');
@@ -0,0 +1,3 @@
+-- followers-only and unlisted posts
+ALTER TYPE post_visibility ADD VALUE 'FOLLOWERS';
+ALTER TYPE post_visibility ADD VALUE 'UNLISTED';
@@ -0,0 +1,13 @@
+-- private accounts and follow requests
+ALTER TYPE notification_type ADD VALUE 'FOLLOW_REQUEST';
+ALTER TABLE users
+ADD COLUMN is_private boolean NOT NULL DEFAULT false;
+CREATE TABLE follow_requests (
+    requester_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    target_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (requester_uid, target_uid),
+    CHECK (requester_uid <> target_uid)
+);
+CREATE INDEX idx_follow_requests_target_created_at ON follow_requests (target_uid, created_at DESC, requester_uid DESC);
+CREATE INDEX idx_follow_requests_requester_created_at ON follow_requests (requester_uid, created_at DESC, target_uid DESC);
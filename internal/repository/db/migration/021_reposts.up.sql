@@ -0,0 +1,11 @@
+-- reposts and quote posts
+ALTER TABLE posts
+ADD COLUMN repost_count integer NOT NULL DEFAULT 0,
+    -- a repost is a post of its own with empty text that points at the original
+ADD COLUMN repost_of_uid uuid REFERENCES posts(uid) ON DELETE CASCADE,
+ADD COLUMN quoted_post_uid uuid REFERENCES posts(uid) ON DELETE SET NULL;
+CREATE UNIQUE INDEX uq_posts_author_repost_of_normal ON posts (author, repost_of_uid)
+WHERE repost_of_uid IS NOT NULL
+    AND status = 'NORMAL'::post_status;
+CREATE INDEX idx_posts_quoted_post_uid ON posts (quoted_post_uid)
+WHERE quoted_post_uid IS NOT NULL;
@@ -0,0 +1,20 @@
+-- @mentions
+ALTER TYPE notification_type ADD VALUE 'MENTION';
+CREATE TYPE mention_source AS ENUM ('POST', 'COMMENT');
+-- JSON-encoded util.Entity spans for rendering links in the text
+ALTER TABLE posts
+ADD COLUMN entities text NOT NULL DEFAULT '[]';
+ALTER TABLE post_comments
+ADD COLUMN entities text NOT NULL DEFAULT '[]';
+CREATE TABLE mentions (
+    source_type mention_source NOT NULL,
+    source_uid uuid NOT NULL,
+    mentioned_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    author_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    post_uid uuid NOT NULL REFERENCES posts(uid) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (source_type, source_uid, mentioned_uid)
+);
+CREATE INDEX idx_mentions_mentioned_created_at ON mentions (mentioned_uid, created_at DESC);
+CREATE INDEX idx_user_username_prefix ON users (lower(username) text_pattern_ops);
+CREATE INDEX idx_user_nickname_prefix ON users (lower(nickname) text_pattern_ops);
@@ -0,0 +1,24 @@
+-- post edit history: every edit keeps the version it replaced
+ALTER TABLE posts
+ADD COLUMN edit_count integer NOT NULL DEFAULT 0;
+CREATE TABLE post_revisions (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    post_uid uuid NOT NULL REFERENCES posts(uid) ON DELETE CASCADE,
+    -- 0 is the original version, n the version left by the n-th edit
+    revision integer NOT NULL,
+    text text NOT NULL,
+    entities text NOT NULL DEFAULT '[]',
+    images text [] NOT NULL DEFAULT '{}',
+    attachments text [] NOT NULL DEFAULT '{}',
+    tags text [] NOT NULL DEFAULT '{}',
+    visibility post_visibility NOT NULL,
+    -- when this version went live; created_at is when an edit replaced it
+    published_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (post_uid, revision)
+);
+CREATE INDEX idx_post_revisions_post_keyset ON post_revisions (post_uid, created_at DESC, uid DESC);
+-- revision policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'ANONYMOUS', '/post.PostService/ListPostRevisions', 'CALL');
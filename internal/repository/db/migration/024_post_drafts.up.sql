@@ -0,0 +1,7 @@
+-- drafts and scheduled posts; neither is visible until published as NORMAL
+ALTER TYPE post_status ADD VALUE 'DRAFT';
+ALTER TYPE post_status ADD VALUE 'SCHEDULED';
+ALTER TABLE posts
+ADD COLUMN publish_at timestamptz;
+CREATE INDEX idx_posts_publish_at ON posts (publish_at)
+WHERE publish_at IS NOT NULL;
@@ -0,0 +1,28 @@
+-- polls attached to posts
+CREATE TABLE polls (
+    id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    uid uuid NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    post_uid uuid NOT NULL UNIQUE REFERENCES posts(uid) ON DELETE CASCADE,
+    multiple boolean NOT NULL DEFAULT false,
+    expires_at timestamptz NOT NULL,
+    voter_count integer NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE TABLE poll_options (
+    poll_uid uuid NOT NULL REFERENCES polls(uid) ON DELETE CASCADE,
+    position integer NOT NULL CHECK (
+        position >= 0
+        AND position < 10
+    ),
+    text text NOT NULL,
+    vote_count integer NOT NULL DEFAULT 0,
+    PRIMARY KEY (poll_uid, position)
+);
+-- one ballot per user; a multiple-choice ballot lists several positions
+CREATE TABLE poll_votes (
+    poll_uid uuid NOT NULL REFERENCES polls(uid) ON DELETE CASCADE,
+    user_uid uuid NOT NULL REFERENCES users(uid) ON DELETE CASCADE,
+    positions integer [] NOT NULL CHECK (cardinality(positions) > 0),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (poll_uid, user_uid)
+);
@@ -0,0 +1,15 @@
+-- refresh tokens become per-device sessions
+ALTER TABLE refresh_tokens DROP CONSTRAINT refresh_tokens_uid_key;
+ALTER TABLE refresh_tokens
+ADD COLUMN session_uid uuid NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+  ADD COLUMN device_id text NOT NULL DEFAULT '',
+  ADD COLUMN user_agent text NOT NULL DEFAULT '',
+  ADD COLUMN ip text NOT NULL DEFAULT '',
+  ADD COLUMN last_used_at timestamptz NOT NULL DEFAULT now();
+CREATE INDEX idx_refresh_tokens_uid ON refresh_tokens (uid, last_used_at DESC);
+-- a device keeps at most one session per user; logins without a device id always open a new one
+CREATE UNIQUE INDEX uq_refresh_tokens_device ON refresh_tokens (uid, device_id)
+WHERE device_id <> '';
+-- session policies
+INSERT INTO casbin_rules (ptype, v0, v1, v2)
+VALUES ('p', 'ANONYMOUS', '/user.UserService/Logout', 'CALL');
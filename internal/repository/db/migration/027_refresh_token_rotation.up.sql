@@ -0,0 +1,18 @@
+-- refresh tokens are stored as SHA-256 hashes
+ALTER TABLE refresh_tokens
+ADD COLUMN token_hash text;
+UPDATE refresh_tokens
+SET token_hash = encode(sha256(convert_to(token, 'UTF8')), 'hex');
+ALTER TABLE refresh_tokens
+ALTER COLUMN token_hash
+SET NOT NULL,
+  ADD CONSTRAINT refresh_tokens_token_hash_key UNIQUE (token_hash),
+  DROP COLUMN token;
+-- tokens already rotated out of a session; the session is the rotation family, and
+-- presenting one of these again revokes it
+CREATE TABLE rotated_refresh_tokens (
+    token_hash text PRIMARY KEY,
+    session_uid uuid NOT NULL REFERENCES refresh_tokens (session_uid) ON DELETE CASCADE,
+    rotated_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_rotated_refresh_tokens_session ON rotated_refresh_tokens (session_uid);
@@ -0,0 +1,12 @@
+-- access tokens revoked before they expire; TOKEN entries match a jti, SESSION entries a
+-- session id and USER entries every token of a user issued before denied_at
+CREATE TYPE access_token_denial_kind AS ENUM ('TOKEN', 'SESSION', 'USER');
+CREATE TABLE access_token_denials (
+    kind access_token_denial_kind NOT NULL,
+    value text NOT NULL,
+    denied_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (kind, value)
+);
+CREATE INDEX idx_access_token_denials_denied_at ON access_token_denials (denied_at);
+CREATE INDEX idx_access_token_denials_expires_at ON access_token_denials (expires_at);
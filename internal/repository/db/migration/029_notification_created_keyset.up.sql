@@ -0,0 +1,3 @@
+-- notifications page on creation time; updated_at moves when a group gains an actor
+DROP INDEX idx_notifications_recipient_keyset;
+CREATE INDEX idx_notifications_recipient_keyset ON notifications (recipient_uid, created_at DESC, uid DESC);
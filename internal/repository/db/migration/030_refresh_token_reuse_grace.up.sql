@@ -0,0 +1,4 @@
+-- the token a rotated refresh token was exchanged for, sealed with a key derived from the
+-- rotated token itself, so a client that retries within the grace window gets it back
+ALTER TABLE rotated_refresh_tokens
+ADD COLUMN successor bytea;
@@ -0,0 +1,7 @@
+-- posts waiting to be fanned out to followers' timelines; rows are written in the same
+-- transaction that publishes the post and deleted once the fan-out commits
+CREATE TABLE timeline_fanout_outbox (
+    post_uid uuid PRIMARY KEY REFERENCES posts (uid) ON DELETE CASCADE,
+    enqueued_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_timeline_fanout_outbox_enqueued_at ON timeline_fanout_outbox (enqueued_at);
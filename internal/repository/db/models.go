@@ -5,6 +5,7 @@
 package db
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"time"
@@ -12,6 +13,142 @@ import (
 	"github.com/google/uuid"
 )
 
+type AccessTokenDenialKind string
+
+const (
+	AccessTokenDenialKindTOKEN   AccessTokenDenialKind = "TOKEN"
+	AccessTokenDenialKindSESSION AccessTokenDenialKind = "SESSION"
+	AccessTokenDenialKindUSER    AccessTokenDenialKind = "USER"
+)
+
+func (e *AccessTokenDenialKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AccessTokenDenialKind(s)
+	case string:
+		*e = AccessTokenDenialKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AccessTokenDenialKind: %T", src)
+	}
+	return nil
+}
+
+type NullAccessTokenDenialKind struct {
+	AccessTokenDenialKind AccessTokenDenialKind
+	Valid                 bool // Valid is true if AccessTokenDenialKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAccessTokenDenialKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.AccessTokenDenialKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AccessTokenDenialKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAccessTokenDenialKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AccessTokenDenialKind), nil
+}
+
+type AdminAction string
+
+const (
+	AdminActionSUSPENDUSER    AdminAction = "SUSPEND_USER"
+	AdminActionRESTOREUSER    AdminAction = "RESTORE_USER"
+	AdminActionSETUSERROLE    AdminAction = "SET_USER_ROLE"
+	AdminActionARCHIVEPOST    AdminAction = "ARCHIVE_POST"
+	AdminActionRESTOREPOST    AdminAction = "RESTORE_POST"
+	AdminActionARCHIVECOMMENT AdminAction = "ARCHIVE_COMMENT"
+	AdminActionRESTORECOMMENT AdminAction = "RESTORE_COMMENT"
+	AdminActionMERGETAG       AdminAction = "MERGE_TAG"
+	AdminActionRENAMETAG      AdminAction = "RENAME_TAG"
+)
+
+func (e *AdminAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AdminAction(s)
+	case string:
+		*e = AdminAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AdminAction: %T", src)
+	}
+	return nil
+}
+
+type NullAdminAction struct {
+	AdminAction AdminAction
+	Valid       bool // Valid is true if AdminAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAdminAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.AdminAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AdminAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAdminAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AdminAction), nil
+}
+
+type AdminTargetType string
+
+const (
+	AdminTargetTypeUSER    AdminTargetType = "USER"
+	AdminTargetTypePOST    AdminTargetType = "POST"
+	AdminTargetTypeCOMMENT AdminTargetType = "COMMENT"
+	AdminTargetTypeTAG     AdminTargetType = "TAG"
+)
+
+func (e *AdminTargetType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AdminTargetType(s)
+	case string:
+		*e = AdminTargetType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AdminTargetType: %T", src)
+	}
+	return nil
+}
+
+type NullAdminTargetType struct {
+	AdminTargetType AdminTargetType
+	Valid           bool // Valid is true if AdminTargetType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAdminTargetType) Scan(value interface{}) error {
+	if value == nil {
+		ns.AdminTargetType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AdminTargetType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAdminTargetType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AdminTargetType), nil
+}
+
 type CommentStatus string
 
 const (
@@ -54,6 +191,91 @@ func (ns NullCommentStatus) Value() (driver.Value, error) {
 	return string(ns.CommentStatus), nil
 }
 
+type ConversationRole string
+
+const (
+	ConversationRoleOWNER  ConversationRole = "OWNER"
+	ConversationRoleADMIN  ConversationRole = "ADMIN"
+	ConversationRoleMEMBER ConversationRole = "MEMBER"
+)
+
+func (e *ConversationRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ConversationRole(s)
+	case string:
+		*e = ConversationRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ConversationRole: %T", src)
+	}
+	return nil
+}
+
+type NullConversationRole struct {
+	ConversationRole ConversationRole
+	Valid            bool // Valid is true if ConversationRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullConversationRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.ConversationRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ConversationRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullConversationRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ConversationRole), nil
+}
+
+type ConversationType string
+
+const (
+	ConversationTypeDIRECT ConversationType = "DIRECT"
+	ConversationTypeGROUP  ConversationType = "GROUP"
+)
+
+func (e *ConversationType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ConversationType(s)
+	case string:
+		*e = ConversationType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ConversationType: %T", src)
+	}
+	return nil
+}
+
+type NullConversationType struct {
+	ConversationType ConversationType
+	Valid            bool // Valid is true if ConversationType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullConversationType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ConversationType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ConversationType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullConversationType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ConversationType), nil
+}
+
 type FileStatus string
 
 const (
@@ -96,11 +318,187 @@ func (ns NullFileStatus) Value() (driver.Value, error) {
 	return string(ns.FileStatus), nil
 }
 
+type MentionSource string
+
+const (
+	MentionSourcePOST    MentionSource = "POST"
+	MentionSourceCOMMENT MentionSource = "COMMENT"
+)
+
+func (e *MentionSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = MentionSource(s)
+	case string:
+		*e = MentionSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for MentionSource: %T", src)
+	}
+	return nil
+}
+
+type NullMentionSource struct {
+	MentionSource MentionSource
+	Valid         bool // Valid is true if MentionSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullMentionSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.MentionSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.MentionSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullMentionSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.MentionSource), nil
+}
+
+type MessagePermission string
+
+const (
+	MessagePermissionEVERYONE  MessagePermission = "EVERYONE"
+	MessagePermissionFOLLOWING MessagePermission = "FOLLOWING"
+	MessagePermissionNOBODY    MessagePermission = "NOBODY"
+)
+
+func (e *MessagePermission) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = MessagePermission(s)
+	case string:
+		*e = MessagePermission(s)
+	default:
+		return fmt.Errorf("unsupported scan type for MessagePermission: %T", src)
+	}
+	return nil
+}
+
+type NullMessagePermission struct {
+	MessagePermission MessagePermission
+	Valid             bool // Valid is true if MessagePermission is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullMessagePermission) Scan(value interface{}) error {
+	if value == nil {
+		ns.MessagePermission, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.MessagePermission.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullMessagePermission) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.MessagePermission), nil
+}
+
+type MessageType string
+
+const (
+	MessageTypeTEXT   MessageType = "TEXT"
+	MessageTypeSYSTEM MessageType = "SYSTEM"
+)
+
+func (e *MessageType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = MessageType(s)
+	case string:
+		*e = MessageType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for MessageType: %T", src)
+	}
+	return nil
+}
+
+type NullMessageType struct {
+	MessageType MessageType
+	Valid       bool // Valid is true if MessageType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullMessageType) Scan(value interface{}) error {
+	if value == nil {
+		ns.MessageType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.MessageType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullMessageType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.MessageType), nil
+}
+
+type NotificationType string
+
+const (
+	NotificationTypePOSTLIKE      NotificationType = "POST_LIKE"
+	NotificationTypeCOMMENTLIKE   NotificationType = "COMMENT_LIKE"
+	NotificationTypeCOMMENT       NotificationType = "COMMENT"
+	NotificationTypeREPLY         NotificationType = "REPLY"
+	NotificationTypeFOLLOW        NotificationType = "FOLLOW"
+	NotificationTypeFOLLOWREQUEST NotificationType = "FOLLOW_REQUEST"
+	NotificationTypeMENTION       NotificationType = "MENTION"
+)
+
+func (e *NotificationType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationType(s)
+	case string:
+		*e = NotificationType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationType: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationType struct {
+	NotificationType NotificationType
+	Valid            bool // Valid is true if NotificationType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationType) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationType), nil
+}
+
 type PostStatus string
 
 const (
-	PostStatusNORMAL   PostStatus = "NORMAL"
-	PostStatusARCHIVED PostStatus = "ARCHIVED"
+	PostStatusNORMAL    PostStatus = "NORMAL"
+	PostStatusARCHIVED  PostStatus = "ARCHIVED"
+	PostStatusDRAFT     PostStatus = "DRAFT"
+	PostStatusSCHEDULED PostStatus = "SCHEDULED"
 )
 
 func (e *PostStatus) Scan(src interface{}) error {
@@ -141,8 +539,10 @@ func (ns NullPostStatus) Value() (driver.Value, error) {
 type PostVisibility string
 
 const (
-	PostVisibilityPUBLIC  PostVisibility = "PUBLIC"
-	PostVisibilityPRIVATE PostVisibility = "PRIVATE"
+	PostVisibilityPUBLIC    PostVisibility = "PUBLIC"
+	PostVisibilityPRIVATE   PostVisibility = "PRIVATE"
+	PostVisibilityFOLLOWERS PostVisibility = "FOLLOWERS"
+	PostVisibilityUNLISTED  PostVisibility = "UNLISTED"
 )
 
 func (e *PostVisibility) Scan(src interface{}) error {
@@ -180,6 +580,186 @@ func (ns NullPostVisibility) Value() (driver.Value, error) {
 	return string(ns.PostVisibility), nil
 }
 
+type ReportReason string
+
+const (
+	ReportReasonSPAM           ReportReason = "SPAM"
+	ReportReasonHARASSMENT     ReportReason = "HARASSMENT"
+	ReportReasonHATE           ReportReason = "HATE"
+	ReportReasonVIOLENCE       ReportReason = "VIOLENCE"
+	ReportReasonSEXUAL         ReportReason = "SEXUAL"
+	ReportReasonMISINFORMATION ReportReason = "MISINFORMATION"
+	ReportReasonOTHER          ReportReason = "OTHER"
+)
+
+func (e *ReportReason) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReportReason(s)
+	case string:
+		*e = ReportReason(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReportReason: %T", src)
+	}
+	return nil
+}
+
+type NullReportReason struct {
+	ReportReason ReportReason
+	Valid        bool // Valid is true if ReportReason is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReportReason) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReportReason, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReportReason.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReportReason) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReportReason), nil
+}
+
+type ReportStatus string
+
+const (
+	ReportStatusOPEN      ReportStatus = "OPEN"
+	ReportStatusCLAIMED   ReportStatus = "CLAIMED"
+	ReportStatusRESOLVED  ReportStatus = "RESOLVED"
+	ReportStatusDISMISSED ReportStatus = "DISMISSED"
+)
+
+func (e *ReportStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReportStatus(s)
+	case string:
+		*e = ReportStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReportStatus: %T", src)
+	}
+	return nil
+}
+
+type NullReportStatus struct {
+	ReportStatus ReportStatus
+	Valid        bool // Valid is true if ReportStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReportStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReportStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReportStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReportStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReportStatus), nil
+}
+
+type ReportTargetType string
+
+const (
+	ReportTargetTypePOST    ReportTargetType = "POST"
+	ReportTargetTypeCOMMENT ReportTargetType = "COMMENT"
+	ReportTargetTypeUSER    ReportTargetType = "USER"
+)
+
+func (e *ReportTargetType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReportTargetType(s)
+	case string:
+		*e = ReportTargetType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReportTargetType: %T", src)
+	}
+	return nil
+}
+
+type NullReportTargetType struct {
+	ReportTargetType ReportTargetType
+	Valid            bool // Valid is true if ReportTargetType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReportTargetType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReportTargetType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReportTargetType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReportTargetType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReportTargetType), nil
+}
+
+type SystemMessageAction string
+
+const (
+	SystemMessageActionGROUPCREATED  SystemMessageAction = "GROUP_CREATED"
+	SystemMessageActionGROUPUPDATED  SystemMessageAction = "GROUP_UPDATED"
+	SystemMessageActionMEMBERADDED   SystemMessageAction = "MEMBER_ADDED"
+	SystemMessageActionMEMBERREMOVED SystemMessageAction = "MEMBER_REMOVED"
+	SystemMessageActionMEMBERLEFT    SystemMessageAction = "MEMBER_LEFT"
+	SystemMessageActionROLECHANGED   SystemMessageAction = "ROLE_CHANGED"
+)
+
+func (e *SystemMessageAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SystemMessageAction(s)
+	case string:
+		*e = SystemMessageAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SystemMessageAction: %T", src)
+	}
+	return nil
+}
+
+type NullSystemMessageAction struct {
+	SystemMessageAction SystemMessageAction
+	Valid               bool // Valid is true if SystemMessageAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSystemMessageAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.SystemMessageAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SystemMessageAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSystemMessageAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SystemMessageAction), nil
+}
+
 type UserRole string
 
 const (
@@ -265,12 +845,65 @@ func (ns NullUserStatus) Value() (driver.Value, error) {
 	return string(ns.UserStatus), nil
 }
 
+type AccessTokenDenial struct {
+	Kind      AccessTokenDenialKind
+	Value     string
+	DeniedAt  time.Time
+	ExpiresAt time.Time
+}
+
+type AdminAuditLog struct {
+	ID         int32
+	Uid        uuid.UUID
+	ActorUid   uuid.UUID
+	Action     AdminAction
+	TargetType AdminTargetType
+	TargetUid  uuid.UUID
+	Reason     string
+	Detail     string
+	CreatedAt  time.Time
+}
+
+type CasbinRule struct {
+	ID    int32
+	Ptype string
+	V0    string
+	V1    string
+	V2    string
+	V3    string
+	V4    string
+	V5    string
+}
+
 type CommentLike struct {
 	CommentUid uuid.UUID
 	UserUid    uuid.UUID
 	CreatedAt  time.Time
 }
 
+type Conversation struct {
+	ID             int32
+	Uid            uuid.UUID
+	Type           ConversationType
+	DirectKey      sql.NullString
+	LastMessageUid uuid.NullUUID
+	LastMessageAt  time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Name           string
+	AvatarUrl      string
+}
+
+type ConversationMember struct {
+	ConversationUid    uuid.UUID
+	UserUid            uuid.UUID
+	LastReadMessageUid uuid.NullUUID
+	LastReadAt         sql.NullTime
+	UnreadCount        int32
+	JoinedAt           time.Time
+	Role               ConversationRole
+}
+
 type File struct {
 	ID          int32
 	Url         string
@@ -283,6 +916,78 @@ type File struct {
 	CreatedAt   time.Time
 }
 
+type FollowRequest struct {
+	RequesterUid uuid.UUID
+	TargetUid    uuid.UUID
+	CreatedAt    time.Time
+}
+
+type Mention struct {
+	SourceType   MentionSource
+	SourceUid    uuid.UUID
+	MentionedUid uuid.UUID
+	AuthorUid    uuid.UUID
+	PostUid      uuid.UUID
+	CreatedAt    time.Time
+}
+
+type Message struct {
+	ID              int32
+	Uid             uuid.UUID
+	ConversationUid uuid.UUID
+	SenderUid       uuid.UUID
+	Content         string
+	Images          []string
+	CreatedAt       time.Time
+	Type            MessageType
+	SystemAction    NullSystemMessageAction
+	TargetUid       uuid.NullUUID
+}
+
+type Notification struct {
+	ID           int32
+	Uid          uuid.UUID
+	RecipientUid uuid.UUID
+	Type         NotificationType
+	TargetUid    uuid.UUID
+	PostUid      uuid.NullUUID
+	ActorUid     uuid.UUID
+	ActorCount   int32
+	ReadAt       sql.NullTime
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type NotificationActor struct {
+	NotificationUid uuid.UUID
+	ActorUid        uuid.UUID
+	CreatedAt       time.Time
+}
+
+type Poll struct {
+	ID         int32
+	Uid        uuid.UUID
+	PostUid    uuid.UUID
+	Multiple   bool
+	ExpiresAt  time.Time
+	VoterCount int32
+	CreatedAt  time.Time
+}
+
+type PollOption struct {
+	PollUid   uuid.UUID
+	Position  int32
+	Text      string
+	VoteCount int32
+}
+
+type PollVote struct {
+	PollUid   uuid.UUID
+	UserUid   uuid.UUID
+	Positions []int32
+	CreatedAt time.Time
+}
+
 type Post struct {
 	ID              int32
 	Uid             uuid.UUID
@@ -300,6 +1005,13 @@ type Post struct {
 	Status          PostStatus
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	SearchVector    interface{}
+	RepostCount     int32
+	RepostOfUid     uuid.NullUUID
+	QuotedPostUid   uuid.NullUUID
+	Entities        string
+	EditCount       int32
+	PublishAt       sql.NullTime
 }
 
 type PostCollection struct {
@@ -324,6 +1036,8 @@ type PostComment struct {
 	Status           CommentStatus
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+	SearchVector     interface{}
+	Entities         string
 }
 
 type PostLike struct {
@@ -332,39 +1046,115 @@ type PostLike struct {
 	CreatedAt time.Time
 }
 
+type PostRevision struct {
+	ID          int32
+	Uid         uuid.UUID
+	PostUid     uuid.UUID
+	Revision    int32
+	Text        string
+	Entities    string
+	Images      []string
+	Attachments []string
+	Tags        []string
+	Visibility  PostVisibility
+	PublishedAt time.Time
+	CreatedAt   time.Time
+}
+
 type PostTag struct {
 	PostID int32
 	TagID  int32
 }
 
 type RefreshToken struct {
-	ID        int32
-	Uid       uuid.UUID
-	Token     string
-	ExpiresAt time.Time
-	CreatedAt time.Time
+	ID         int32
+	Uid        uuid.UUID
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	SessionUid uuid.UUID
+	DeviceID   string
+	UserAgent  string
+	Ip         string
+	LastUsedAt time.Time
+	TokenHash  string
+}
+
+type Report struct {
+	ID          int32
+	Uid         uuid.UUID
+	ReporterUid uuid.UUID
+	TargetType  ReportTargetType
+	TargetUid   uuid.UUID
+	Reason      ReportReason
+	Detail      string
+	Status      ReportStatus
+	HandlerUid  uuid.NullUUID
+	Note        string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type RotatedRefreshToken struct {
+	TokenHash  string
+	SessionUid uuid.UUID
+	RotatedAt  time.Time
+	Successor  []byte
 }
 
 type Tag struct {
 	ID   int32
 	Name string
+	Uid  uuid.UUID
+}
+
+type TagAlias struct {
+	Name      string
+	TagID     int32
+	CreatedAt time.Time
+}
+
+type TagFollow struct {
+	UserUid   uuid.UUID
+	TagID     int32
+	CreatedAt time.Time
+}
+
+type TimelineEntry struct {
+	OwnerUid  uuid.UUID
+	PostUid   uuid.UUID
+	AuthorUid uuid.UUID
+	CreatedAt time.Time
+}
+
+type TimelineFanoutOutbox struct {
+	PostUid    uuid.UUID
+	EnqueuedAt time.Time
 }
 
 type User struct {
-	ID             int32
-	Uid            uuid.UUID
-	Username       string
-	Role           UserRole
-	Email          string
-	Nickname       string
-	PasswordHash   string
-	AvatarUrl      string
-	FollowersCount int32
-	FollowingCount int32
-	Description    string
-	Status         UserStatus
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID                int32
+	Uid               uuid.UUID
+	Username          string
+	Role              UserRole
+	Email             string
+	Nickname          string
+	PasswordHash      string
+	AvatarUrl         string
+	FollowersCount    int32
+	FollowingCount    int32
+	Description       string
+	Status            UserStatus
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	SearchVector      interface{}
+	MessagePermission MessagePermission
+	IsPrivate         bool
+}
+
+type UserBlock struct {
+	BlockerUid uuid.UUID
+	BlockedUid uuid.UUID
+	CreatedAt  time.Time
 }
 
 type UserFollow struct {
@@ -372,3 +1162,9 @@ type UserFollow struct {
 	FolloweeUid uuid.UUID
 	CreatedAt   time.Time
 }
+
+type UserMute struct {
+	MuterUid  uuid.UUID
+	MutedUid  uuid.UUID
+	CreatedAt time.Time
+}
@@ -0,0 +1,273 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: notification.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const addNotificationActor = `-- name: AddNotificationActor :exec
+WITH inserted AS (
+  INSERT INTO notification_actors (notification_uid, actor_uid)
+  VALUES ($2, $1) ON CONFLICT DO NOTHING
+  RETURNING 1
+)
+UPDATE notifications
+SET actor_uid = $1,
+  actor_count = actor_count + 1,
+  updated_at = now()
+WHERE uid = $2
+  AND EXISTS (
+    SELECT 1
+    FROM inserted
+  )
+`
+
+type AddNotificationActorParams struct {
+	ActorUid        uuid.UUID
+	NotificationUid uuid.UUID
+}
+
+func (q *Queries) AddNotificationActor(ctx context.Context, arg AddNotificationActorParams) error {
+	_, err := q.db.ExecContext(ctx, addNotificationActor, arg.ActorUid, arg.NotificationUid)
+	return err
+}
+
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT count(*)::int
+FROM notifications
+WHERE recipient_uid = $1
+  AND read_at IS NULL
+`
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, recipientUid uuid.UUID) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countUnreadNotifications, recipientUid)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createNotification = `-- name: CreateNotification :exec
+INSERT INTO notifications (
+    recipient_uid,
+    type,
+    target_uid,
+    post_uid,
+    actor_uid
+  )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+  )
+`
+
+type CreateNotificationParams struct {
+	RecipientUid uuid.UUID
+	Type         NotificationType
+	TargetUid    uuid.UUID
+	PostUid      uuid.NullUUID
+	ActorUid     uuid.UUID
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) error {
+	_, err := q.db.ExecContext(ctx, createNotification,
+		arg.RecipientUid,
+		arg.Type,
+		arg.TargetUid,
+		arg.PostUid,
+		arg.ActorUid,
+	)
+	return err
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT n.uid,
+  n.type,
+  n.target_uid,
+  n.post_uid,
+  n.actor_uid,
+  u.nickname AS actor_nickname,
+  u.avatar_url AS actor_avatar_url,
+  n.actor_count,
+  COALESCE(c.content, p.text, '')::text AS preview,
+  n.read_at,
+  n.created_at,
+  n.updated_at
+FROM notifications n
+  JOIN users u ON u.uid = n.actor_uid
+  LEFT JOIN posts p ON p.uid = n.post_uid
+  LEFT JOIN post_comments c ON c.uid = n.target_uid
+  AND n.type IN (
+    'COMMENT_LIKE'::notification_type,
+    'COMMENT'::notification_type,
+    'REPLY'::notification_type,
+    'MENTION'::notification_type
+  )
+WHERE n.recipient_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (n.created_at, n.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY n.created_at DESC,
+  n.uid DESC
+LIMIT 20
+`
+
+type ListNotificationsParams struct {
+	RecipientUid    uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListNotificationsRow struct {
+	Uid            uuid.UUID
+	Type           NotificationType
+	TargetUid      uuid.UUID
+	PostUid        uuid.NullUUID
+	ActorUid       uuid.UUID
+	ActorNickname  string
+	ActorAvatarUrl string
+	ActorCount     int32
+	Preview        string
+	ReadAt         sql.NullTime
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]ListNotificationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listNotifications, arg.RecipientUid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNotificationsRow
+	for rows.Next() {
+		var i ListNotificationsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Type,
+			&i.TargetUid,
+			&i.PostUid,
+			&i.ActorUid,
+			&i.ActorNickname,
+			&i.ActorAvatarUrl,
+			&i.ActorCount,
+			&i.Preview,
+			&i.ReadAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = now()
+WHERE recipient_uid = $1
+  AND read_at IS NULL
+`
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, recipientUid uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markAllNotificationsRead, recipientUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :execrows
+UPDATE notifications
+SET read_at = now()
+WHERE recipient_uid = $1
+  AND uid = ANY($2::uuid [])
+  AND read_at IS NULL
+`
+
+type MarkNotificationsReadParams struct {
+	RecipientUid uuid.UUID
+	Uids         []uuid.UUID
+}
+
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markNotificationsRead, arg.RecipientUid, pq.Array(arg.Uids))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertNotificationGroup = `-- name: UpsertNotificationGroup :one
+INSERT INTO notifications (
+    recipient_uid,
+    type,
+    target_uid,
+    post_uid,
+    actor_uid,
+    actor_count
+  )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    0
+  ) ON CONFLICT (recipient_uid, type, target_uid)
+WHERE read_at IS NULL
+  AND type IN (
+    'POST_LIKE'::notification_type,
+    'COMMENT_LIKE'::notification_type,
+    'FOLLOW'::notification_type
+  ) DO
+UPDATE
+SET updated_at = notifications.updated_at
+RETURNING uid
+`
+
+type UpsertNotificationGroupParams struct {
+	RecipientUid uuid.UUID
+	Type         NotificationType
+	TargetUid    uuid.UUID
+	PostUid      uuid.NullUUID
+	ActorUid     uuid.UUID
+}
+
+func (q *Queries) UpsertNotificationGroup(ctx context.Context, arg UpsertNotificationGroupParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, upsertNotificationGroup,
+		arg.RecipientUid,
+		arg.Type,
+		arg.TargetUid,
+		arg.PostUid,
+		arg.ActorUid,
+	)
+	var uid uuid.UUID
+	err := row.Scan(&uid)
+	return uid, err
+}
@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: poll.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const createPoll = `-- name: CreatePoll :one
+INSERT INTO polls (post_uid, multiple, expires_at)
+VALUES ($1, $2, $3)
+RETURNING uid
+`
+
+type CreatePollParams struct {
+	PostUid   uuid.UUID
+	Multiple  bool
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreatePoll(ctx context.Context, arg CreatePollParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, createPoll, arg.PostUid, arg.Multiple, arg.ExpiresAt)
+	var uid uuid.UUID
+	err := row.Scan(&uid)
+	return uid, err
+}
+
+const createPollOptions = `-- name: CreatePollOptions :exec
+INSERT INTO poll_options (poll_uid, position, text)
+SELECT $1,
+  o.ordinality - 1,
+  o.text
+FROM unnest($2::text []) WITH ORDINALITY AS o(text, ordinality)
+`
+
+type CreatePollOptionsParams struct {
+	PollUid uuid.UUID
+	Options []string
+}
+
+func (q *Queries) CreatePollOptions(ctx context.Context, arg CreatePollOptionsParams) error {
+	_, err := q.db.ExecContext(ctx, createPollOptions, arg.PollUid, pq.Array(arg.Options))
+	return err
+}
+
+const getPollByPostUid = `-- name: GetPollByPostUid :one
+SELECT pl.uid,
+  pl.multiple,
+  pl.expires_at,
+  (
+    SELECT count(*)
+    FROM poll_options o
+    WHERE o.poll_uid = pl.uid
+  )::integer AS option_count
+FROM polls pl
+WHERE pl.post_uid = $1
+LIMIT 1
+`
+
+type GetPollByPostUidRow struct {
+	Uid         uuid.UUID
+	Multiple    bool
+	ExpiresAt   time.Time
+	OptionCount int32
+}
+
+func (q *Queries) GetPollByPostUid(ctx context.Context, postUid uuid.UUID) (GetPollByPostUidRow, error) {
+	row := q.db.QueryRowContext(ctx, getPollByPostUid, postUid)
+	var i GetPollByPostUidRow
+	err := row.Scan(
+		&i.Uid,
+		&i.Multiple,
+		&i.ExpiresAt,
+		&i.OptionCount,
+	)
+	return i, err
+}
+
+const getPollsByPostUids = `-- name: GetPollsByPostUids :many
+SELECT pl.post_uid,
+  pl.uid,
+  pl.multiple,
+  pl.expires_at,
+  pl.voter_count,
+  ARRAY(
+    SELECT o.text
+    FROM poll_options o
+    WHERE o.poll_uid = pl.uid
+    ORDER BY o.position
+  )::text [] AS option_texts,
+  ARRAY(
+    SELECT o.vote_count
+    FROM poll_options o
+    WHERE o.poll_uid = pl.uid
+    ORDER BY o.position
+  )::integer [] AS option_votes,
+  (v.user_uid IS NOT NULL)::boolean AS voted,
+  COALESCE(v.positions, '{}'::integer [])::integer [] AS my_positions
+FROM polls pl
+  LEFT JOIN poll_votes v ON v.poll_uid = pl.uid
+  AND v.user_uid = $1::uuid
+WHERE pl.post_uid = ANY($2::uuid [])
+`
+
+type GetPollsByPostUidsParams struct {
+	Viewer   uuid.NullUUID
+	PostUids []uuid.UUID
+}
+
+type GetPollsByPostUidsRow struct {
+	PostUid     uuid.UUID
+	Uid         uuid.UUID
+	Multiple    bool
+	ExpiresAt   time.Time
+	VoterCount  int32
+	OptionTexts []string
+	OptionVotes []int32
+	Voted       bool
+	MyPositions []int32
+}
+
+func (q *Queries) GetPollsByPostUids(ctx context.Context, arg GetPollsByPostUidsParams) ([]GetPollsByPostUidsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPollsByPostUids, arg.Viewer, pq.Array(arg.PostUids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPollsByPostUidsRow
+	for rows.Next() {
+		var i GetPollsByPostUidsRow
+		if err := rows.Scan(
+			&i.PostUid,
+			&i.Uid,
+			&i.Multiple,
+			&i.ExpiresAt,
+			&i.VoterCount,
+			pq.Array(&i.OptionTexts),
+			pq.Array(&i.OptionVotes),
+			&i.Voted,
+			pq.Array(&i.MyPositions),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const votePoll = `-- name: VotePoll :one
+WITH ballot AS (
+  INSERT INTO poll_votes (poll_uid, user_uid, positions)
+  SELECT pl.uid,
+    $1,
+    $2::integer []
+  FROM polls pl
+  WHERE pl.uid = $3
+    AND pl.expires_at > now() ON CONFLICT (poll_uid, user_uid) DO NOTHING
+  RETURNING poll_uid,
+    positions
+),
+counted AS (
+  UPDATE poll_options o
+  SET vote_count = o.vote_count + 1
+  FROM ballot b
+  WHERE o.poll_uid = b.poll_uid
+    AND o.position = ANY(b.positions)
+  RETURNING 1
+),
+voters AS (
+  UPDATE polls vp
+  SET voter_count = vp.voter_count + 1
+  FROM ballot b
+  WHERE vp.uid = b.poll_uid
+  RETURNING 1
+)
+SELECT count(*)
+FROM ballot
+`
+
+type VotePollParams struct {
+	UserUid   uuid.UUID
+	Positions []int32
+	PollUid   uuid.UUID
+}
+
+func (q *Queries) VotePoll(ctx context.Context, arg VotePollParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, votePoll, arg.UserUid, pq.Array(arg.Positions), arg.PollUid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
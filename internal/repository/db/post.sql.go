@@ -14,13 +14,25 @@ import (
 	"github.com/lib/pq"
 )
 
-const archivePostByUidAndAuthor = `-- name: ArchivePostByUidAndAuthor :execrows
-UPDATE posts
-SET status = 'ARCHIVED'::post_status,
-  updated_at = now()
-WHERE uid = $1
-  AND author = $2
-  AND status = 'NORMAL'::post_status
+const archivePostByUidAndAuthor = `-- name: ArchivePostByUidAndAuthor :one
+WITH archived AS (
+  UPDATE posts ap
+  SET status = 'ARCHIVED'::post_status,
+    updated_at = now()
+  WHERE ap.uid = $1
+    AND ap.author = $2
+    AND ap.status = 'NORMAL'::post_status
+  RETURNING ap.repost_of_uid
+),
+unreposted AS (
+  UPDATE posts o
+  SET repost_count = GREATEST(o.repost_count - 1, 0)
+  FROM archived a
+  WHERE o.uid = a.repost_of_uid
+  RETURNING 1
+)
+SELECT count(*)
+FROM archived
 `
 
 type ArchivePostByUidAndAuthorParams struct {
@@ -29,44 +41,55 @@ type ArchivePostByUidAndAuthorParams struct {
 }
 
 func (q *Queries) ArchivePostByUidAndAuthor(ctx context.Context, arg ArchivePostByUidAndAuthorParams) (int64, error) {
-	result, err := q.db.ExecContext(ctx, archivePostByUidAndAuthor, arg.Uid, arg.Author)
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected()
+	row := q.db.QueryRowContext(ctx, archivePostByUidAndAuthor, arg.Uid, arg.Author)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
 const createPost = `-- name: CreatePost :one
 INSERT INTO posts (
     author,
     text,
+    entities,
     images,
     attachments,
     visibility,
     pinned,
-    ip
+    ip,
+    quoted_post_uid,
+    status,
+    publish_at
   )
 VALUES (
     $1,
     $2,
-    COALESCE($3::text [], '{}'::text []),
+    $3,
     COALESCE($4::text [], '{}'::text []),
-    $5,
+    COALESCE($5::text [], '{}'::text []),
     $6,
-    $7
+    $7,
+    $8,
+    $9::uuid,
+    $10,
+    $11::timestamptz
   )
 RETURNING id,
   uid
 `
 
 type CreatePostParams struct {
-	Author      uuid.UUID
-	Text        string
-	Images      []string
-	Attachments []string
-	Visibility  PostVisibility
-	Pinned      bool
-	Ip          string
+	Author        uuid.UUID
+	Text          string
+	Entities      string
+	Images        []string
+	Attachments   []string
+	Visibility    PostVisibility
+	Pinned        bool
+	Ip            string
+	QuotedPostUid uuid.NullUUID
+	Status        PostStatus
+	PublishAt     sql.NullTime
 }
 
 type CreatePostRow struct {
@@ -78,17 +101,220 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (CreateP
 	row := q.db.QueryRowContext(ctx, createPost,
 		arg.Author,
 		arg.Text,
+		arg.Entities,
 		pq.Array(arg.Images),
 		pq.Array(arg.Attachments),
 		arg.Visibility,
 		arg.Pinned,
 		arg.Ip,
+		arg.QuotedPostUid,
+		arg.Status,
+		arg.PublishAt,
 	)
 	var i CreatePostRow
 	err := row.Scan(&i.ID, &i.Uid)
 	return i, err
 }
 
+const createRepost = `-- name: CreateRepost :one
+WITH inserted AS (
+  INSERT INTO posts (author, text, repost_of_uid)
+  VALUES ($2, '', $1) ON CONFLICT (author, repost_of_uid)
+  WHERE repost_of_uid IS NOT NULL
+    AND status = 'NORMAL'::post_status DO NOTHING
+  RETURNING uid
+),
+updated AS (
+  UPDATE posts
+  SET repost_count = repost_count + 1,
+    updated_at = now()
+  WHERE uid = $1
+    AND EXISTS (SELECT 1 FROM inserted)
+  RETURNING repost_count
+)
+SELECT COALESCE(
+    (SELECT uid FROM inserted),
+    '00000000-0000-0000-0000-000000000000'::uuid
+  )::uuid AS repost_uid,
+  COALESCE(
+    (SELECT repost_count FROM updated),
+    (SELECT repost_count FROM posts WHERE posts.uid = $1)
+  )::int4 AS repost_count
+`
+
+type CreateRepostParams struct {
+	PostUid uuid.UUID
+	Author  uuid.UUID
+}
+
+type CreateRepostRow struct {
+	RepostUid   uuid.UUID
+	RepostCount int32
+}
+
+func (q *Queries) CreateRepost(ctx context.Context, arg CreateRepostParams) (CreateRepostRow, error) {
+	row := q.db.QueryRowContext(ctx, createRepost, arg.PostUid, arg.Author)
+	var i CreateRepostRow
+	err := row.Scan(&i.RepostUid, &i.RepostCount)
+	return i, err
+}
+
+const deleteDraftByUidAndAuthor = `-- name: DeleteDraftByUidAndAuthor :execrows
+DELETE FROM posts
+WHERE uid = $1
+  AND author = $2
+  AND status IN ('DRAFT'::post_status, 'SCHEDULED'::post_status)
+`
+
+type DeleteDraftByUidAndAuthorParams struct {
+	Uid    uuid.UUID
+	Author uuid.UUID
+}
+
+// drafts were never published, so nothing refers to them and they are removed outright
+func (q *Queries) DeleteDraftByUidAndAuthor(ctx context.Context, arg DeleteDraftByUidAndAuthorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDraftByUidAndAuthor, arg.Uid, arg.Author)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getEmbeddedPosts = `-- name: GetEmbeddedPosts :many
+SELECT p.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.repost_count,
+  p.edit_count,
+  p.visibility,
+  p.created_at,
+  p.updated_at,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names,
+  (
+    p.status = 'NORMAL'::post_status
+    AND u.status = 'NORMAL'::user_status
+    AND (
+      p.author = $1::uuid
+      OR (
+        p.visibility IN (
+          'PUBLIC'::post_visibility,
+          'UNLISTED'::post_visibility
+        )
+        AND NOT u.is_private
+      )
+      OR (
+        p.visibility IN (
+          'PUBLIC'::post_visibility,
+          'UNLISTED'::post_visibility,
+          'FOLLOWERS'::post_visibility
+        )
+        AND EXISTS (
+          SELECT 1
+          FROM user_follows vf
+          WHERE vf.follower_uid = $1::uuid
+            AND vf.followee_uid = p.author
+        )
+      )
+    )
+  )::boolean AS visible
+FROM posts p
+  JOIN users u ON u.uid = p.author
+WHERE p.uid = ANY($2::uuid [])
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = $1::uuid
+      AND ub.blocked_uid = p.author
+  )
+`
+
+type GetEmbeddedPostsParams struct {
+	Viewer uuid.NullUUID
+	Uids   []uuid.UUID
+}
+
+type GetEmbeddedPostsRow struct {
+	Uid             uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Entities        string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
+	Visibility      PostVisibility
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	TagNames        []string
+	Visible         bool
+}
+
+func (q *Queries) GetEmbeddedPosts(ctx context.Context, arg GetEmbeddedPostsParams) ([]GetEmbeddedPostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getEmbeddedPosts, arg.Viewer, pq.Array(arg.Uids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEmbeddedPostsRow
+	for rows.Next() {
+		var i GetEmbeddedPostsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Entities,
+			pq.Array(&i.Images),
+			pq.Array(&i.Attachments),
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.RepostCount,
+			&i.EditCount,
+			&i.Visibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			pq.Array(&i.TagNames),
+			&i.Visible,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPostByUid = `-- name: GetPostByUid :one
 SELECT p.uid,
   p.author,
@@ -96,11 +322,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -108,8 +337,17 @@ SELECT p.uid,
   p.status,
   p.created_at,
   p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
   (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = $1::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
   COALESCE(
     (
       SELECT array_agg(
@@ -130,6 +368,36 @@ FROM posts p
   LEFT JOIN post_collections pc ON pc.post_uid = p.uid
   AND pc.user_uid = $1::uuid
 WHERE p.uid = $2
+  AND (
+    p.status = 'NORMAL'::post_status
+    OR (
+      p.status IN ('DRAFT'::post_status, 'SCHEDULED'::post_status)
+      AND p.author = $1::uuid
+    )
+  )
+  AND (
+    p.author = $1::uuid
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility
+      )
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = $1::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
 LIMIT 1
 `
 
@@ -145,11 +413,14 @@ type GetPostByUidRow struct {
 	AuthorNickname  string
 	AuthorAvatarUrl string
 	Text            string
+	Entities        string
 	Images          []string
 	Attachments     []string
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn time.Time
@@ -157,8 +428,11 @@ type GetPostByUidRow struct {
 	Status          PostStatus
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	RepostOfUid     string
+	QuotedPostUid   string
 	Liked           bool
 	Collected       bool
+	Reposted        bool
 	TagNames        []string
 }
 
@@ -172,11 +446,14 @@ func (q *Queries) GetPostByUid(ctx context.Context, arg GetPostByUidParams) (Get
 		&i.AuthorNickname,
 		&i.AuthorAvatarUrl,
 		&i.Text,
+		&i.Entities,
 		pq.Array(&i.Images),
 		pq.Array(&i.Attachments),
 		&i.CommentCount,
 		&i.CollectionCount,
 		&i.LikeCount,
+		&i.RepostCount,
+		&i.EditCount,
 		&i.Pinned,
 		&i.Visibility,
 		&i.LatestRepliedOn,
@@ -184,13 +461,564 @@ func (q *Queries) GetPostByUid(ctx context.Context, arg GetPostByUidParams) (Get
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RepostOfUid,
+		&i.QuotedPostUid,
 		&i.Liked,
 		&i.Collected,
+		&i.Reposted,
 		pq.Array(&i.TagNames),
 	)
 	return i, err
 }
 
+const getPostVisibilityByUid = `-- name: GetPostVisibilityByUid :one
+SELECT p.author,
+  p.visibility,
+  u.is_private AS author_is_private,
+  (p.repost_of_uid IS NOT NULL)::boolean AS is_repost
+FROM posts p
+  JOIN users u ON u.uid = p.author
+WHERE p.uid = $1
+  AND p.status = 'NORMAL'::post_status
+  AND (
+    p.author = $2::uuid
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility
+      )
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = $2::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
+LIMIT 1
+`
+
+type GetPostVisibilityByUidParams struct {
+	Uid    uuid.UUID
+	Viewer uuid.NullUUID
+}
+
+type GetPostVisibilityByUidRow struct {
+	Author          uuid.UUID
+	Visibility      PostVisibility
+	AuthorIsPrivate bool
+	IsRepost        bool
+}
+
+func (q *Queries) GetPostVisibilityByUid(ctx context.Context, arg GetPostVisibilityByUidParams) (GetPostVisibilityByUidRow, error) {
+	row := q.db.QueryRowContext(ctx, getPostVisibilityByUid, arg.Uid, arg.Viewer)
+	var i GetPostVisibilityByUidRow
+	err := row.Scan(
+		&i.Author,
+		&i.Visibility,
+		&i.AuthorIsPrivate,
+		&i.IsRepost,
+	)
+	return i, err
+}
+
+const listDraftsByAuthor = `-- name: ListDraftsByAuthor :many
+SELECT p.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.pinned,
+  p.visibility,
+  p.status,
+  p.publish_at,
+  p.created_at,
+  p.updated_at,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM posts p
+  JOIN users u ON u.uid = p.author
+WHERE p.author = $1
+  AND p.status IN ('DRAFT'::post_status, 'SCHEDULED'::post_status)
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20
+`
+
+type ListDraftsByAuthorParams struct {
+	Author          uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListDraftsByAuthorRow struct {
+	Uid             uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Entities        string
+	Images          []string
+	Attachments     []string
+	Pinned          bool
+	Visibility      PostVisibility
+	Status          PostStatus
+	PublishAt       sql.NullTime
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	QuotedPostUid   string
+	TagNames        []string
+}
+
+func (q *Queries) ListDraftsByAuthor(ctx context.Context, arg ListDraftsByAuthorParams) ([]ListDraftsByAuthorRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDraftsByAuthor, arg.Author, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDraftsByAuthorRow
+	for rows.Next() {
+		var i ListDraftsByAuthorRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Entities,
+			pq.Array(&i.Images),
+			pq.Array(&i.Attachments),
+			&i.Pinned,
+			&i.Visibility,
+			&i.Status,
+			&i.PublishAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.QuotedPostUid,
+			pq.Array(&i.TagNames),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExplicitPostTags = `-- name: ListExplicitPostTags :many
+WITH inline AS (
+  SELECT e->>'text' AS name
+  FROM posts ip,
+    jsonb_array_elements(ip.entities::jsonb) e
+  WHERE ip.uid = $1
+    AND e->>'type' = 'HASHTAG'
+)
+SELECT t.name
+FROM posts p
+  JOIN post_tags pt ON pt.post_id = p.id
+  JOIN tags t ON t.id = pt.tag_id
+WHERE p.uid = $1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM inline i
+    WHERE i.name = t.name
+      OR EXISTS (
+        SELECT 1
+        FROM tag_aliases a
+        WHERE a.tag_id = t.id
+          AND a.name = i.name
+      )
+  )
+ORDER BY t.name
+`
+
+func (q *Queries) ListExplicitPostTags(ctx context.Context, uid uuid.UUID) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listExplicitPostTags, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHomeTimeline = `-- name: ListHomeTimeline :many
+WITH candidates AS (
+  (
+    SELECT te.post_uid,
+      te.created_at
+    FROM timeline_entries te
+      JOIN posts tp ON tp.uid = te.post_uid
+      AND tp.status = 'NORMAL'::post_status
+      AND tp.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+    WHERE te.owner_uid = $1
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_blocks ub
+        WHERE ub.blocker_uid = $1
+          AND ub.blocked_uid = te.author_uid
+      )
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_mutes um
+        WHERE um.muter_uid = $1
+          AND um.muted_uid = te.author_uid
+      )
+      AND (
+        (
+          $2::timestamptz IS NULL
+          AND $3::uuid IS NULL
+        )
+        OR (te.created_at, te.post_uid) < (
+          $2::timestamptz,
+          $3::uuid
+        )
+      )
+    ORDER BY te.created_at DESC,
+      te.post_uid DESC
+    LIMIT 20
+  )
+  UNION
+  (
+    SELECT rp.uid AS post_uid,
+      rp.created_at
+    FROM posts rp
+    WHERE rp.status = 'NORMAL'::post_status
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_blocks ub
+        WHERE ub.blocker_uid = $1
+          AND ub.blocked_uid = rp.author
+      )
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_mutes um
+        WHERE um.muter_uid = $1
+          AND um.muted_uid = rp.author
+      )
+      AND (
+        rp.author = $1
+        OR (
+          rp.visibility IN (
+            'PUBLIC'::post_visibility,
+            'FOLLOWERS'::post_visibility
+          )
+          AND rp.author IN (
+            SELECT uf.followee_uid
+            FROM user_follows uf
+              JOIN users fu ON fu.uid = uf.followee_uid
+            WHERE uf.follower_uid = $1
+              AND fu.followers_count >= $4::int
+          )
+        )
+      )
+      AND (
+        (
+          $2::timestamptz IS NULL
+          AND $3::uuid IS NULL
+        )
+        OR (rp.created_at, rp.uid) < (
+          $2::timestamptz,
+          $3::uuid
+        )
+      )
+    ORDER BY rp.created_at DESC,
+      rp.uid DESC
+    LIMIT 20
+  )
+  UNION
+  (
+    SELECT gp.uid AS post_uid,
+      gp.created_at
+    FROM posts gp
+      JOIN users gu ON gu.uid = gp.author
+    WHERE gp.status = 'NORMAL'::post_status
+      AND (
+        (
+          gp.visibility = 'PUBLIC'::post_visibility
+          AND NOT gu.is_private
+        )
+        OR (
+          gp.visibility IN (
+            'PUBLIC'::post_visibility,
+            'FOLLOWERS'::post_visibility
+          )
+          AND EXISTS (
+            SELECT 1
+            FROM user_follows vf
+            WHERE vf.follower_uid = $1
+              AND vf.followee_uid = gp.author
+          )
+        )
+      )
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_blocks ub
+        WHERE ub.blocker_uid = $1
+          AND ub.blocked_uid = gp.author
+      )
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_mutes um
+        WHERE um.muter_uid = $1
+          AND um.muted_uid = gp.author
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM post_tags gpt
+          JOIN tag_follows tf ON tf.tag_id = gpt.tag_id
+        WHERE gpt.post_id = gp.id
+          AND tf.user_uid = $1
+      )
+      AND (
+        (
+          $2::timestamptz IS NULL
+          AND $3::uuid IS NULL
+        )
+        OR (gp.created_at, gp.uid) < (
+          $2::timestamptz,
+          $3::uuid
+        )
+      )
+    ORDER BY gp.created_at DESC,
+      gp.uid DESC
+    LIMIT 20
+  )
+)
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.repost_count,
+  p.edit_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.status,
+  p.created_at,
+  p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = $1
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM candidates c
+  JOIN posts p ON p.uid = c.post_uid
+  JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1
+  LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20
+`
+
+type ListHomeTimelineParams struct {
+	Viewer          uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+	FanoutThreshold int32
+}
+
+type ListHomeTimelineRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Entities        string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn time.Time
+	Ip              string
+	Status          PostStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	RepostOfUid     string
+	QuotedPostUid   string
+	Liked           bool
+	Collected       bool
+	Reposted        bool
+	TagNames        []string
+}
+
+func (q *Queries) ListHomeTimeline(ctx context.Context, arg ListHomeTimelineParams) ([]ListHomeTimelineRow, error) {
+	rows, err := q.db.QueryContext(ctx, listHomeTimeline,
+		arg.Viewer,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.FanoutThreshold,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHomeTimelineRow
+	for rows.Next() {
+		var i ListHomeTimelineRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Entities,
+			pq.Array(&i.Images),
+			pq.Array(&i.Attachments),
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.RepostCount,
+			&i.EditCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepostOfUid,
+			&i.QuotedPostUid,
+			&i.Liked,
+			&i.Collected,
+			&i.Reposted,
+			pq.Array(&i.TagNames),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInlinePostTags = `-- name: ListInlinePostTags :many
+SELECT (e->>'text')::text AS name
+FROM posts p,
+  jsonb_array_elements(p.entities::jsonb) e
+WHERE p.uid = $1
+  AND e->>'type' = 'HASHTAG'
+`
+
+func (q *Queries) ListInlinePostTags(ctx context.Context, uid uuid.UUID) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listInlinePostTags, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPosts = `-- name: ListPosts :many
 SELECT p.uid,
   p.author,
@@ -198,11 +1026,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -210,8 +1041,17 @@ SELECT p.uid,
   p.status,
   p.created_at,
   p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
   (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = $1::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
   COALESCE(
     (
       SELECT array_agg(
@@ -232,6 +1072,39 @@ FROM posts p
   LEFT JOIN post_collections pc ON pc.post_uid = p.uid
   AND pc.user_uid = $1::uuid
 WHERE p.status = 'NORMAL'::post_status
+  AND (
+    (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND (
+        p.author = $1::uuid
+        OR EXISTS (
+          SELECT 1
+          FROM user_follows vf
+          WHERE vf.follower_uid = $1::uuid
+            AND vf.followee_uid = p.author
+        )
+      )
+    )
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = $1::uuid
+      AND ub.blocked_uid = p.author
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_mutes um
+    WHERE um.muter_uid = $1::uuid
+      AND um.muted_uid = p.author
+  )
   AND (
     (
       $2::timestamptz IS NULL
@@ -260,11 +1133,14 @@ type ListPostsRow struct {
 	AuthorNickname  string
 	AuthorAvatarUrl string
 	Text            string
+	Entities        string
 	Images          []string
 	Attachments     []string
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn time.Time
@@ -272,8 +1148,11 @@ type ListPostsRow struct {
 	Status          PostStatus
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	RepostOfUid     string
+	QuotedPostUid   string
 	Liked           bool
 	Collected       bool
+	Reposted        bool
 	TagNames        []string
 }
 
@@ -293,11 +1172,14 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 			&i.AuthorNickname,
 			&i.AuthorAvatarUrl,
 			&i.Text,
+			&i.Entities,
 			pq.Array(&i.Images),
 			pq.Array(&i.Attachments),
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.RepostCount,
+			&i.EditCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
@@ -305,8 +1187,11 @@ func (q *Queries) ListPosts(ctx context.Context, arg ListPostsParams) ([]ListPos
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RepostOfUid,
+			&i.QuotedPostUid,
 			&i.Liked,
 			&i.Collected,
+			&i.Reposted,
 			pq.Array(&i.TagNames),
 		); err != nil {
 			return nil, err
@@ -329,11 +1214,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -341,8 +1229,17 @@ SELECT p.uid,
   p.status,
   p.created_at,
   p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
   (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = $1::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
   COALESCE(
     (
       SELECT array_agg(
@@ -364,6 +1261,25 @@ FROM posts p
   AND pc.user_uid = $1::uuid
 WHERE p.status = 'NORMAL'::post_status
   AND p.author = $2
+  AND (
+    p.author = $1::uuid
+    OR (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = $1::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
   AND (
     (
       $3::timestamptz IS NULL
@@ -393,11 +1309,14 @@ type ListPostsByAuthorRow struct {
 	AuthorNickname  string
 	AuthorAvatarUrl string
 	Text            string
+	Entities        string
 	Images          []string
 	Attachments     []string
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn time.Time
@@ -405,8 +1324,11 @@ type ListPostsByAuthorRow struct {
 	Status          PostStatus
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	RepostOfUid     string
+	QuotedPostUid   string
 	Liked           bool
 	Collected       bool
+	Reposted        bool
 	TagNames        []string
 }
 
@@ -431,11 +1353,14 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 			&i.AuthorNickname,
 			&i.AuthorAvatarUrl,
 			&i.Text,
+			&i.Entities,
 			pq.Array(&i.Images),
 			pq.Array(&i.Attachments),
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.RepostCount,
+			&i.EditCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
@@ -443,8 +1368,11 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RepostOfUid,
+			&i.QuotedPostUid,
 			&i.Liked,
 			&i.Collected,
+			&i.Reposted,
 			pq.Array(&i.TagNames),
 		); err != nil {
 			return nil, err
@@ -460,56 +1388,218 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 	return items, nil
 }
 
+const lockPostByUidAndAuthor = `-- name: LockPostByUidAndAuthor :one
+SELECT status
+FROM posts
+WHERE uid = $1
+  AND author = $2
+  AND status IN (
+    'NORMAL'::post_status,
+    'DRAFT'::post_status,
+    'SCHEDULED'::post_status
+  )
+  AND repost_of_uid IS NULL
+LIMIT 1 FOR UPDATE
+`
+
+type LockPostByUidAndAuthorParams struct {
+	Uid    uuid.UUID
+	Author uuid.UUID
+}
+
+func (q *Queries) LockPostByUidAndAuthor(ctx context.Context, arg LockPostByUidAndAuthorParams) (PostStatus, error) {
+	row := q.db.QueryRowContext(ctx, lockPostByUidAndAuthor, arg.Uid, arg.Author)
+	var status PostStatus
+	err := row.Scan(&status)
+	return status, err
+}
+
+const publishDuePosts = `-- name: PublishDuePosts :many
+WITH due AS (
+  SELECT dp.id
+  FROM posts dp
+  WHERE dp.status = 'SCHEDULED'::post_status
+    AND dp.publish_at <= now()
+  ORDER BY dp.publish_at
+  LIMIT $1 FOR UPDATE SKIP LOCKED
+)
+UPDATE posts p
+SET status = 'NORMAL'::post_status,
+  publish_at = NULL,
+  created_at = now(),
+  updated_at = now()
+FROM due
+WHERE p.id = due.id
+RETURNING p.uid,
+  p.author,
+  p.entities
+`
+
+type PublishDuePostsRow struct {
+	Uid      uuid.UUID
+	Author   uuid.UUID
+	Entities string
+}
+
+func (q *Queries) PublishDuePosts(ctx context.Context, batchSize int32) ([]PublishDuePostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, publishDuePosts, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PublishDuePostsRow
+	for rows.Next() {
+		var i PublishDuePostsRow
+		if err := rows.Scan(&i.Uid, &i.Author, &i.Entities); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeRepost = `-- name: RemoveRepost :one
+WITH archived AS (
+  UPDATE posts ap
+  SET status = 'ARCHIVED'::post_status,
+    updated_at = now()
+  WHERE ap.author = $1
+    AND ap.repost_of_uid = $2::uuid
+    AND ap.status = 'NORMAL'::post_status
+  RETURNING 1
+),
+updated AS (
+  UPDATE posts
+  SET repost_count = GREATEST(repost_count - 1, 0),
+    updated_at = now()
+  WHERE uid = $2
+    AND EXISTS (SELECT 1 FROM archived)
+  RETURNING repost_count
+)
+SELECT repost_count
+FROM updated
+UNION ALL
+SELECT repost_count
+FROM posts
+WHERE uid = $2
+  AND NOT EXISTS (SELECT 1 FROM updated)
+LIMIT 1
+`
+
+type RemoveRepostParams struct {
+	Author  uuid.UUID
+	PostUid uuid.UUID
+}
+
+func (q *Queries) RemoveRepost(ctx context.Context, arg RemoveRepostParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, removeRepost, arg.Author, arg.PostUid)
+	var repost_count int32
+	err := row.Scan(&repost_count)
+	return repost_count, err
+}
+
 const updatePostByUidAndAuthor = `-- name: UpdatePostByUidAndAuthor :one
 UPDATE posts
 SET text = COALESCE($1, text),
-  images = COALESCE($2::text [], images),
-  attachments = COALESCE($3::text [], attachments),
+  entities = COALESCE($2, entities),
+  images = COALESCE($3::text [], images),
+  attachments = COALESCE($4::text [], attachments),
   visibility = COALESCE(
-    $4::post_visibility,
+    $5::post_visibility,
     visibility
   ),
-  pinned = COALESCE($5::boolean, pinned),
+  pinned = COALESCE($6::boolean, pinned),
+  edit_count = edit_count + CASE
+    WHEN $7::boolean THEN 1
+    ELSE 0
+  END,
+  status = COALESCE($8::post_status, status),
+  publish_at = CASE
+    WHEN $9::boolean THEN $10::timestamptz
+    ELSE publish_at
+  END,
+  -- a draft takes its place in feeds when it is published
+  created_at = CASE
+    WHEN status <> 'NORMAL'::post_status
+    AND $8::post_status = 'NORMAL'::post_status THEN now()
+    ELSE created_at
+  END,
   updated_at = now()
-WHERE uid = $6
-  AND author = $7
-  AND status = 'NORMAL'::post_status
-RETURNING id
+WHERE uid = $11
+  AND author = $12
+  AND status IN (
+    'NORMAL'::post_status,
+    'DRAFT'::post_status,
+    'SCHEDULED'::post_status
+  )
+  AND repost_of_uid IS NULL
+RETURNING id,
+  status,
+  entities
 `
 
 type UpdatePostByUidAndAuthorParams struct {
-	Text        sql.NullString
-	Images      []string
-	Attachments []string
-	Visibility  NullPostVisibility
-	Pinned      sql.NullBool
-	Uid         uuid.UUID
-	Author      uuid.UUID
+	Text            sql.NullString
+	Entities        sql.NullString
+	Images          []string
+	Attachments     []string
+	Visibility      NullPostVisibility
+	Pinned          sql.NullBool
+	Edited          bool
+	Status          NullPostStatus
+	UpdatePublishAt bool
+	PublishAt       sql.NullTime
+	Uid             uuid.UUID
+	Author          uuid.UUID
 }
 
-func (q *Queries) UpdatePostByUidAndAuthor(ctx context.Context, arg UpdatePostByUidAndAuthorParams) (int32, error) {
+type UpdatePostByUidAndAuthorRow struct {
+	ID       int32
+	Status   PostStatus
+	Entities string
+}
+
+func (q *Queries) UpdatePostByUidAndAuthor(ctx context.Context, arg UpdatePostByUidAndAuthorParams) (UpdatePostByUidAndAuthorRow, error) {
 	row := q.db.QueryRowContext(ctx, updatePostByUidAndAuthor,
 		arg.Text,
+		arg.Entities,
 		pq.Array(arg.Images),
 		pq.Array(arg.Attachments),
 		arg.Visibility,
 		arg.Pinned,
+		arg.Edited,
+		arg.Status,
+		arg.UpdatePublishAt,
+		arg.PublishAt,
 		arg.Uid,
 		arg.Author,
 	)
-	var id int32
-	err := row.Scan(&id)
-	return id, err
+	var i UpdatePostByUidAndAuthorRow
+	err := row.Scan(&i.ID, &i.Status, &i.Entities)
+	return i, err
 }
 
 const upsertPostTags = `-- name: UpsertPostTags :exec
 WITH input AS (
   SELECT DISTINCT unnest($2::text []) AS name
 ),
+resolved AS (
+  SELECT i.name,
+    a.tag_id
+  FROM input i
+    LEFT JOIN tag_aliases a ON a.name = i.name
+),
 upsert AS (
   INSERT INTO tags(name)
   SELECT name
-  FROM input ON CONFLICT (name) DO
+  FROM resolved
+  WHERE tag_id IS NULL ON CONFLICT (name) DO
   UPDATE
   SET name = EXCLUDED.name
   RETURNING id
@@ -517,6 +1607,10 @@ upsert AS (
 new_ids AS (
   SELECT id AS tag_id
   FROM upsert
+  UNION
+  SELECT tag_id
+  FROM resolved
+  WHERE tag_id IS NOT NULL
 ),
 del AS (
   DELETE FROM post_tags pt
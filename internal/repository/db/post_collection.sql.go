@@ -57,11 +57,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -70,6 +73,15 @@ SELECT p.uid,
   p.created_at,
   p.updated_at,
   true AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = $1
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
   COALESCE(
     (
@@ -92,8 +104,27 @@ FROM post_collections c
 WHERE p.status = 'NORMAL'::post_status
   AND c.user_uid = $1
   AND (
-    p.visibility = 'PUBLIC'::post_visibility
-    OR p.author = $1
+    p.author = $1
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility
+      )
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = $1
+          AND vf.followee_uid = p.author
+      )
+    )
   )
   AND (
     (
@@ -123,11 +154,14 @@ type ListPostsByCollectorRow struct {
 	AuthorNickname  string
 	AuthorAvatarUrl string
 	Text            string
+	Entities        string
 	Images          []string
 	Attachments     []string
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn time.Time
@@ -136,6 +170,9 @@ type ListPostsByCollectorRow struct {
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	Collected       bool
+	Reposted        bool
+	RepostOfUid     string
+	QuotedPostUid   string
 	Liked           bool
 	TagNames        []string
 }
@@ -156,11 +193,14 @@ func (q *Queries) ListPostsByCollector(ctx context.Context, arg ListPostsByColle
 			&i.AuthorNickname,
 			&i.AuthorAvatarUrl,
 			&i.Text,
+			&i.Entities,
 			pq.Array(&i.Images),
 			pq.Array(&i.Attachments),
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.RepostCount,
+			&i.EditCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
@@ -169,6 +209,9 @@ func (q *Queries) ListPostsByCollector(ctx context.Context, arg ListPostsByColle
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Collected,
+			&i.Reposted,
+			&i.RepostOfUid,
+			&i.QuotedPostUid,
 			&i.Liked,
 			pq.Array(&i.TagNames),
 		); err != nil {
@@ -57,11 +57,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -69,8 +72,17 @@ SELECT p.uid,
   p.status,
   p.created_at,
   p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   true AS liked,
   (c.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = $1
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
   COALESCE(
     (
       SELECT array_agg(
@@ -92,8 +104,27 @@ FROM post_likes l
 WHERE p.status = 'NORMAL'::post_status
   AND l.user_uid = $1
   AND (
-    p.visibility = 'PUBLIC'::post_visibility
-    OR p.author = $1
+    p.author = $1
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility
+      )
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = $1
+          AND vf.followee_uid = p.author
+      )
+    )
   )
   AND (
     (
@@ -123,11 +154,14 @@ type ListPostsByLikerRow struct {
 	AuthorNickname  string
 	AuthorAvatarUrl string
 	Text            string
+	Entities        string
 	Images          []string
 	Attachments     []string
 	CommentCount    int32
 	CollectionCount int32
 	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
 	Pinned          bool
 	Visibility      PostVisibility
 	LatestRepliedOn time.Time
@@ -135,8 +169,11 @@ type ListPostsByLikerRow struct {
 	Status          PostStatus
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	RepostOfUid     string
+	QuotedPostUid   string
 	Liked           bool
 	Collected       bool
+	Reposted        bool
 	TagNames        []string
 }
 
@@ -156,11 +193,14 @@ func (q *Queries) ListPostsByLiker(ctx context.Context, arg ListPostsByLikerPara
 			&i.AuthorNickname,
 			&i.AuthorAvatarUrl,
 			&i.Text,
+			&i.Entities,
 			pq.Array(&i.Images),
 			pq.Array(&i.Attachments),
 			&i.CommentCount,
 			&i.CollectionCount,
 			&i.LikeCount,
+			&i.RepostCount,
+			&i.EditCount,
 			&i.Pinned,
 			&i.Visibility,
 			&i.LatestRepliedOn,
@@ -168,8 +208,11 @@ func (q *Queries) ListPostsByLiker(ctx context.Context, arg ListPostsByLikerPara
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RepostOfUid,
+			&i.QuotedPostUid,
 			&i.Liked,
 			&i.Collected,
+			&i.Reposted,
 			pq.Array(&i.TagNames),
 		); err != nil {
 			return nil, err
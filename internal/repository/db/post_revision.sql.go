@@ -0,0 +1,156 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: post_revision.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const createPostRevision = `-- name: CreatePostRevision :one
+INSERT INTO post_revisions (
+    post_uid,
+    revision,
+    text,
+    entities,
+    images,
+    attachments,
+    tags,
+    visibility,
+    published_at
+  )
+SELECT p.uid,
+  p.edit_count,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tags,
+  p.visibility,
+  COALESCE(
+    (
+      SELECT max(pr.created_at)
+      FROM post_revisions pr
+      WHERE pr.post_uid = p.uid
+    ),
+    p.created_at
+  )
+FROM posts p
+WHERE p.uid = $1
+  AND p.author = $2
+  AND p.status = 'NORMAL'::post_status
+  AND p.repost_of_uid IS NULL
+FOR UPDATE OF p
+RETURNING revision
+`
+
+type CreatePostRevisionParams struct {
+	Uid    uuid.UUID
+	Author uuid.UUID
+}
+
+func (q *Queries) CreatePostRevision(ctx context.Context, arg CreatePostRevisionParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, createPostRevision, arg.Uid, arg.Author)
+	var revision int32
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const listPostRevisions = `-- name: ListPostRevisions :many
+SELECT r.uid,
+  r.revision,
+  r.text,
+  r.entities,
+  r.images,
+  r.attachments,
+  r.tags,
+  r.visibility,
+  r.published_at,
+  r.created_at
+FROM post_revisions r
+WHERE r.post_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (r.created_at, r.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY r.created_at DESC,
+  r.uid DESC
+LIMIT 20
+`
+
+type ListPostRevisionsParams struct {
+	PostUid         uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListPostRevisionsRow struct {
+	Uid         uuid.UUID
+	Revision    int32
+	Text        string
+	Entities    string
+	Images      []string
+	Attachments []string
+	Tags        []string
+	Visibility  PostVisibility
+	PublishedAt time.Time
+	CreatedAt   time.Time
+}
+
+func (q *Queries) ListPostRevisions(ctx context.Context, arg ListPostRevisionsParams) ([]ListPostRevisionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPostRevisions, arg.PostUid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostRevisionsRow
+	for rows.Next() {
+		var i ListPostRevisionsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Revision,
+			&i.Text,
+			&i.Entities,
+			pq.Array(&i.Images),
+			pq.Array(&i.Attachments),
+			pq.Array(&i.Tags),
+			&i.Visibility,
+			&i.PublishedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
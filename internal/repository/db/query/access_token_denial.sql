@@ -0,0 +1,21 @@
+-- name: DenyAccessTokens :one
+INSERT INTO access_token_denials (kind, value, expires_at)
+VALUES (@kind, @value, @expires_at) ON CONFLICT (kind, value) DO
+UPDATE
+SET denied_at = now(),
+  expires_at = GREATEST(
+    access_token_denials.expires_at,
+    EXCLUDED.expires_at
+  )
+RETURNING denied_at;
+-- name: ListAccessTokenDenials :many
+SELECT kind,
+  value,
+  denied_at,
+  expires_at
+FROM access_token_denials
+WHERE denied_at > @since
+  AND expires_at > now();
+-- name: DeleteExpiredAccessTokenDenials :exec
+DELETE FROM access_token_denials
+WHERE expires_at <= now();
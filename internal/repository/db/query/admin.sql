@@ -0,0 +1,179 @@
+-- name: GetUserRoleAndStatus :one
+SELECT uid,
+  role,
+  status
+FROM users
+WHERE uid = @uid;
+-- name: SetUserStatus :execrows
+UPDATE users
+SET status = @status,
+  updated_at = now()
+WHERE uid = @uid
+  AND status <> @status;
+-- name: SetUserRole :execrows
+UPDATE users
+SET role = @role,
+  updated_at = now()
+WHERE uid = @uid
+  AND role <> @role;
+-- name: SetPostStatus :one
+WITH changed AS (
+  UPDATE posts cp
+  SET status = @status,
+    updated_at = now()
+  WHERE cp.uid = @uid
+    AND cp.status <> @status
+  RETURNING cp.repost_of_uid,
+    cp.status
+),
+reposts AS (
+  UPDATE posts o
+  SET repost_count = GREATEST(
+      o.repost_count + CASE
+        WHEN c.status = 'NORMAL'::post_status THEN 1
+        ELSE -1
+      END,
+      0
+    )
+  FROM changed c
+  WHERE o.uid = c.repost_of_uid
+  RETURNING 1
+)
+SELECT count(*)
+FROM changed;
+-- name: GetCommentMetaForAdmin :one
+SELECT post_uid,
+  author_uid,
+  root_uid,
+  status
+FROM post_comments
+WHERE uid = @uid
+LIMIT 1;
+-- name: SetCommentStatus :execrows
+UPDATE post_comments
+SET status = @status,
+  updated_at = now()
+WHERE uid = @uid
+  AND status <> @status;
+-- name: CreateAdminAuditLog :exec
+INSERT INTO admin_audit_logs (
+    actor_uid,
+    action,
+    target_type,
+    target_uid,
+    reason,
+    detail
+  )
+VALUES (
+    @actor_uid,
+    @action,
+    @target_type,
+    @target_uid,
+    @reason,
+    @detail
+  );
+-- name: ListAdminAuditLogs :many
+SELECT l.uid,
+  l.actor_uid,
+  u.nickname AS actor_nickname,
+  l.action,
+  l.target_type,
+  l.target_uid,
+  l.reason,
+  l.detail,
+  l.created_at
+FROM admin_audit_logs l
+  LEFT JOIN users u ON u.uid = l.actor_uid
+WHERE (
+    sqlc.narg(target_uid)::uuid IS NULL
+    OR l.target_uid = sqlc.narg(target_uid)::uuid
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (l.created_at, l.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY l.created_at DESC,
+  l.uid DESC
+LIMIT 20;
+-- name: ListArchivedUsers :many
+SELECT uid,
+  username,
+  role,
+  email,
+  nickname,
+  avatar_url,
+  followers_count,
+  following_count,
+  updated_at
+FROM users
+WHERE status = 'ARCHIVED'::user_status
+  AND (
+    (
+      sqlc.narg(cursor_archived_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (updated_at, uid) < (
+      sqlc.narg(cursor_archived_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY updated_at DESC,
+  uid DESC
+LIMIT 20;
+-- name: ListArchivedPosts :many
+SELECT p.uid,
+  p.author,
+  u.nickname AS author_nickname,
+  p.text,
+  p.images,
+  p.visibility,
+  p.created_at,
+  p.updated_at
+FROM posts p
+  LEFT JOIN users u ON u.uid = p.author
+WHERE p.status = 'ARCHIVED'::post_status
+  AND (
+    (
+      sqlc.narg(cursor_archived_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (p.updated_at, p.uid) < (
+      sqlc.narg(cursor_archived_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY p.updated_at DESC,
+  p.uid DESC
+LIMIT 20;
+-- name: ListArchivedComments :many
+SELECT c.uid,
+  c.post_uid,
+  c.author_uid,
+  u.nickname AS author_nickname,
+  c.root_uid,
+  c.content,
+  c.images,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  LEFT JOIN users u ON u.uid = c.author_uid
+WHERE c.status = 'ARCHIVED'::comment_status
+  AND (
+    (
+      sqlc.narg(cursor_archived_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (c.updated_at, c.uid) < (
+      sqlc.narg(cursor_archived_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY c.updated_at DESC,
+  c.uid DESC
+LIMIT 20;
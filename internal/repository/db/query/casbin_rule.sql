@@ -0,0 +1,10 @@
+-- name: ListCasbinRules :many
+SELECT ptype,
+  v0,
+  v1,
+  v2,
+  v3,
+  v4,
+  v5
+FROM casbin_rules
+ORDER BY id;
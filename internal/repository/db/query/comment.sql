@@ -7,6 +7,7 @@ INSERT INTO post_comments (
     parent_uid,
     reply_to_author_uid,
     content,
+    entities,
     images,
     ip
   )
@@ -18,6 +19,7 @@ VALUES (
     @parent_uid,
     @reply_to_author_uid,
     @content,
+    @entities,
     @images,
     @ip
   )
@@ -93,6 +95,7 @@ SELECT c.uid,
   c.parent_uid,
   c.reply_to_author_uid,
   c.content,
+  c.entities,
   c.images,
   c.reply_count,
   c.like_count,
@@ -107,6 +110,18 @@ FROM post_comments c
 WHERE c.status = 'NORMAL'::comment_status
   AND c.post_uid = @post_uid
   AND c.parent_uid IS NULL
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = sqlc.narg(viewer)::uuid
+      AND ub.blocked_uid = c.author_uid
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_mutes um
+    WHERE um.muter_uid = sqlc.narg(viewer)::uuid
+      AND um.muted_uid = c.author_uid
+  )
   AND (
     (
       sqlc.narg(cursor_created_at)::timestamptz IS NULL
@@ -130,6 +145,7 @@ SELECT c.uid,
   c.parent_uid,
   c.reply_to_author_uid,
   c.content,
+  c.entities,
   c.images,
   c.reply_count,
   c.like_count,
@@ -145,6 +161,18 @@ FROM post_comments c
 WHERE c.status = 'NORMAL'::comment_status
   AND c.root_uid = @root_uid
   AND c.root_uid <> c.uid
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = sqlc.narg(viewer)::uuid
+      AND ub.blocked_uid = c.author_uid
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_mutes um
+    WHERE um.muter_uid = sqlc.narg(viewer)::uuid
+      AND um.muted_uid = c.author_uid
+  )
 ORDER BY c.created_at ASC,
   c.uid ASC
 LIMIT 10 OFFSET (sqlc.arg(page)::int - 1) * 10;
@@ -123,3 +123,93 @@ SELECT EXISTS(
   WHERE follower_uid = @follower_uid
     AND followee_uid = @followee_uid
 ) AS is_following;
+
+-- name: GetFollowCounts :one
+SELECT (
+    SELECT following_count
+    FROM users
+    WHERE users.uid = @follower_uid
+  )::int4 AS following_count,
+  (
+    SELECT followers_count
+    FROM users
+    WHERE users.uid = @followee_uid
+  )::int4 AS followers_count;
+
+-- name: IsPrivateUser :one
+SELECT is_private
+FROM users
+WHERE uid = @uid
+  AND status = 'NORMAL'::user_status;
+
+-- name: CreateFollowRequest :execrows
+INSERT INTO follow_requests (requester_uid, target_uid)
+SELECT @requester_uid,
+  @target_uid
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM user_follows
+    WHERE follower_uid = @requester_uid
+      AND followee_uid = @target_uid
+  ) ON CONFLICT DO NOTHING;
+
+-- name: DeleteFollowRequest :execrows
+DELETE FROM follow_requests
+WHERE requester_uid = @requester_uid
+  AND target_uid = @target_uid;
+
+-- name: ListIncomingFollowRequests :many
+SELECT fr.created_at AS requested_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  u.status,
+  u.created_at
+FROM follow_requests fr
+  JOIN users u ON u.uid = fr.requester_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE fr.target_uid = @uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (fr.created_at, fr.requester_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY fr.created_at DESC,
+  fr.requester_uid DESC
+LIMIT 20;
+
+-- name: ListOutgoingFollowRequests :many
+SELECT fr.created_at AS requested_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  u.status,
+  u.created_at
+FROM follow_requests fr
+  JOIN users u ON u.uid = fr.target_uid
+  AND u.status = 'NORMAL'::user_status
+WHERE fr.requester_uid = @uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (fr.created_at, fr.target_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY fr.created_at DESC,
+  fr.target_uid DESC
+LIMIT 20;
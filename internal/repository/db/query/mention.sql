@@ -0,0 +1,40 @@
+-- name: GetMentionableUsers :many
+SELECT u.uid,
+  u.username
+FROM users u
+WHERE u.username = ANY(@usernames::text [])
+  AND u.status = 'NORMAL'::user_status
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = u.uid
+      AND ub.blocked_uid = @author_uid
+  );
+-- name: SyncMentions :many
+WITH input AS (
+  SELECT DISTINCT unnest(@mentioned_uids::uuid []) AS mentioned_uid
+),
+del AS (
+  DELETE FROM mentions m
+  WHERE m.source_type = @source_type
+    AND m.source_uid = @source_uid
+    AND NOT EXISTS (
+      SELECT 1
+      FROM input i
+      WHERE i.mentioned_uid = m.mentioned_uid
+    )
+)
+INSERT INTO mentions (
+    source_type,
+    source_uid,
+    mentioned_uid,
+    author_uid,
+    post_uid
+  )
+SELECT @source_type,
+  @source_uid,
+  mentioned_uid,
+  @author_uid,
+  @post_uid
+FROM input ON CONFLICT DO NOTHING
+RETURNING mentioned_uid;
@@ -0,0 +1,339 @@
+-- name: GetMessagingTarget :one
+SELECT u.status,
+  u.message_permission,
+  EXISTS (
+    SELECT 1
+    FROM user_follows uf
+    WHERE uf.follower_uid = u.uid
+      AND uf.followee_uid = @sender_uid
+  )::boolean AS follows_sender,
+  EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = u.uid
+      AND ub.blocked_uid = @sender_uid
+  )::boolean AS blocked_sender
+FROM users u
+WHERE u.uid = @uid;
+-- name: GetMessagePermission :one
+SELECT message_permission
+FROM users
+WHERE uid = @uid;
+-- name: SetMessagePermission :exec
+UPDATE users
+SET message_permission = @message_permission,
+  updated_at = now()
+WHERE uid = @uid;
+-- name: UpsertDirectConversation :one
+INSERT INTO conversations (type, direct_key)
+VALUES ('DIRECT'::conversation_type, @direct_key::text) ON CONFLICT (direct_key) DO
+UPDATE
+SET direct_key = EXCLUDED.direct_key
+RETURNING uid,
+  type;
+-- name: AddConversationMember :execrows
+INSERT INTO conversation_members (conversation_uid, user_uid, role)
+VALUES (@conversation_uid, @user_uid, @role) ON CONFLICT DO NOTHING;
+-- name: GetConversationMembership :one
+SELECT c.type,
+  m.role
+FROM conversations c
+  JOIN conversation_members m ON m.conversation_uid = c.uid
+WHERE c.uid = @conversation_uid
+  AND m.user_uid = @user_uid;
+-- name: GetDirectPeer :one
+SELECT user_uid
+FROM conversation_members
+WHERE conversation_uid = @conversation_uid
+  AND user_uid <> @user_uid
+LIMIT 1;
+-- name: ListConversationMemberUids :many
+SELECT user_uid
+FROM conversation_members
+WHERE conversation_uid = @conversation_uid;
+-- name: CreateMessage :one
+WITH inserted AS (
+  INSERT INTO messages (conversation_uid, sender_uid, content, images)
+  VALUES (@conversation_uid, @sender_uid, @content, @images)
+  RETURNING uid,
+    sender_uid,
+    created_at
+)
+SELECT i.uid,
+  i.created_at,
+  u.nickname AS sender_nickname,
+  u.avatar_url AS sender_avatar_url
+FROM inserted i
+  JOIN users u ON u.uid = i.sender_uid;
+-- name: CreateSystemMessage :one
+WITH inserted AS (
+  INSERT INTO messages (
+      conversation_uid,
+      sender_uid,
+      type,
+      system_action,
+      target_uid,
+      content
+    )
+  VALUES (
+      @conversation_uid,
+      @sender_uid,
+      'SYSTEM'::message_type,
+      @system_action::system_message_action,
+      sqlc.narg(target_uid)::uuid,
+      @content
+    )
+  RETURNING uid,
+    sender_uid,
+    created_at
+)
+SELECT i.uid,
+  i.created_at,
+  u.nickname AS sender_nickname,
+  u.avatar_url AS sender_avatar_url
+FROM inserted i
+  JOIN users u ON u.uid = i.sender_uid;
+-- name: TouchConversation :exec
+UPDATE conversations
+SET last_message_uid = @last_message_uid,
+  last_message_at = @last_message_at,
+  updated_at = now()
+WHERE uid = @uid;
+-- name: IncrementUnreadMessages :many
+UPDATE conversation_members
+SET unread_count = unread_count + 1
+WHERE conversation_uid = @conversation_uid
+  AND user_uid <> @sender_uid
+RETURNING user_uid;
+-- name: ListConversations :many
+SELECT c.uid,
+  c.type,
+  c.name,
+  c.avatar_url,
+  (
+    SELECT count(*)
+    FROM conversation_members cm
+    WHERE cm.conversation_uid = c.uid
+  )::integer AS member_count,
+  c.last_message_at,
+  c.created_at,
+  m.role,
+  m.unread_count,
+  m.last_read_message_uid,
+  m.last_read_at,
+  -- the peer is only joined for DIRECT conversations
+  COALESCE(peer.user_uid, '00000000-0000-0000-0000-000000000000'::uuid)::uuid AS peer_uid,
+  COALESCE(peer.nickname, '')::text AS peer_nickname,
+  COALESCE(peer.avatar_url, '')::text AS peer_avatar_url,
+  peer.last_read_message_uid AS peer_last_read_message_uid,
+  peer.last_read_at AS peer_last_read_at,
+  lm.uid AS last_message_uid,
+  lm.sender_uid AS last_message_sender_uid,
+  lm.content AS last_message_content,
+  lm.images AS last_message_images,
+  lm.type AS last_message_type,
+  lm.system_action AS last_message_system_action,
+  lm.target_uid AS last_message_target_uid,
+  lm.created_at AS last_message_created_at
+FROM conversation_members m
+  JOIN conversations c ON c.uid = m.conversation_uid
+  LEFT JOIN LATERAL (
+    SELECT pm.user_uid,
+      pu.nickname,
+      pu.avatar_url,
+      pm.last_read_message_uid,
+      pm.last_read_at
+    FROM conversation_members pm
+      JOIN users pu ON pu.uid = pm.user_uid
+    WHERE pm.conversation_uid = c.uid
+      AND pm.user_uid <> m.user_uid
+    LIMIT 1
+  ) peer ON c.type = 'DIRECT'::conversation_type
+  LEFT JOIN messages lm ON lm.uid = c.last_message_uid
+WHERE m.user_uid = @user_uid
+  AND (
+    (
+      sqlc.narg(cursor_updated_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (c.last_message_at, c.uid) < (
+      sqlc.narg(cursor_updated_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY c.last_message_at DESC,
+  c.uid DESC
+LIMIT 20;
+-- name: GetConversation :one
+SELECT c.uid,
+  c.type,
+  c.name,
+  c.avatar_url,
+  (
+    SELECT count(*)
+    FROM conversation_members cm
+    WHERE cm.conversation_uid = c.uid
+  )::integer AS member_count,
+  c.last_message_at,
+  c.created_at,
+  m.role,
+  m.unread_count,
+  m.last_read_message_uid,
+  m.last_read_at,
+  -- the peer is only joined for DIRECT conversations
+  COALESCE(peer.user_uid, '00000000-0000-0000-0000-000000000000'::uuid)::uuid AS peer_uid,
+  COALESCE(peer.nickname, '')::text AS peer_nickname,
+  COALESCE(peer.avatar_url, '')::text AS peer_avatar_url,
+  peer.last_read_message_uid AS peer_last_read_message_uid,
+  peer.last_read_at AS peer_last_read_at,
+  lm.uid AS last_message_uid,
+  lm.sender_uid AS last_message_sender_uid,
+  lm.content AS last_message_content,
+  lm.images AS last_message_images,
+  lm.type AS last_message_type,
+  lm.system_action AS last_message_system_action,
+  lm.target_uid AS last_message_target_uid,
+  lm.created_at AS last_message_created_at
+FROM conversation_members m
+  JOIN conversations c ON c.uid = m.conversation_uid
+  LEFT JOIN LATERAL (
+    SELECT pm.user_uid,
+      pu.nickname,
+      pu.avatar_url,
+      pm.last_read_message_uid,
+      pm.last_read_at
+    FROM conversation_members pm
+      JOIN users pu ON pu.uid = pm.user_uid
+    WHERE pm.conversation_uid = c.uid
+      AND pm.user_uid <> m.user_uid
+    LIMIT 1
+  ) peer ON c.type = 'DIRECT'::conversation_type
+  LEFT JOIN messages lm ON lm.uid = c.last_message_uid
+WHERE m.user_uid = @user_uid
+  AND c.uid = @conversation_uid;
+-- name: ListMessages :many
+SELECT msg.uid,
+  msg.conversation_uid,
+  msg.sender_uid,
+  u.nickname AS sender_nickname,
+  u.avatar_url AS sender_avatar_url,
+  msg.content,
+  msg.images,
+  msg.type,
+  msg.system_action,
+  msg.target_uid,
+  msg.created_at
+FROM messages msg
+  JOIN users u ON u.uid = msg.sender_uid
+WHERE msg.conversation_uid = @conversation_uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (msg.created_at, msg.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY msg.created_at DESC,
+  msg.uid DESC
+LIMIT 20;
+-- name: GetReadTarget :one
+SELECT uid,
+  created_at
+FROM messages
+WHERE conversation_uid = @conversation_uid
+  AND (
+    sqlc.narg(message_uid)::uuid IS NULL
+    OR uid = sqlc.narg(message_uid)::uuid
+  )
+ORDER BY created_at DESC,
+  uid DESC
+LIMIT 1;
+-- name: MarkConversationRead :execrows
+UPDATE conversation_members m
+SET last_read_message_uid = @message_uid::uuid,
+  last_read_at = @message_created_at::timestamptz,
+  unread_count = (
+    SELECT count(*)
+    FROM messages msg
+    WHERE msg.conversation_uid = m.conversation_uid
+      AND msg.sender_uid <> m.user_uid
+      AND msg.type = 'TEXT'::message_type
+      AND (msg.created_at, msg.uid) > (
+        @message_created_at::timestamptz,
+        @message_uid::uuid
+      )
+  )
+WHERE m.conversation_uid = @conversation_uid
+  AND m.user_uid = @user_uid
+  AND (
+    m.last_read_at IS NULL
+    OR (m.last_read_at, m.last_read_message_uid) < (
+      @message_created_at::timestamptz,
+      @message_uid::uuid
+    )
+  );
+-- name: CountUnreadMessages :one
+SELECT COALESCE(sum(unread_count), 0)::integer AS unread_count
+FROM conversation_members
+WHERE user_uid = @user_uid;
+-- name: CreateGroupConversation :one
+INSERT INTO conversations (type, name, avatar_url)
+VALUES ('GROUP'::conversation_type, @name, @avatar_url)
+RETURNING uid;
+-- name: UpdateGroupConversation :exec
+UPDATE conversations
+SET name = @name,
+  avatar_url = @avatar_url,
+  updated_at = now()
+WHERE uid = @uid;
+-- name: LockConversation :one
+SELECT type,
+  name,
+  avatar_url
+FROM conversations
+WHERE uid = @uid FOR
+UPDATE;
+-- name: CountConversationMembers :one
+SELECT count(*)::integer AS member_count
+FROM conversation_members
+WHERE conversation_uid = @conversation_uid;
+-- name: GetConversationMemberRole :one
+SELECT role
+FROM conversation_members
+WHERE conversation_uid = @conversation_uid
+  AND user_uid = @user_uid;
+-- name: SetConversationMemberRole :execrows
+UPDATE conversation_members
+SET role = @role
+WHERE conversation_uid = @conversation_uid
+  AND user_uid = @user_uid
+  AND role <> @role;
+-- name: RemoveConversationMember :execrows
+DELETE FROM conversation_members
+WHERE conversation_uid = @conversation_uid
+  AND user_uid = @user_uid;
+-- name: ListConversationMembers :many
+SELECT m.user_uid,
+  u.nickname,
+  u.avatar_url,
+  m.role,
+  m.joined_at
+FROM conversation_members m
+  JOIN users u ON u.uid = m.user_uid
+WHERE m.conversation_uid = @conversation_uid
+  AND (
+    (
+      sqlc.narg(cursor_joined_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (m.joined_at, m.user_uid) > (
+      sqlc.narg(cursor_joined_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY m.joined_at,
+  m.user_uid
+LIMIT 20;
@@ -0,0 +1,109 @@
+-- name: CreateNotification :exec
+INSERT INTO notifications (
+    recipient_uid,
+    type,
+    target_uid,
+    post_uid,
+    actor_uid
+  )
+VALUES (
+    @recipient_uid,
+    @type,
+    @target_uid,
+    @post_uid,
+    @actor_uid
+  );
+-- name: UpsertNotificationGroup :one
+INSERT INTO notifications (
+    recipient_uid,
+    type,
+    target_uid,
+    post_uid,
+    actor_uid,
+    actor_count
+  )
+VALUES (
+    @recipient_uid,
+    @type,
+    @target_uid,
+    @post_uid,
+    @actor_uid,
+    0
+  ) ON CONFLICT (recipient_uid, type, target_uid)
+WHERE read_at IS NULL
+  AND type IN (
+    'POST_LIKE'::notification_type,
+    'COMMENT_LIKE'::notification_type,
+    'FOLLOW'::notification_type
+  ) DO
+UPDATE
+SET updated_at = notifications.updated_at
+RETURNING uid;
+-- name: AddNotificationActor :exec
+WITH inserted AS (
+  INSERT INTO notification_actors (notification_uid, actor_uid)
+  VALUES (@notification_uid, @actor_uid) ON CONFLICT DO NOTHING
+  RETURNING 1
+)
+UPDATE notifications
+SET actor_uid = @actor_uid,
+  actor_count = actor_count + 1,
+  updated_at = now()
+WHERE uid = @notification_uid
+  AND EXISTS (
+    SELECT 1
+    FROM inserted
+  );
+-- name: ListNotifications :many
+SELECT n.uid,
+  n.type,
+  n.target_uid,
+  n.post_uid,
+  n.actor_uid,
+  u.nickname AS actor_nickname,
+  u.avatar_url AS actor_avatar_url,
+  n.actor_count,
+  COALESCE(c.content, p.text, '')::text AS preview,
+  n.read_at,
+  n.created_at,
+  n.updated_at
+FROM notifications n
+  JOIN users u ON u.uid = n.actor_uid
+  LEFT JOIN posts p ON p.uid = n.post_uid
+  LEFT JOIN post_comments c ON c.uid = n.target_uid
+  AND n.type IN (
+    'COMMENT_LIKE'::notification_type,
+    'COMMENT'::notification_type,
+    'REPLY'::notification_type,
+    'MENTION'::notification_type
+  )
+WHERE n.recipient_uid = @recipient_uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (n.created_at, n.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY n.created_at DESC,
+  n.uid DESC
+LIMIT 20;
+-- name: CountUnreadNotifications :one
+SELECT count(*)::int
+FROM notifications
+WHERE recipient_uid = @recipient_uid
+  AND read_at IS NULL;
+-- name: MarkNotificationsRead :execrows
+UPDATE notifications
+SET read_at = now()
+WHERE recipient_uid = @recipient_uid
+  AND uid = ANY(@uids::uuid [])
+  AND read_at IS NULL;
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = now()
+WHERE recipient_uid = @recipient_uid
+  AND read_at IS NULL;
@@ -0,0 +1,75 @@
+-- name: CreatePoll :one
+INSERT INTO polls (post_uid, multiple, expires_at)
+VALUES (@post_uid, @multiple, @expires_at)
+RETURNING uid;
+-- name: CreatePollOptions :exec
+INSERT INTO poll_options (poll_uid, position, text)
+SELECT @poll_uid,
+  o.ordinality - 1,
+  o.text
+FROM unnest(@options::text []) WITH ORDINALITY AS o(text, ordinality);
+-- name: GetPollByPostUid :one
+SELECT pl.uid,
+  pl.multiple,
+  pl.expires_at,
+  (
+    SELECT count(*)
+    FROM poll_options o
+    WHERE o.poll_uid = pl.uid
+  )::integer AS option_count
+FROM polls pl
+WHERE pl.post_uid = @post_uid
+LIMIT 1;
+-- name: VotePoll :one
+WITH ballot AS (
+  INSERT INTO poll_votes (poll_uid, user_uid, positions)
+  SELECT pl.uid,
+    @user_uid,
+    @positions::integer []
+  FROM polls pl
+  WHERE pl.uid = @poll_uid
+    AND pl.expires_at > now() ON CONFLICT (poll_uid, user_uid) DO NOTHING
+  RETURNING poll_uid,
+    positions
+),
+counted AS (
+  UPDATE poll_options o
+  SET vote_count = o.vote_count + 1
+  FROM ballot b
+  WHERE o.poll_uid = b.poll_uid
+    AND o.position = ANY(b.positions)
+  RETURNING 1
+),
+voters AS (
+  UPDATE polls vp
+  SET voter_count = vp.voter_count + 1
+  FROM ballot b
+  WHERE vp.uid = b.poll_uid
+  RETURNING 1
+)
+SELECT count(*)
+FROM ballot;
+-- name: GetPollsByPostUids :many
+SELECT pl.post_uid,
+  pl.uid,
+  pl.multiple,
+  pl.expires_at,
+  pl.voter_count,
+  ARRAY(
+    SELECT o.text
+    FROM poll_options o
+    WHERE o.poll_uid = pl.uid
+    ORDER BY o.position
+  )::text [] AS option_texts,
+  ARRAY(
+    SELECT o.vote_count
+    FROM poll_options o
+    WHERE o.poll_uid = pl.uid
+    ORDER BY o.position
+  )::integer [] AS option_votes,
+  (v.user_uid IS NOT NULL)::boolean AS voted,
+  COALESCE(v.positions, '{}'::integer [])::integer [] AS my_positions
+FROM polls pl
+  LEFT JOIN poll_votes v ON v.poll_uid = pl.uid
+  AND v.user_uid = sqlc.narg(viewer)::uuid
+WHERE pl.post_uid = ANY(@post_uids::uuid []);
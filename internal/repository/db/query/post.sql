@@ -2,20 +2,28 @@
 INSERT INTO posts (
     author,
     text,
+    entities,
     images,
     attachments,
     visibility,
     pinned,
-    ip
+    ip,
+    quoted_post_uid,
+    status,
+    publish_at
   )
 VALUES (
     @author,
     @text,
+    @entities,
     COALESCE(@images::text [], '{}'::text []),
     COALESCE(@attachments::text [], '{}'::text []),
     @visibility,
     @pinned,
-    @ip
+    @ip,
+    sqlc.narg(quoted_post_uid)::uuid,
+    @status,
+    sqlc.narg(publish_at)::timestamptz
   )
 RETURNING id,
   uid;
@@ -23,10 +31,17 @@ RETURNING id,
 WITH input AS (
   SELECT DISTINCT unnest(@tags::text []) AS name
 ),
+resolved AS (
+  SELECT i.name,
+    a.tag_id
+  FROM input i
+    LEFT JOIN tag_aliases a ON a.name = i.name
+),
 upsert AS (
   INSERT INTO tags(name)
   SELECT name
-  FROM input ON CONFLICT (name) DO
+  FROM resolved
+  WHERE tag_id IS NULL ON CONFLICT (name) DO
   UPDATE
   SET name = EXCLUDED.name
   RETURNING id
@@ -34,6 +49,10 @@ upsert AS (
 new_ids AS (
   SELECT id AS tag_id
   FROM upsert
+  UNION
+  SELECT tag_id
+  FROM resolved
+  WHERE tag_id IS NOT NULL
 ),
 del AS (
   DELETE FROM post_tags pt
@@ -48,6 +67,37 @@ INSERT INTO post_tags (post_id, tag_id)
 SELECT @post_id,
   tag_id
 FROM new_ids ON CONFLICT (post_id, tag_id) DO NOTHING;
+-- name: ListInlinePostTags :many
+SELECT (e->>'text')::text AS name
+FROM posts p,
+  jsonb_array_elements(p.entities::jsonb) e
+WHERE p.uid = @uid
+  AND e->>'type' = 'HASHTAG';
+-- name: ListExplicitPostTags :many
+WITH inline AS (
+  SELECT e->>'text' AS name
+  FROM posts ip,
+    jsonb_array_elements(ip.entities::jsonb) e
+  WHERE ip.uid = @uid
+    AND e->>'type' = 'HASHTAG'
+)
+SELECT t.name
+FROM posts p
+  JOIN post_tags pt ON pt.post_id = p.id
+  JOIN tags t ON t.id = pt.tag_id
+WHERE p.uid = @uid
+  AND NOT EXISTS (
+    SELECT 1
+    FROM inline i
+    WHERE i.name = t.name
+      OR EXISTS (
+        SELECT 1
+        FROM tag_aliases a
+        WHERE a.tag_id = t.id
+          AND a.name = i.name
+      )
+  )
+ORDER BY t.name;
 -- name: GetPostByUid :one
 SELECT p.uid,
   p.author,
@@ -55,11 +105,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -67,8 +120,17 @@ SELECT p.uid,
   p.status,
   p.created_at,
   p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
   (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = sqlc.narg(viewer)::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
   COALESCE(
     (
       SELECT array_agg(
@@ -89,6 +151,36 @@ FROM posts p
   LEFT JOIN post_collections pc ON pc.post_uid = p.uid
   AND pc.user_uid = sqlc.narg(viewer)::uuid
 WHERE p.uid = @uid
+  AND (
+    p.status = 'NORMAL'::post_status
+    OR (
+      p.status IN ('DRAFT'::post_status, 'SCHEDULED'::post_status)
+      AND p.author = sqlc.narg(viewer)::uuid
+    )
+  )
+  AND (
+    p.author = sqlc.narg(viewer)::uuid
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility
+      )
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = sqlc.narg(viewer)::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
 LIMIT 1;
 -- name: ListPosts :many
 SELECT p.uid,
@@ -97,11 +189,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -109,8 +204,17 @@ SELECT p.uid,
   p.status,
   p.created_at,
   p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
   (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = sqlc.narg(viewer)::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
   COALESCE(
     (
       SELECT array_agg(
@@ -131,6 +235,39 @@ FROM posts p
   LEFT JOIN post_collections pc ON pc.post_uid = p.uid
   AND pc.user_uid = sqlc.narg(viewer)::uuid
 WHERE p.status = 'NORMAL'::post_status
+  AND (
+    (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND (
+        p.author = sqlc.narg(viewer)::uuid
+        OR EXISTS (
+          SELECT 1
+          FROM user_follows vf
+          WHERE vf.follower_uid = sqlc.narg(viewer)::uuid
+            AND vf.followee_uid = p.author
+        )
+      )
+    )
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = sqlc.narg(viewer)::uuid
+      AND ub.blocked_uid = p.author
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_mutes um
+    WHERE um.muter_uid = sqlc.narg(viewer)::uuid
+      AND um.muted_uid = p.author
+  )
   AND (
     (
       sqlc.narg(cursor_created_at)::timestamptz IS NULL
@@ -147,6 +284,7 @@ LIMIT 20;
 -- name: UpdatePostByUidAndAuthor :one
 UPDATE posts
 SET text = COALESCE(sqlc.narg(text), text),
+  entities = COALESCE(sqlc.narg(entities), entities),
   images = COALESCE(sqlc.narg(images)::text [], images),
   attachments = COALESCE(sqlc.narg(attachments)::text [], attachments),
   visibility = COALESCE(
@@ -154,18 +292,134 @@ SET text = COALESCE(sqlc.narg(text), text),
     visibility
   ),
   pinned = COALESCE(sqlc.narg(pinned)::boolean, pinned),
+  edit_count = edit_count + CASE
+    WHEN @edited::boolean THEN 1
+    ELSE 0
+  END,
+  status = COALESCE(sqlc.narg(status)::post_status, status),
+  publish_at = CASE
+    WHEN @update_publish_at::boolean THEN sqlc.narg(publish_at)::timestamptz
+    ELSE publish_at
+  END,
+  -- a draft takes its place in feeds when it is published
+  created_at = CASE
+    WHEN status <> 'NORMAL'::post_status
+    AND sqlc.narg(status)::post_status = 'NORMAL'::post_status THEN now()
+    ELSE created_at
+  END,
   updated_at = now()
 WHERE uid = @uid
   AND author = @author
-  AND status = 'NORMAL'::post_status
-RETURNING id;
--- name: ArchivePostByUidAndAuthor :execrows
-UPDATE posts
-SET status = 'ARCHIVED'::post_status,
+  AND status IN (
+    'NORMAL'::post_status,
+    'DRAFT'::post_status,
+    'SCHEDULED'::post_status
+  )
+  AND repost_of_uid IS NULL
+RETURNING id,
+  status,
+  entities;
+-- name: LockPostByUidAndAuthor :one
+SELECT status
+FROM posts
+WHERE uid = @uid
+  AND author = @author
+  AND status IN (
+    'NORMAL'::post_status,
+    'DRAFT'::post_status,
+    'SCHEDULED'::post_status
+  )
+  AND repost_of_uid IS NULL
+LIMIT 1 FOR UPDATE;
+-- name: PublishDuePosts :many
+WITH due AS (
+  SELECT dp.id
+  FROM posts dp
+  WHERE dp.status = 'SCHEDULED'::post_status
+    AND dp.publish_at <= now()
+  ORDER BY dp.publish_at
+  LIMIT @batch_size FOR UPDATE SKIP LOCKED
+)
+UPDATE posts p
+SET status = 'NORMAL'::post_status,
+  publish_at = NULL,
+  created_at = now(),
   updated_at = now()
+FROM due
+WHERE p.id = due.id
+RETURNING p.uid,
+  p.author,
+  p.entities;
+-- name: ListDraftsByAuthor :many
+SELECT p.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.pinned,
+  p.visibility,
+  p.status,
+  p.publish_at,
+  p.created_at,
+  p.updated_at,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM posts p
+  JOIN users u ON u.uid = p.author
+WHERE p.author = @author
+  AND p.status IN ('DRAFT'::post_status, 'SCHEDULED'::post_status)
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20;
+-- name: ArchivePostByUidAndAuthor :one
+WITH archived AS (
+  UPDATE posts ap
+  SET status = 'ARCHIVED'::post_status,
+    updated_at = now()
+  WHERE ap.uid = @uid
+    AND ap.author = @author
+    AND ap.status = 'NORMAL'::post_status
+  RETURNING ap.repost_of_uid
+),
+unreposted AS (
+  UPDATE posts o
+  SET repost_count = GREATEST(o.repost_count - 1, 0)
+  FROM archived a
+  WHERE o.uid = a.repost_of_uid
+  RETURNING 1
+)
+SELECT count(*)
+FROM archived;
+-- name: DeleteDraftByUidAndAuthor :execrows
+-- drafts were never published, so nothing refers to them and they are removed outright
+DELETE FROM posts
 WHERE uid = @uid
   AND author = @author
-  AND status = 'NORMAL'::post_status;
+  AND status IN ('DRAFT'::post_status, 'SCHEDULED'::post_status);
 -- name: ListPostsByAuthor :many
 SELECT p.uid,
   p.author,
@@ -173,11 +427,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -185,8 +442,17 @@ SELECT p.uid,
   p.status,
   p.created_at,
   p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   (pl.user_uid IS NOT NULL)::boolean AS liked,
   (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = sqlc.narg(viewer)::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
   COALESCE(
     (
       SELECT array_agg(
@@ -208,6 +474,25 @@ FROM posts p
   AND pc.user_uid = sqlc.narg(viewer)::uuid
 WHERE p.status = 'NORMAL'::post_status
   AND p.author = @author
+  AND (
+    p.author = sqlc.narg(viewer)::uuid
+    OR (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = sqlc.narg(viewer)::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
   AND (
     (
       sqlc.narg(cursor_created_at)::timestamptz IS NULL
@@ -220,4 +505,354 @@ WHERE p.status = 'NORMAL'::post_status
   )
 ORDER BY p.created_at DESC,
   p.uid DESC
-LIMIT 20;
\ No newline at end of file
+LIMIT 20;
+-- name: ListHomeTimeline :many
+WITH candidates AS (
+  (
+    SELECT te.post_uid,
+      te.created_at
+    FROM timeline_entries te
+      JOIN posts tp ON tp.uid = te.post_uid
+      AND tp.status = 'NORMAL'::post_status
+      AND tp.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+    WHERE te.owner_uid = @viewer
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_blocks ub
+        WHERE ub.blocker_uid = @viewer
+          AND ub.blocked_uid = te.author_uid
+      )
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_mutes um
+        WHERE um.muter_uid = @viewer
+          AND um.muted_uid = te.author_uid
+      )
+      AND (
+        (
+          sqlc.narg(cursor_created_at)::timestamptz IS NULL
+          AND sqlc.narg(cursor_id)::uuid IS NULL
+        )
+        OR (te.created_at, te.post_uid) < (
+          sqlc.narg(cursor_created_at)::timestamptz,
+          sqlc.narg(cursor_id)::uuid
+        )
+      )
+    ORDER BY te.created_at DESC,
+      te.post_uid DESC
+    LIMIT 20
+  )
+  UNION
+  (
+    SELECT rp.uid AS post_uid,
+      rp.created_at
+    FROM posts rp
+    WHERE rp.status = 'NORMAL'::post_status
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_blocks ub
+        WHERE ub.blocker_uid = @viewer
+          AND ub.blocked_uid = rp.author
+      )
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_mutes um
+        WHERE um.muter_uid = @viewer
+          AND um.muted_uid = rp.author
+      )
+      AND (
+        rp.author = @viewer
+        OR (
+          rp.visibility IN (
+            'PUBLIC'::post_visibility,
+            'FOLLOWERS'::post_visibility
+          )
+          AND rp.author IN (
+            SELECT uf.followee_uid
+            FROM user_follows uf
+              JOIN users fu ON fu.uid = uf.followee_uid
+            WHERE uf.follower_uid = @viewer
+              AND fu.followers_count >= sqlc.arg(fanout_threshold)::int
+          )
+        )
+      )
+      AND (
+        (
+          sqlc.narg(cursor_created_at)::timestamptz IS NULL
+          AND sqlc.narg(cursor_id)::uuid IS NULL
+        )
+        OR (rp.created_at, rp.uid) < (
+          sqlc.narg(cursor_created_at)::timestamptz,
+          sqlc.narg(cursor_id)::uuid
+        )
+      )
+    ORDER BY rp.created_at DESC,
+      rp.uid DESC
+    LIMIT 20
+  )
+  UNION
+  (
+    SELECT gp.uid AS post_uid,
+      gp.created_at
+    FROM posts gp
+      JOIN users gu ON gu.uid = gp.author
+    WHERE gp.status = 'NORMAL'::post_status
+      AND (
+        (
+          gp.visibility = 'PUBLIC'::post_visibility
+          AND NOT gu.is_private
+        )
+        OR (
+          gp.visibility IN (
+            'PUBLIC'::post_visibility,
+            'FOLLOWERS'::post_visibility
+          )
+          AND EXISTS (
+            SELECT 1
+            FROM user_follows vf
+            WHERE vf.follower_uid = @viewer
+              AND vf.followee_uid = gp.author
+          )
+        )
+      )
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_blocks ub
+        WHERE ub.blocker_uid = @viewer
+          AND ub.blocked_uid = gp.author
+      )
+      AND NOT EXISTS (
+        SELECT 1
+        FROM user_mutes um
+        WHERE um.muter_uid = @viewer
+          AND um.muted_uid = gp.author
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM post_tags gpt
+          JOIN tag_follows tf ON tf.tag_id = gpt.tag_id
+        WHERE gpt.post_id = gp.id
+          AND tf.user_uid = @viewer
+      )
+      AND (
+        (
+          sqlc.narg(cursor_created_at)::timestamptz IS NULL
+          AND sqlc.narg(cursor_id)::uuid IS NULL
+        )
+        OR (gp.created_at, gp.uid) < (
+          sqlc.narg(cursor_created_at)::timestamptz,
+          sqlc.narg(cursor_id)::uuid
+        )
+      )
+    ORDER BY gp.created_at DESC,
+      gp.uid DESC
+    LIMIT 20
+  )
+)
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.repost_count,
+  p.edit_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.status,
+  p.created_at,
+  p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = @viewer
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM candidates c
+  JOIN posts p ON p.uid = c.post_uid
+  JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = @viewer
+  LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = @viewer
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20;
+-- name: GetPostVisibilityByUid :one
+SELECT p.author,
+  p.visibility,
+  u.is_private AS author_is_private,
+  (p.repost_of_uid IS NOT NULL)::boolean AS is_repost
+FROM posts p
+  JOIN users u ON u.uid = p.author
+WHERE p.uid = @uid
+  AND p.status = 'NORMAL'::post_status
+  AND (
+    p.author = sqlc.narg(viewer)::uuid
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility
+      )
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = sqlc.narg(viewer)::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
+LIMIT 1;
+
+-- name: CreateRepost :one
+WITH inserted AS (
+  INSERT INTO posts (author, text, repost_of_uid)
+  VALUES (@author, '', @post_uid) ON CONFLICT (author, repost_of_uid)
+  WHERE repost_of_uid IS NOT NULL
+    AND status = 'NORMAL'::post_status DO NOTHING
+  RETURNING uid
+),
+updated AS (
+  UPDATE posts
+  SET repost_count = repost_count + 1,
+    updated_at = now()
+  WHERE uid = @post_uid
+    AND EXISTS (SELECT 1 FROM inserted)
+  RETURNING repost_count
+)
+SELECT COALESCE(
+    (SELECT uid FROM inserted),
+    '00000000-0000-0000-0000-000000000000'::uuid
+  )::uuid AS repost_uid,
+  COALESCE(
+    (SELECT repost_count FROM updated),
+    (SELECT repost_count FROM posts WHERE posts.uid = @post_uid)
+  )::int4 AS repost_count;
+-- name: RemoveRepost :one
+WITH archived AS (
+  UPDATE posts ap
+  SET status = 'ARCHIVED'::post_status,
+    updated_at = now()
+  WHERE ap.author = @author
+    AND ap.repost_of_uid = @post_uid::uuid
+    AND ap.status = 'NORMAL'::post_status
+  RETURNING 1
+),
+updated AS (
+  UPDATE posts
+  SET repost_count = GREATEST(repost_count - 1, 0),
+    updated_at = now()
+  WHERE uid = @post_uid
+    AND EXISTS (SELECT 1 FROM archived)
+  RETURNING repost_count
+)
+SELECT repost_count
+FROM updated
+UNION ALL
+SELECT repost_count
+FROM posts
+WHERE uid = @post_uid
+  AND NOT EXISTS (SELECT 1 FROM updated)
+LIMIT 1;
+-- name: GetEmbeddedPosts :many
+SELECT p.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.repost_count,
+  p.edit_count,
+  p.visibility,
+  p.created_at,
+  p.updated_at,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names,
+  (
+    p.status = 'NORMAL'::post_status
+    AND u.status = 'NORMAL'::user_status
+    AND (
+      p.author = sqlc.narg(viewer)::uuid
+      OR (
+        p.visibility IN (
+          'PUBLIC'::post_visibility,
+          'UNLISTED'::post_visibility
+        )
+        AND NOT u.is_private
+      )
+      OR (
+        p.visibility IN (
+          'PUBLIC'::post_visibility,
+          'UNLISTED'::post_visibility,
+          'FOLLOWERS'::post_visibility
+        )
+        AND EXISTS (
+          SELECT 1
+          FROM user_follows vf
+          WHERE vf.follower_uid = sqlc.narg(viewer)::uuid
+            AND vf.followee_uid = p.author
+        )
+      )
+    )
+  )::boolean AS visible
+FROM posts p
+  JOIN users u ON u.uid = p.author
+WHERE p.uid = ANY(@uids::uuid [])
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = sqlc.narg(viewer)::uuid
+      AND ub.blocked_uid = p.author
+  );
\ No newline at end of file
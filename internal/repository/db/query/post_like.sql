@@ -50,11 +50,14 @@ SELECT p.uid,
   u.nickname AS author_nickname,
   u.avatar_url AS author_avatar_url,
   p.text,
+  p.entities,
   p.images,
   p.attachments,
   p.comment_count,
   p.collection_count,
   p.like_count,
+  p.repost_count,
+  p.edit_count,
   p.pinned,
   p.visibility,
   p.latest_replied_on,
@@ -62,8 +65,17 @@ SELECT p.uid,
   p.status,
   p.created_at,
   p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
   true AS liked,
   (c.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = @liker
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
   COALESCE(
     (
       SELECT array_agg(
@@ -85,8 +97,27 @@ FROM post_likes l
 WHERE p.status = 'NORMAL'::post_status
   AND l.user_uid = @liker
   AND (
-    p.visibility = 'PUBLIC'::post_visibility
-    OR p.author = @liker
+    p.author = @liker
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility
+      )
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'UNLISTED'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = @liker
+          AND vf.followee_uid = p.author
+      )
+    )
   )
   AND (
     (
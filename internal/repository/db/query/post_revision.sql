@@ -0,0 +1,72 @@
+-- name: CreatePostRevision :one
+INSERT INTO post_revisions (
+    post_uid,
+    revision,
+    text,
+    entities,
+    images,
+    attachments,
+    tags,
+    visibility,
+    published_at
+  )
+SELECT p.uid,
+  p.edit_count,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tags,
+  p.visibility,
+  COALESCE(
+    (
+      SELECT max(pr.created_at)
+      FROM post_revisions pr
+      WHERE pr.post_uid = p.uid
+    ),
+    p.created_at
+  )
+FROM posts p
+WHERE p.uid = @uid
+  AND p.author = @author
+  AND p.status = 'NORMAL'::post_status
+  AND p.repost_of_uid IS NULL
+FOR UPDATE OF p
+RETURNING revision;
+-- name: ListPostRevisions :many
+SELECT r.uid,
+  r.revision,
+  r.text,
+  r.entities,
+  r.images,
+  r.attachments,
+  r.tags,
+  r.visibility,
+  r.published_at,
+  r.created_at
+FROM post_revisions r
+WHERE r.post_uid = @post_uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (r.created_at, r.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY r.created_at DESC,
+  r.uid DESC
+LIMIT 20;
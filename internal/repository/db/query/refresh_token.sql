@@ -1,12 +1,85 @@
 -- name: GetRefreshToken :one
 SELECT uid,
-  token
+  session_uid
 FROM refresh_tokens
-WHERE token = $1
-  AND expires_at > now();
--- name: UpsertRefreshToken :exec
-INSERT INTO refresh_tokens (uid, token, expires_at)
-VALUES ($1, $2, $3) ON CONFLICT (uid) DO
-UPDATE
-SET token = EXCLUDED.token,
-  expires_at = EXCLUDED.expires_at;
\ No newline at end of file
+WHERE token_hash = $1
+  AND expires_at > now() FOR
+UPDATE;
+-- name: GetRotatedRefreshToken :one
+SELECT t.uid,
+  t.session_uid,
+  t.device_id,
+  t.ip,
+  t.token_hash AS current_token_hash,
+  r.successor,
+  r.rotated_at
+FROM rotated_refresh_tokens r
+  JOIN refresh_tokens t ON t.session_uid = r.session_uid
+WHERE r.token_hash = $1 FOR
+UPDATE OF t;
+-- name: CreateSession :exec
+INSERT INTO refresh_tokens (
+    session_uid,
+    uid,
+    token_hash,
+    expires_at,
+    device_id,
+    user_agent,
+    ip
+  )
+VALUES (
+    @session_uid,
+    @uid,
+    @token_hash,
+    @expires_at,
+    @device_id,
+    @user_agent,
+    @ip
+  );
+-- name: DeleteDeviceSession :many
+DELETE FROM refresh_tokens
+WHERE uid = @uid
+  AND device_id = @device_id
+  AND device_id <> ''
+RETURNING session_uid;
+-- name: RotateSessionToken :exec
+WITH rotated AS (
+  INSERT INTO rotated_refresh_tokens (token_hash, session_uid, successor)
+  SELECT old.token_hash,
+    old.session_uid,
+    @successor::bytea
+  FROM refresh_tokens old
+  WHERE old.session_uid = @session_uid
+)
+UPDATE refresh_tokens
+SET token_hash = @token_hash,
+  expires_at = @expires_at,
+  user_agent = @user_agent,
+  ip = @ip,
+  last_used_at = now()
+WHERE refresh_tokens.session_uid = @session_uid;
+-- name: ListSessionsByUser :many
+SELECT session_uid,
+  device_id,
+  user_agent,
+  ip,
+  created_at,
+  last_used_at
+FROM refresh_tokens
+WHERE uid = $1
+  AND expires_at > now()
+ORDER BY last_used_at DESC,
+  id DESC;
+-- name: DeleteSession :execrows
+DELETE FROM refresh_tokens
+WHERE uid = @uid
+  AND session_uid = @session_uid;
+-- name: DeleteOtherSessions :many
+DELETE FROM refresh_tokens
+WHERE uid = @uid
+  AND session_uid <> @session_uid
+RETURNING session_uid;
+-- name: DeleteRefreshToken :many
+DELETE FROM refresh_tokens
+WHERE token_hash = $1
+RETURNING session_uid;
@@ -0,0 +1,69 @@
+-- name: AddBlock :execrows
+INSERT INTO user_blocks (blocker_uid, blocked_uid)
+VALUES (@blocker_uid, @blocked_uid) ON CONFLICT DO NOTHING;
+-- name: RemoveBlock :execrows
+DELETE FROM user_blocks
+WHERE blocker_uid = @blocker_uid
+  AND blocked_uid = @blocked_uid;
+-- name: IsBlockedByAny :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_blocks
+    WHERE blocker_uid = ANY(@blocker_uids::uuid [])
+      AND blocked_uid = @blocked_uid
+  )::boolean AS blocked;
+-- name: AddMute :execrows
+INSERT INTO user_mutes (muter_uid, muted_uid)
+VALUES (@muter_uid, @muted_uid) ON CONFLICT DO NOTHING;
+-- name: RemoveMute :execrows
+DELETE FROM user_mutes
+WHERE muter_uid = @muter_uid
+  AND muted_uid = @muted_uid;
+-- name: ListBlocks :many
+SELECT ub.created_at AS blocked_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count
+FROM user_blocks ub
+  JOIN users u ON u.uid = ub.blocked_uid
+WHERE ub.blocker_uid = @uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (ub.created_at, ub.blocked_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY ub.created_at DESC,
+  ub.blocked_uid DESC
+LIMIT 20;
+-- name: ListMutes :many
+SELECT um.created_at AS muted_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count
+FROM user_mutes um
+  JOIN users u ON u.uid = um.muted_uid
+WHERE um.muter_uid = @uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (um.created_at, um.muted_uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY um.created_at DESC,
+  um.muted_uid DESC
+LIMIT 20;
@@ -0,0 +1,118 @@
+-- name: ReportTargetExists :one
+SELECT CASE
+    @target_type::report_target_type
+    WHEN 'POST' THEN EXISTS (
+      SELECT 1
+      FROM posts p
+      WHERE p.uid = @target_uid::uuid
+        AND p.status = 'NORMAL'::post_status
+    )
+    WHEN 'COMMENT' THEN EXISTS (
+      SELECT 1
+      FROM post_comments c
+      WHERE c.uid = @target_uid::uuid
+        AND c.status = 'NORMAL'::comment_status
+    )
+    ELSE EXISTS (
+      SELECT 1
+      FROM users u
+      WHERE u.uid = @target_uid::uuid
+        AND u.status = 'NORMAL'::user_status
+    )
+  END::boolean AS found;
+-- name: CreateReport :one
+INSERT INTO reports (
+    reporter_uid,
+    target_type,
+    target_uid,
+    reason,
+    detail
+  )
+VALUES (
+    @reporter_uid,
+    @target_type,
+    @target_uid,
+    @reason,
+    @detail
+  ) ON CONFLICT (reporter_uid, target_type, target_uid)
+WHERE status IN ('OPEN'::report_status, 'CLAIMED'::report_status) DO
+UPDATE
+SET reason = EXCLUDED.reason,
+  detail = EXCLUDED.detail,
+  updated_at = now()
+RETURNING uid;
+-- name: GetReportByUid :one
+SELECT uid,
+  target_type,
+  target_uid,
+  status,
+  handler_uid
+FROM reports
+WHERE uid = @uid
+LIMIT 1;
+-- name: ClaimReport :execrows
+UPDATE reports
+SET status = 'CLAIMED'::report_status,
+  handler_uid = @handler_uid::uuid,
+  updated_at = now()
+WHERE uid = @uid
+  AND status = 'OPEN'::report_status;
+-- name: CloseReport :execrows
+UPDATE reports
+SET status = @status,
+  handler_uid = @handler_uid::uuid,
+  note = @note,
+  updated_at = now()
+WHERE uid = @uid
+  AND (
+    status = 'OPEN'::report_status
+    OR (
+      status = 'CLAIMED'::report_status
+      AND handler_uid = @handler_uid::uuid
+    )
+  );
+-- name: ResolvePendingReportsByTarget :execrows
+UPDATE reports
+SET status = 'RESOLVED'::report_status,
+  handler_uid = @handler_uid::uuid,
+  note = @note,
+  updated_at = now()
+WHERE target_type = @target_type
+  AND target_uid = @target_uid
+  AND status IN ('OPEN'::report_status, 'CLAIMED'::report_status);
+-- name: ListReports :many
+SELECT r.uid,
+  r.reporter_uid,
+  u.nickname AS reporter_nickname,
+  r.target_type,
+  r.target_uid,
+  r.reason,
+  r.detail,
+  r.status,
+  r.handler_uid,
+  r.note,
+  (
+    SELECT count(*)
+    FROM reports t
+    WHERE t.target_type = r.target_type
+      AND t.target_uid = r.target_uid
+      AND t.status IN ('OPEN'::report_status, 'CLAIMED'::report_status)
+  )::int AS pending_count,
+  r.created_at,
+  r.updated_at
+FROM reports r
+  LEFT JOIN users u ON u.uid = r.reporter_uid
+WHERE r.status = @status
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (r.created_at, r.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY r.created_at DESC,
+  r.uid DESC
+LIMIT 20;
@@ -0,0 +1,227 @@
+-- name: SearchPosts :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.repost_count,
+  p.edit_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.status,
+  p.created_at,
+  p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = sqlc.narg(viewer)::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM posts p
+  JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+  LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    p.author = sqlc.narg(viewer)::uuid
+    OR (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = sqlc.narg(viewer)::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
+  AND (
+    p.search_vector @@ websearch_to_tsquery('simple', @query::text)
+    OR p.text ILIKE '%' || @pattern::text || '%'
+  )
+  AND (
+    sqlc.narg(author_uid)::uuid IS NULL
+    OR p.author = sqlc.narg(author_uid)::uuid
+  )
+  AND (
+    sqlc.narg(tag)::text IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+        AND t.name = sqlc.narg(tag)::text
+    )
+  )
+  AND (
+    sqlc.narg(created_after)::timestamptz IS NULL
+    OR p.created_at >= sqlc.narg(created_after)::timestamptz
+  )
+  AND (
+    sqlc.narg(created_before)::timestamptz IS NULL
+    OR p.created_at < sqlc.narg(created_before)::timestamptz
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20;
+-- name: SearchComments :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.entities,
+  c.images,
+  c.reply_count,
+  c.like_count,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  JOIN posts p ON p.uid = c.post_uid
+  AND p.status = 'NORMAL'::post_status
+  JOIN users pu ON pu.uid = p.author
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = sqlc.narg(viewer)::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND (
+    p.author = sqlc.narg(viewer)::uuid
+    OR (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT pu.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = sqlc.narg(viewer)::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
+  AND (
+    c.search_vector @@ websearch_to_tsquery('simple', @query::text)
+    OR c.content ILIKE '%' || @pattern::text || '%'
+  )
+  AND (
+    sqlc.narg(author_uid)::uuid IS NULL
+    OR c.author_uid = sqlc.narg(author_uid)::uuid
+  )
+  AND (
+    sqlc.narg(tag)::text IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+        AND t.name = sqlc.narg(tag)::text
+    )
+  )
+  AND (
+    sqlc.narg(created_after)::timestamptz IS NULL
+    OR c.created_at >= sqlc.narg(created_after)::timestamptz
+  )
+  AND (
+    sqlc.narg(created_before)::timestamptz IS NULL
+    OR c.created_at < sqlc.narg(created_before)::timestamptz
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (c.created_at, c.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY c.created_at DESC,
+  c.uid DESC
+LIMIT 20;
+-- name: SearchUsers :many
+SELECT u.uid,
+  u.username,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (f.follower_uid IS NOT NULL)::boolean AS is_following,
+  u.created_at
+FROM users u
+  LEFT JOIN user_follows f ON f.followee_uid = u.uid
+  AND f.follower_uid = sqlc.narg(viewer)::uuid
+WHERE u.status = 'NORMAL'::user_status
+  AND (
+    u.search_vector @@ websearch_to_tsquery('simple', @query::text)
+    OR u.nickname ILIKE '%' || @pattern::text || '%'
+    OR u.username ILIKE '%' || @pattern::text || '%'
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (u.created_at, u.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY u.created_at DESC,
+  u.uid DESC
+LIMIT 20;
@@ -0,0 +1,221 @@
+-- name: GetTagByName :one
+SELECT t.id,
+  t.uid,
+  t.name,
+  (
+    SELECT count(*)
+    FROM post_tags pt
+      JOIN posts p ON p.id = pt.post_id
+    WHERE pt.tag_id = t.id
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+  )::integer AS post_count,
+  (
+    SELECT count(*)
+    FROM tag_follows tf
+    WHERE tf.tag_id = t.id
+  )::integer AS follower_count
+FROM tags t
+WHERE t.name = @name
+  OR t.id = (
+    SELECT a.tag_id
+    FROM tag_aliases a
+    WHERE a.name = @name
+  )
+LIMIT 1;
+-- name: ListPostsByTag :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.repost_count,
+  p.edit_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.status,
+  p.created_at,
+  p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = sqlc.narg(viewer)::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt2
+        JOIN tags t ON t.id = pt2.tag_id
+      WHERE pt2.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM post_tags pt
+  JOIN posts p ON p.id = pt.post_id
+  JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = sqlc.narg(viewer)::uuid
+  LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = sqlc.narg(viewer)::uuid
+WHERE pt.tag_id = @tag_id
+  AND p.status = 'NORMAL'::post_status
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = sqlc.narg(viewer)::uuid
+      AND ub.blocked_uid = p.author
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_mutes um
+    WHERE um.muter_uid = sqlc.narg(viewer)::uuid
+      AND um.muted_uid = p.author
+  )
+  AND (
+    p.author = sqlc.narg(viewer)::uuid
+    OR (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = sqlc.narg(viewer)::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20;
+-- name: AutocompleteTags :many
+SELECT t.uid,
+  t.name,
+  count(pt.post_id)::integer AS post_count
+FROM tags t
+  LEFT JOIN post_tags pt ON pt.tag_id = t.id
+WHERE lower(t.name) LIKE lower(@prefix::text) || '%'
+GROUP BY t.id
+ORDER BY post_count DESC,
+  t.name
+LIMIT 10;
+-- name: ListTrendingTags :many
+SELECT t.uid,
+  t.name,
+  count(*)::integer AS post_count
+FROM posts p
+  JOIN post_tags pt ON pt.post_id = p.id
+  JOIN tags t ON t.id = pt.tag_id
+WHERE p.status = 'NORMAL'::post_status
+  AND p.visibility = 'PUBLIC'::post_visibility
+  AND p.created_at >= @since::timestamptz
+GROUP BY t.id
+ORDER BY post_count DESC,
+  max(p.created_at) DESC,
+  t.name
+LIMIT 20;
+-- name: RenameTag :execrows
+UPDATE tags
+SET name = @name
+WHERE id = @id;
+-- name: MovePostTags :exec
+INSERT INTO post_tags (post_id, tag_id)
+SELECT src.post_id,
+  @target_id::integer
+FROM post_tags src
+WHERE src.tag_id = @source_id::integer ON CONFLICT DO NOTHING;
+-- name: MoveTagAliases :exec
+UPDATE tag_aliases
+SET tag_id = @target_id
+WHERE tag_id = @source_id;
+-- name: UpsertTagAlias :exec
+INSERT INTO tag_aliases (name, tag_id)
+VALUES (@name, @tag_id) ON CONFLICT (name) DO
+UPDATE
+SET tag_id = EXCLUDED.tag_id;
+-- name: DeleteTagAlias :exec
+DELETE FROM tag_aliases
+WHERE name = @name;
+-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE id = @id;
+-- name: MoveTagFollows :exec
+INSERT INTO tag_follows (user_uid, tag_id, created_at)
+SELECT src.user_uid,
+  @target_id::integer,
+  src.created_at
+FROM tag_follows src
+WHERE src.tag_id = @source_id::integer ON CONFLICT DO NOTHING;
+-- name: AddTagFollow :execrows
+INSERT INTO tag_follows (user_uid, tag_id)
+VALUES (@user_uid, @tag_id) ON CONFLICT DO NOTHING;
+-- name: RemoveTagFollow :execrows
+DELETE FROM tag_follows
+WHERE user_uid = @user_uid
+  AND tag_id = @tag_id;
+-- name: IsFollowingTag :one
+SELECT EXISTS (
+    SELECT 1
+    FROM tag_follows
+    WHERE user_uid = @user_uid
+      AND tag_id = @tag_id
+  )::boolean AS is_following;
+-- name: ListFollowedTags :many
+SELECT tf.created_at AS followed_at,
+  t.uid,
+  t.name,
+  (
+    SELECT count(*)
+    FROM tag_follows c
+    WHERE c.tag_id = t.id
+  )::integer AS follower_count
+FROM tag_follows tf
+  JOIN tags t ON t.id = tf.tag_id
+WHERE tf.user_uid = @uid
+  AND (
+    (
+      sqlc.narg(cursor_created_at)::timestamptz IS NULL
+      AND sqlc.narg(cursor_id)::uuid IS NULL
+    )
+    OR (tf.created_at, t.uid) < (
+      sqlc.narg(cursor_created_at)::timestamptz,
+      sqlc.narg(cursor_id)::uuid
+    )
+  )
+ORDER BY tf.created_at DESC,
+  t.uid DESC
+LIMIT 20;
@@ -0,0 +1,103 @@
+-- name: FanoutPostToFollowers :execrows
+INSERT INTO timeline_entries (owner_uid, post_uid, author_uid, created_at)
+SELECT uf.follower_uid,
+  p.uid,
+  p.author,
+  p.created_at
+FROM posts p
+  JOIN users u ON u.uid = p.author
+  AND u.followers_count < sqlc.arg(fanout_threshold)::int
+  JOIN user_follows uf ON uf.followee_uid = p.author
+WHERE p.uid = @post_uid
+  AND p.status = 'NORMAL'::post_status
+  AND p.visibility IN (
+    'PUBLIC'::post_visibility,
+    'FOLLOWERS'::post_visibility
+  ) ON CONFLICT DO NOTHING;
+-- name: TrimFollowerTimelines :execrows
+DELETE FROM timeline_entries te USING (
+    SELECT uf.follower_uid AS owner_uid,
+      cutoff.created_at,
+      cutoff.post_uid
+    FROM posts p
+      JOIN user_follows uf ON uf.followee_uid = p.author
+      CROSS JOIN LATERAL (
+        SELECT e.created_at,
+          e.post_uid
+        FROM timeline_entries e
+        WHERE e.owner_uid = uf.follower_uid
+        ORDER BY e.created_at DESC,
+          e.post_uid DESC OFFSET sqlc.arg(max_entries)::int
+        LIMIT 1
+      ) cutoff
+    WHERE p.uid = @post_uid
+  ) cutoffs
+WHERE te.owner_uid = cutoffs.owner_uid
+  AND (te.created_at, te.post_uid) <= (cutoffs.created_at, cutoffs.post_uid);
+-- name: BackfillTimelineFromAuthor :execrows
+INSERT INTO timeline_entries (owner_uid, post_uid, author_uid, created_at)
+SELECT @owner_uid,
+  p.uid,
+  p.author,
+  p.created_at
+FROM posts p
+  JOIN users u ON u.uid = p.author
+  AND u.followers_count < sqlc.arg(fanout_threshold)::int
+WHERE p.author = @author_uid
+  AND p.status = 'NORMAL'::post_status
+  AND p.visibility IN (
+    'PUBLIC'::post_visibility,
+    'FOLLOWERS'::post_visibility
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT sqlc.arg(max_entries)::int ON CONFLICT DO NOTHING;
+-- name: DeleteTimelineEntriesByAuthor :execrows
+DELETE FROM timeline_entries
+WHERE owner_uid = @owner_uid
+  AND author_uid = @author_uid;
+-- name: TruncateTimelineEntries :exec
+TRUNCATE timeline_entries;
+-- name: RebuildTimelineEntries :execrows
+INSERT INTO timeline_entries (owner_uid, post_uid, author_uid, created_at)
+SELECT ranked.owner_uid,
+  ranked.post_uid,
+  ranked.author_uid,
+  ranked.created_at
+FROM (
+    SELECT uf.follower_uid AS owner_uid,
+      p.uid AS post_uid,
+      p.author AS author_uid,
+      p.created_at,
+      row_number() OVER (
+        PARTITION BY uf.follower_uid
+        ORDER BY p.created_at DESC,
+          p.uid DESC
+      ) AS rn
+    FROM user_follows uf
+      JOIN users u ON u.uid = uf.followee_uid
+      AND u.followers_count < sqlc.arg(fanout_threshold)::int
+      JOIN posts p ON p.author = uf.followee_uid
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+  ) ranked
+WHERE ranked.rn <= sqlc.arg(max_entries)::int;
+-- name: EnqueueTimelineFanout :exec
+INSERT INTO timeline_fanout_outbox (post_uid)
+VALUES (@post_uid) ON CONFLICT DO NOTHING;
+-- name: ClaimTimelineFanout :one
+SELECT post_uid
+FROM timeline_fanout_outbox
+ORDER BY enqueued_at
+LIMIT 1 FOR
+UPDATE SKIP LOCKED;
+-- name: DeleteTimelineFanout :exec
+DELETE FROM timeline_fanout_outbox
+WHERE post_uid = @post_uid;
+-- name: RequeueTimelineFanout :exec
+UPDATE timeline_fanout_outbox
+SET enqueued_at = now()
+WHERE post_uid = @post_uid;
@@ -17,6 +17,7 @@ SELECT uid,
   followers_count,
   following_count,
   description,
+  is_private,
   status,
   created_at
 FROM users
@@ -38,12 +39,56 @@ SELECT uid,
 FROM users
 WHERE username = $1
   AND status = 'NORMAL'::user_status;
+-- name: GetUserPasswordHash :one
+SELECT password_hash
+FROM users
+WHERE uid = $1
+  AND status = 'NORMAL'::user_status;
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = @password_hash
+WHERE uid = @uid;
 -- name: UpdateUser :exec
 UPDATE users
 SET username = COALESCE(sqlc.narg(username), username),
   email = COALESCE(sqlc.narg(email), email),
   nickname = COALESCE(sqlc.narg(nickname), nickname),
   avatar_url = COALESCE(sqlc.narg(avatar_url), avatar_url),
+  is_private = COALESCE(sqlc.narg(is_private)::boolean, is_private),
   updated_at = now()
 WHERE uid = $1
-  AND status = 'NORMAL'::user_status;
\ No newline at end of file
+  AND status = 'NORMAL'::user_status;
+-- name: AutocompleteUsers :many
+SELECT u.uid,
+  u.username,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (uf.follower_uid IS NOT NULL)::boolean AS is_following
+FROM users u
+  LEFT JOIN user_follows uf ON uf.followee_uid = u.uid
+  AND uf.follower_uid = @viewer
+WHERE u.status = 'NORMAL'::user_status
+  AND u.uid <> @viewer
+  AND (
+    lower(u.username) LIKE lower(@prefix::text) || '%'
+    OR lower(u.nickname) LIKE lower(@prefix::text) || '%'
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE (
+        ub.blocker_uid = @viewer
+        AND ub.blocked_uid = u.uid
+      )
+      OR (
+        ub.blocker_uid = u.uid
+        AND ub.blocked_uid = @viewer
+      )
+  )
+ORDER BY is_following DESC,
+  u.followers_count DESC,
+  u.username
+LIMIT 10;
@@ -12,41 +12,317 @@ import (
 	"github.com/google/uuid"
 )
 
+const createSession = `-- name: CreateSession :exec
+INSERT INTO refresh_tokens (
+    session_uid,
+    uid,
+    token_hash,
+    expires_at,
+    device_id,
+    user_agent,
+    ip
+  )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+  )
+`
+
+type CreateSessionParams struct {
+	SessionUid uuid.UUID
+	Uid        uuid.UUID
+	TokenHash  string
+	ExpiresAt  time.Time
+	DeviceID   string
+	UserAgent  string
+	Ip         string
+}
+
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createSession,
+		arg.SessionUid,
+		arg.Uid,
+		arg.TokenHash,
+		arg.ExpiresAt,
+		arg.DeviceID,
+		arg.UserAgent,
+		arg.Ip,
+	)
+	return err
+}
+
+const deleteDeviceSession = `-- name: DeleteDeviceSession :many
+DELETE FROM refresh_tokens
+WHERE uid = $1
+  AND device_id = $2
+  AND device_id <> ''
+RETURNING session_uid
+`
+
+type DeleteDeviceSessionParams struct {
+	Uid      uuid.UUID
+	DeviceID string
+}
+
+func (q *Queries) DeleteDeviceSession(ctx context.Context, arg DeleteDeviceSessionParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, deleteDeviceSession, arg.Uid, arg.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var session_uid uuid.UUID
+		if err := rows.Scan(&session_uid); err != nil {
+			return nil, err
+		}
+		items = append(items, session_uid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteOtherSessions = `-- name: DeleteOtherSessions :many
+DELETE FROM refresh_tokens
+WHERE uid = $1
+  AND session_uid <> $2
+RETURNING session_uid
+`
+
+type DeleteOtherSessionsParams struct {
+	Uid        uuid.UUID
+	SessionUid uuid.UUID
+}
+
+func (q *Queries) DeleteOtherSessions(ctx context.Context, arg DeleteOtherSessionsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, deleteOtherSessions, arg.Uid, arg.SessionUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var session_uid uuid.UUID
+		if err := rows.Scan(&session_uid); err != nil {
+			return nil, err
+		}
+		items = append(items, session_uid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteRefreshToken = `-- name: DeleteRefreshToken :many
+DELETE FROM refresh_tokens
+WHERE token_hash = $1
+RETURNING session_uid
+`
+
+func (q *Queries) DeleteRefreshToken(ctx context.Context, tokenHash string) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, deleteRefreshToken, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var session_uid uuid.UUID
+		if err := rows.Scan(&session_uid); err != nil {
+			return nil, err
+		}
+		items = append(items, session_uid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteSession = `-- name: DeleteSession :execrows
+DELETE FROM refresh_tokens
+WHERE uid = $1
+  AND session_uid = $2
+`
+
+type DeleteSessionParams struct {
+	Uid        uuid.UUID
+	SessionUid uuid.UUID
+}
+
+func (q *Queries) DeleteSession(ctx context.Context, arg DeleteSessionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteSession, arg.Uid, arg.SessionUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getRefreshToken = `-- name: GetRefreshToken :one
 SELECT uid,
-  token
+  session_uid
 FROM refresh_tokens
-WHERE token = $1
-  AND expires_at > now()
+WHERE token_hash = $1
+  AND expires_at > now() FOR
+UPDATE
 `
 
 type GetRefreshTokenRow struct {
-	Uid   uuid.UUID
-	Token string
+	Uid        uuid.UUID
+	SessionUid uuid.UUID
 }
 
-func (q *Queries) GetRefreshToken(ctx context.Context, token string) (GetRefreshTokenRow, error) {
-	row := q.db.QueryRowContext(ctx, getRefreshToken, token)
+func (q *Queries) GetRefreshToken(ctx context.Context, tokenHash string) (GetRefreshTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, getRefreshToken, tokenHash)
 	var i GetRefreshTokenRow
-	err := row.Scan(&i.Uid, &i.Token)
+	err := row.Scan(&i.Uid, &i.SessionUid)
 	return i, err
 }
 
-const upsertRefreshToken = `-- name: UpsertRefreshToken :exec
-INSERT INTO refresh_tokens (uid, token, expires_at)
-VALUES ($1, $2, $3) ON CONFLICT (uid) DO
-UPDATE
-SET token = EXCLUDED.token,
-  expires_at = EXCLUDED.expires_at
+const getRotatedRefreshToken = `-- name: GetRotatedRefreshToken :one
+SELECT t.uid,
+  t.session_uid,
+  t.device_id,
+  t.ip,
+  t.token_hash AS current_token_hash,
+  r.successor,
+  r.rotated_at
+FROM rotated_refresh_tokens r
+  JOIN refresh_tokens t ON t.session_uid = r.session_uid
+WHERE r.token_hash = $1 FOR
+UPDATE OF t
+`
+
+type GetRotatedRefreshTokenRow struct {
+	Uid              uuid.UUID
+	SessionUid       uuid.UUID
+	DeviceID         string
+	Ip               string
+	CurrentTokenHash string
+	Successor        []byte
+	RotatedAt        time.Time
+}
+
+func (q *Queries) GetRotatedRefreshToken(ctx context.Context, tokenHash string) (GetRotatedRefreshTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, getRotatedRefreshToken, tokenHash)
+	var i GetRotatedRefreshTokenRow
+	err := row.Scan(
+		&i.Uid,
+		&i.SessionUid,
+		&i.DeviceID,
+		&i.Ip,
+		&i.CurrentTokenHash,
+		&i.Successor,
+		&i.RotatedAt,
+	)
+	return i, err
+}
+
+const listSessionsByUser = `-- name: ListSessionsByUser :many
+SELECT session_uid,
+  device_id,
+  user_agent,
+  ip,
+  created_at,
+  last_used_at
+FROM refresh_tokens
+WHERE uid = $1
+  AND expires_at > now()
+ORDER BY last_used_at DESC,
+  id DESC
+`
+
+type ListSessionsByUserRow struct {
+	SessionUid uuid.UUID
+	DeviceID   string
+	UserAgent  string
+	Ip         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+func (q *Queries) ListSessionsByUser(ctx context.Context, uid uuid.UUID) ([]ListSessionsByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionsByUser, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSessionsByUserRow
+	for rows.Next() {
+		var i ListSessionsByUserRow
+		if err := rows.Scan(
+			&i.SessionUid,
+			&i.DeviceID,
+			&i.UserAgent,
+			&i.Ip,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rotateSessionToken = `-- name: RotateSessionToken :exec
+WITH rotated AS (
+  INSERT INTO rotated_refresh_tokens (token_hash, session_uid, successor)
+  SELECT old.token_hash,
+    old.session_uid,
+    $6::bytea
+  FROM refresh_tokens old
+  WHERE old.session_uid = $5
+)
+UPDATE refresh_tokens
+SET token_hash = $1,
+  expires_at = $2,
+  user_agent = $3,
+  ip = $4,
+  last_used_at = now()
+WHERE refresh_tokens.session_uid = $5
 `
 
-type UpsertRefreshTokenParams struct {
-	Uid       uuid.UUID
-	Token     string
-	ExpiresAt time.Time
+type RotateSessionTokenParams struct {
+	TokenHash  string
+	ExpiresAt  time.Time
+	UserAgent  string
+	Ip         string
+	SessionUid uuid.UUID
+	Successor  []byte
 }
 
-func (q *Queries) UpsertRefreshToken(ctx context.Context, arg UpsertRefreshTokenParams) error {
-	_, err := q.db.ExecContext(ctx, upsertRefreshToken, arg.Uid, arg.Token, arg.ExpiresAt)
+func (q *Queries) RotateSessionToken(ctx context.Context, arg RotateSessionTokenParams) error {
+	_, err := q.db.ExecContext(ctx, rotateSessionToken,
+		arg.TokenHash,
+		arg.ExpiresAt,
+		arg.UserAgent,
+		arg.Ip,
+		arg.SessionUid,
+		arg.Successor,
+	)
 	return err
 }
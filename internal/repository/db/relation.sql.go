@@ -0,0 +1,256 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: relation.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const addBlock = `-- name: AddBlock :execrows
+INSERT INTO user_blocks (blocker_uid, blocked_uid)
+VALUES ($1, $2) ON CONFLICT DO NOTHING
+`
+
+type AddBlockParams struct {
+	BlockerUid uuid.UUID
+	BlockedUid uuid.UUID
+}
+
+func (q *Queries) AddBlock(ctx context.Context, arg AddBlockParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addBlock, arg.BlockerUid, arg.BlockedUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const addMute = `-- name: AddMute :execrows
+INSERT INTO user_mutes (muter_uid, muted_uid)
+VALUES ($1, $2) ON CONFLICT DO NOTHING
+`
+
+type AddMuteParams struct {
+	MuterUid uuid.UUID
+	MutedUid uuid.UUID
+}
+
+func (q *Queries) AddMute(ctx context.Context, arg AddMuteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addMute, arg.MuterUid, arg.MutedUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const isBlockedByAny = `-- name: IsBlockedByAny :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_blocks
+    WHERE blocker_uid = ANY($1::uuid [])
+      AND blocked_uid = $2
+  )::boolean AS blocked
+`
+
+type IsBlockedByAnyParams struct {
+	BlockerUids []uuid.UUID
+	BlockedUid  uuid.UUID
+}
+
+func (q *Queries) IsBlockedByAny(ctx context.Context, arg IsBlockedByAnyParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isBlockedByAny, pq.Array(arg.BlockerUids), arg.BlockedUid)
+	var blocked bool
+	err := row.Scan(&blocked)
+	return blocked, err
+}
+
+const listBlocks = `-- name: ListBlocks :many
+SELECT ub.created_at AS blocked_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count
+FROM user_blocks ub
+  JOIN users u ON u.uid = ub.blocked_uid
+WHERE ub.blocker_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (ub.created_at, ub.blocked_uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY ub.created_at DESC,
+  ub.blocked_uid DESC
+LIMIT 20
+`
+
+type ListBlocksParams struct {
+	Uid             uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListBlocksRow struct {
+	BlockedAt      time.Time
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+}
+
+func (q *Queries) ListBlocks(ctx context.Context, arg ListBlocksParams) ([]ListBlocksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listBlocks, arg.Uid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBlocksRow
+	for rows.Next() {
+		var i ListBlocksRow
+		if err := rows.Scan(
+			&i.BlockedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMutes = `-- name: ListMutes :many
+SELECT um.created_at AS muted_at,
+  u.uid,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count
+FROM user_mutes um
+  JOIN users u ON u.uid = um.muted_uid
+WHERE um.muter_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (um.created_at, um.muted_uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY um.created_at DESC,
+  um.muted_uid DESC
+LIMIT 20
+`
+
+type ListMutesParams struct {
+	Uid             uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListMutesRow struct {
+	MutedAt        time.Time
+	Uid            uuid.UUID
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+}
+
+func (q *Queries) ListMutes(ctx context.Context, arg ListMutesParams) ([]ListMutesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMutes, arg.Uid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMutesRow
+	for rows.Next() {
+		var i ListMutesRow
+		if err := rows.Scan(
+			&i.MutedAt,
+			&i.Uid,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeBlock = `-- name: RemoveBlock :execrows
+DELETE FROM user_blocks
+WHERE blocker_uid = $1
+  AND blocked_uid = $2
+`
+
+type RemoveBlockParams struct {
+	BlockerUid uuid.UUID
+	BlockedUid uuid.UUID
+}
+
+func (q *Queries) RemoveBlock(ctx context.Context, arg RemoveBlockParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeBlock, arg.BlockerUid, arg.BlockedUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const removeMute = `-- name: RemoveMute :execrows
+DELETE FROM user_mutes
+WHERE muter_uid = $1
+  AND muted_uid = $2
+`
+
+type RemoveMuteParams struct {
+	MuterUid uuid.UUID
+	MutedUid uuid.UUID
+}
+
+func (q *Queries) RemoveMute(ctx context.Context, arg RemoveMuteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeMute, arg.MuterUid, arg.MutedUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
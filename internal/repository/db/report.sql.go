@@ -0,0 +1,312 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: report.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const claimReport = `-- name: ClaimReport :execrows
+UPDATE reports
+SET status = 'CLAIMED'::report_status,
+  handler_uid = $1::uuid,
+  updated_at = now()
+WHERE uid = $2
+  AND status = 'OPEN'::report_status
+`
+
+type ClaimReportParams struct {
+	HandlerUid uuid.UUID
+	Uid        uuid.UUID
+}
+
+func (q *Queries) ClaimReport(ctx context.Context, arg ClaimReportParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimReport, arg.HandlerUid, arg.Uid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const closeReport = `-- name: CloseReport :execrows
+UPDATE reports
+SET status = $1,
+  handler_uid = $2::uuid,
+  note = $3,
+  updated_at = now()
+WHERE uid = $4
+  AND (
+    status = 'OPEN'::report_status
+    OR (
+      status = 'CLAIMED'::report_status
+      AND handler_uid = $2::uuid
+    )
+  )
+`
+
+type CloseReportParams struct {
+	Status     ReportStatus
+	HandlerUid uuid.UUID
+	Note       string
+	Uid        uuid.UUID
+}
+
+func (q *Queries) CloseReport(ctx context.Context, arg CloseReportParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, closeReport,
+		arg.Status,
+		arg.HandlerUid,
+		arg.Note,
+		arg.Uid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createReport = `-- name: CreateReport :one
+INSERT INTO reports (
+    reporter_uid,
+    target_type,
+    target_uid,
+    reason,
+    detail
+  )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+  ) ON CONFLICT (reporter_uid, target_type, target_uid)
+WHERE status IN ('OPEN'::report_status, 'CLAIMED'::report_status) DO
+UPDATE
+SET reason = EXCLUDED.reason,
+  detail = EXCLUDED.detail,
+  updated_at = now()
+RETURNING uid
+`
+
+type CreateReportParams struct {
+	ReporterUid uuid.UUID
+	TargetType  ReportTargetType
+	TargetUid   uuid.UUID
+	Reason      ReportReason
+	Detail      string
+}
+
+func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, createReport,
+		arg.ReporterUid,
+		arg.TargetType,
+		arg.TargetUid,
+		arg.Reason,
+		arg.Detail,
+	)
+	var uid uuid.UUID
+	err := row.Scan(&uid)
+	return uid, err
+}
+
+const getReportByUid = `-- name: GetReportByUid :one
+SELECT uid,
+  target_type,
+  target_uid,
+  status,
+  handler_uid
+FROM reports
+WHERE uid = $1
+LIMIT 1
+`
+
+type GetReportByUidRow struct {
+	Uid        uuid.UUID
+	TargetType ReportTargetType
+	TargetUid  uuid.UUID
+	Status     ReportStatus
+	HandlerUid uuid.NullUUID
+}
+
+func (q *Queries) GetReportByUid(ctx context.Context, uid uuid.UUID) (GetReportByUidRow, error) {
+	row := q.db.QueryRowContext(ctx, getReportByUid, uid)
+	var i GetReportByUidRow
+	err := row.Scan(
+		&i.Uid,
+		&i.TargetType,
+		&i.TargetUid,
+		&i.Status,
+		&i.HandlerUid,
+	)
+	return i, err
+}
+
+const listReports = `-- name: ListReports :many
+SELECT r.uid,
+  r.reporter_uid,
+  u.nickname AS reporter_nickname,
+  r.target_type,
+  r.target_uid,
+  r.reason,
+  r.detail,
+  r.status,
+  r.handler_uid,
+  r.note,
+  (
+    SELECT count(*)
+    FROM reports t
+    WHERE t.target_type = r.target_type
+      AND t.target_uid = r.target_uid
+      AND t.status IN ('OPEN'::report_status, 'CLAIMED'::report_status)
+  )::int AS pending_count,
+  r.created_at,
+  r.updated_at
+FROM reports r
+  LEFT JOIN users u ON u.uid = r.reporter_uid
+WHERE r.status = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (r.created_at, r.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY r.created_at DESC,
+  r.uid DESC
+LIMIT 20
+`
+
+type ListReportsParams struct {
+	Status          ReportStatus
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListReportsRow struct {
+	Uid              uuid.UUID
+	ReporterUid      uuid.UUID
+	ReporterNickname sql.NullString
+	TargetType       ReportTargetType
+	TargetUid        uuid.UUID
+	Reason           ReportReason
+	Detail           string
+	Status           ReportStatus
+	HandlerUid       uuid.NullUUID
+	Note             string
+	PendingCount     int32
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (q *Queries) ListReports(ctx context.Context, arg ListReportsParams) ([]ListReportsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listReports, arg.Status, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReportsRow
+	for rows.Next() {
+		var i ListReportsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.ReporterUid,
+			&i.ReporterNickname,
+			&i.TargetType,
+			&i.TargetUid,
+			&i.Reason,
+			&i.Detail,
+			&i.Status,
+			&i.HandlerUid,
+			&i.Note,
+			&i.PendingCount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reportTargetExists = `-- name: ReportTargetExists :one
+SELECT CASE
+    $1::report_target_type
+    WHEN 'POST' THEN EXISTS (
+      SELECT 1
+      FROM posts p
+      WHERE p.uid = $2::uuid
+        AND p.status = 'NORMAL'::post_status
+    )
+    WHEN 'COMMENT' THEN EXISTS (
+      SELECT 1
+      FROM post_comments c
+      WHERE c.uid = $2::uuid
+        AND c.status = 'NORMAL'::comment_status
+    )
+    ELSE EXISTS (
+      SELECT 1
+      FROM users u
+      WHERE u.uid = $2::uuid
+        AND u.status = 'NORMAL'::user_status
+    )
+  END::boolean AS found
+`
+
+type ReportTargetExistsParams struct {
+	TargetType ReportTargetType
+	TargetUid  uuid.UUID
+}
+
+func (q *Queries) ReportTargetExists(ctx context.Context, arg ReportTargetExistsParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, reportTargetExists, arg.TargetType, arg.TargetUid)
+	var found bool
+	err := row.Scan(&found)
+	return found, err
+}
+
+const resolvePendingReportsByTarget = `-- name: ResolvePendingReportsByTarget :execrows
+UPDATE reports
+SET status = 'RESOLVED'::report_status,
+  handler_uid = $1::uuid,
+  note = $2,
+  updated_at = now()
+WHERE target_type = $3
+  AND target_uid = $4
+  AND status IN ('OPEN'::report_status, 'CLAIMED'::report_status)
+`
+
+type ResolvePendingReportsByTargetParams struct {
+	HandlerUid uuid.UUID
+	Note       string
+	TargetType ReportTargetType
+	TargetUid  uuid.UUID
+}
+
+func (q *Queries) ResolvePendingReportsByTarget(ctx context.Context, arg ResolvePendingReportsByTargetParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, resolvePendingReportsByTarget,
+		arg.HandlerUid,
+		arg.Note,
+		arg.TargetType,
+		arg.TargetUid,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,491 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: search.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const searchComments = `-- name: SearchComments :many
+SELECT c.uid,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  c.post_uid,
+  c.root_uid,
+  c.parent_uid,
+  c.reply_to_author_uid,
+  c.content,
+  c.entities,
+  c.images,
+  c.reply_count,
+  c.like_count,
+  (cl.user_uid IS NOT NULL)::boolean AS liked,
+  c.created_at,
+  c.updated_at
+FROM post_comments c
+  JOIN users u ON u.uid = c.author_uid
+  AND u.status = 'NORMAL'::user_status
+  JOIN posts p ON p.uid = c.post_uid
+  AND p.status = 'NORMAL'::post_status
+  JOIN users pu ON pu.uid = p.author
+  LEFT JOIN comment_likes cl ON cl.comment_uid = c.uid
+  AND cl.user_uid = $1::uuid
+WHERE c.status = 'NORMAL'::comment_status
+  AND (
+    p.author = $1::uuid
+    OR (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT pu.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = $1::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
+  AND (
+    c.search_vector @@ websearch_to_tsquery('simple', $2::text)
+    OR c.content ILIKE '%' || $3::text || '%'
+  )
+  AND (
+    $4::uuid IS NULL
+    OR c.author_uid = $4::uuid
+  )
+  AND (
+    $5::text IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+        AND t.name = $5::text
+    )
+  )
+  AND (
+    $6::timestamptz IS NULL
+    OR c.created_at >= $6::timestamptz
+  )
+  AND (
+    $7::timestamptz IS NULL
+    OR c.created_at < $7::timestamptz
+  )
+  AND (
+    (
+      $8::timestamptz IS NULL
+      AND $9::uuid IS NULL
+    )
+    OR (c.created_at, c.uid) < (
+      $8::timestamptz,
+      $9::uuid
+    )
+  )
+ORDER BY c.created_at DESC,
+  c.uid DESC
+LIMIT 20
+`
+
+type SearchCommentsParams struct {
+	Viewer          uuid.NullUUID
+	Query           string
+	Pattern         string
+	AuthorUid       uuid.NullUUID
+	Tag             sql.NullString
+	CreatedAfter    sql.NullTime
+	CreatedBefore   sql.NullTime
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type SearchCommentsRow struct {
+	Uid              uuid.UUID
+	AuthorUid        uuid.UUID
+	AuthorNickname   string
+	AuthorAvatarUrl  string
+	PostUid          uuid.UUID
+	RootUid          uuid.UUID
+	ParentUid        uuid.NullUUID
+	ReplyToAuthorUid uuid.NullUUID
+	Content          string
+	Entities         string
+	Images           []string
+	ReplyCount       int32
+	LikeCount        int32
+	Liked            bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (q *Queries) SearchComments(ctx context.Context, arg SearchCommentsParams) ([]SearchCommentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchComments,
+		arg.Viewer,
+		arg.Query,
+		arg.Pattern,
+		arg.AuthorUid,
+		arg.Tag,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchCommentsRow
+	for rows.Next() {
+		var i SearchCommentsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.PostUid,
+			&i.RootUid,
+			&i.ParentUid,
+			&i.ReplyToAuthorUid,
+			&i.Content,
+			&i.Entities,
+			pq.Array(&i.Images),
+			&i.ReplyCount,
+			&i.LikeCount,
+			&i.Liked,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchPosts = `-- name: SearchPosts :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.repost_count,
+  p.edit_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.status,
+  p.created_at,
+  p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = $1::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM posts p
+  JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+  LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+WHERE p.status = 'NORMAL'::post_status
+  AND (
+    p.author = $1::uuid
+    OR (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = $1::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
+  AND (
+    p.search_vector @@ websearch_to_tsquery('simple', $2::text)
+    OR p.text ILIKE '%' || $3::text || '%'
+  )
+  AND (
+    $4::uuid IS NULL
+    OR p.author = $4::uuid
+  )
+  AND (
+    $5::text IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM post_tags pt
+        JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id
+        AND t.name = $5::text
+    )
+  )
+  AND (
+    $6::timestamptz IS NULL
+    OR p.created_at >= $6::timestamptz
+  )
+  AND (
+    $7::timestamptz IS NULL
+    OR p.created_at < $7::timestamptz
+  )
+  AND (
+    (
+      $8::timestamptz IS NULL
+      AND $9::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      $8::timestamptz,
+      $9::uuid
+    )
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20
+`
+
+type SearchPostsParams struct {
+	Viewer          uuid.NullUUID
+	Query           string
+	Pattern         string
+	AuthorUid       uuid.NullUUID
+	Tag             sql.NullString
+	CreatedAfter    sql.NullTime
+	CreatedBefore   sql.NullTime
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type SearchPostsRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Entities        string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn time.Time
+	Ip              string
+	Status          PostStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	RepostOfUid     string
+	QuotedPostUid   string
+	Liked           bool
+	Collected       bool
+	Reposted        bool
+	TagNames        []string
+}
+
+func (q *Queries) SearchPosts(ctx context.Context, arg SearchPostsParams) ([]SearchPostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchPosts,
+		arg.Viewer,
+		arg.Query,
+		arg.Pattern,
+		arg.AuthorUid,
+		arg.Tag,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchPostsRow
+	for rows.Next() {
+		var i SearchPostsRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Entities,
+			pq.Array(&i.Images),
+			pq.Array(&i.Attachments),
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.RepostCount,
+			&i.EditCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepostOfUid,
+			&i.QuotedPostUid,
+			&i.Liked,
+			&i.Collected,
+			&i.Reposted,
+			pq.Array(&i.TagNames),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT u.uid,
+  u.username,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (f.follower_uid IS NOT NULL)::boolean AS is_following,
+  u.created_at
+FROM users u
+  LEFT JOIN user_follows f ON f.followee_uid = u.uid
+  AND f.follower_uid = $1::uuid
+WHERE u.status = 'NORMAL'::user_status
+  AND (
+    u.search_vector @@ websearch_to_tsquery('simple', $2::text)
+    OR u.nickname ILIKE '%' || $3::text || '%'
+    OR u.username ILIKE '%' || $3::text || '%'
+  )
+  AND (
+    (
+      $4::timestamptz IS NULL
+      AND $5::uuid IS NULL
+    )
+    OR (u.created_at, u.uid) < (
+      $4::timestamptz,
+      $5::uuid
+    )
+  )
+ORDER BY u.created_at DESC,
+  u.uid DESC
+LIMIT 20
+`
+
+type SearchUsersParams struct {
+	Viewer          uuid.NullUUID
+	Query           string
+	Pattern         string
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type SearchUsersRow struct {
+	Uid            uuid.UUID
+	Username       string
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	IsFollowing    bool
+	CreatedAt      time.Time
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]SearchUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchUsers,
+		arg.Viewer,
+		arg.Query,
+		arg.Pattern,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUsersRow
+	for rows.Next() {
+		var i SearchUsersRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Username,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.IsFollowing,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,580 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: tag.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const addTagFollow = `-- name: AddTagFollow :execrows
+INSERT INTO tag_follows (user_uid, tag_id)
+VALUES ($1, $2) ON CONFLICT DO NOTHING
+`
+
+type AddTagFollowParams struct {
+	UserUid uuid.UUID
+	TagID   int32
+}
+
+func (q *Queries) AddTagFollow(ctx context.Context, arg AddTagFollowParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addTagFollow, arg.UserUid, arg.TagID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const autocompleteTags = `-- name: AutocompleteTags :many
+SELECT t.uid,
+  t.name,
+  count(pt.post_id)::integer AS post_count
+FROM tags t
+  LEFT JOIN post_tags pt ON pt.tag_id = t.id
+WHERE lower(t.name) LIKE lower($1::text) || '%'
+GROUP BY t.id
+ORDER BY post_count DESC,
+  t.name
+LIMIT 10
+`
+
+type AutocompleteTagsRow struct {
+	Uid       uuid.UUID
+	Name      string
+	PostCount int32
+}
+
+func (q *Queries) AutocompleteTags(ctx context.Context, prefix string) ([]AutocompleteTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, autocompleteTags, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AutocompleteTagsRow
+	for rows.Next() {
+		var i AutocompleteTagsRow
+		if err := rows.Scan(&i.Uid, &i.Name, &i.PostCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteTag = `-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTag(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteTag, id)
+	return err
+}
+
+const deleteTagAlias = `-- name: DeleteTagAlias :exec
+DELETE FROM tag_aliases
+WHERE name = $1
+`
+
+func (q *Queries) DeleteTagAlias(ctx context.Context, name string) error {
+	_, err := q.db.ExecContext(ctx, deleteTagAlias, name)
+	return err
+}
+
+const getTagByName = `-- name: GetTagByName :one
+SELECT t.id,
+  t.uid,
+  t.name,
+  (
+    SELECT count(*)
+    FROM post_tags pt
+      JOIN posts p ON p.id = pt.post_id
+    WHERE pt.tag_id = t.id
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility = 'PUBLIC'::post_visibility
+  )::integer AS post_count,
+  (
+    SELECT count(*)
+    FROM tag_follows tf
+    WHERE tf.tag_id = t.id
+  )::integer AS follower_count
+FROM tags t
+WHERE t.name = $1
+  OR t.id = (
+    SELECT a.tag_id
+    FROM tag_aliases a
+    WHERE a.name = $1
+  )
+LIMIT 1
+`
+
+type GetTagByNameRow struct {
+	ID            int32
+	Uid           uuid.UUID
+	Name          string
+	PostCount     int32
+	FollowerCount int32
+}
+
+func (q *Queries) GetTagByName(ctx context.Context, name string) (GetTagByNameRow, error) {
+	row := q.db.QueryRowContext(ctx, getTagByName, name)
+	var i GetTagByNameRow
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.Name,
+		&i.PostCount,
+		&i.FollowerCount,
+	)
+	return i, err
+}
+
+const isFollowingTag = `-- name: IsFollowingTag :one
+SELECT EXISTS (
+    SELECT 1
+    FROM tag_follows
+    WHERE user_uid = $1
+      AND tag_id = $2
+  )::boolean AS is_following
+`
+
+type IsFollowingTagParams struct {
+	UserUid uuid.UUID
+	TagID   int32
+}
+
+func (q *Queries) IsFollowingTag(ctx context.Context, arg IsFollowingTagParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isFollowingTag, arg.UserUid, arg.TagID)
+	var is_following bool
+	err := row.Scan(&is_following)
+	return is_following, err
+}
+
+const listFollowedTags = `-- name: ListFollowedTags :many
+SELECT tf.created_at AS followed_at,
+  t.uid,
+  t.name,
+  (
+    SELECT count(*)
+    FROM tag_follows c
+    WHERE c.tag_id = t.id
+  )::integer AS follower_count
+FROM tag_follows tf
+  JOIN tags t ON t.id = tf.tag_id
+WHERE tf.user_uid = $1
+  AND (
+    (
+      $2::timestamptz IS NULL
+      AND $3::uuid IS NULL
+    )
+    OR (tf.created_at, t.uid) < (
+      $2::timestamptz,
+      $3::uuid
+    )
+  )
+ORDER BY tf.created_at DESC,
+  t.uid DESC
+LIMIT 20
+`
+
+type ListFollowedTagsParams struct {
+	Uid             uuid.UUID
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListFollowedTagsRow struct {
+	FollowedAt    time.Time
+	Uid           uuid.UUID
+	Name          string
+	FollowerCount int32
+}
+
+func (q *Queries) ListFollowedTags(ctx context.Context, arg ListFollowedTagsParams) ([]ListFollowedTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFollowedTags, arg.Uid, arg.CursorCreatedAt, arg.CursorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFollowedTagsRow
+	for rows.Next() {
+		var i ListFollowedTagsRow
+		if err := rows.Scan(
+			&i.FollowedAt,
+			&i.Uid,
+			&i.Name,
+			&i.FollowerCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsByTag = `-- name: ListPostsByTag :many
+SELECT p.uid,
+  p.author,
+  u.uid AS author_uid,
+  u.nickname AS author_nickname,
+  u.avatar_url AS author_avatar_url,
+  p.text,
+  p.entities,
+  p.images,
+  p.attachments,
+  p.comment_count,
+  p.collection_count,
+  p.like_count,
+  p.repost_count,
+  p.edit_count,
+  p.pinned,
+  p.visibility,
+  p.latest_replied_on,
+  p.ip,
+  p.status,
+  p.created_at,
+  p.updated_at,
+  COALESCE(p.repost_of_uid::text, '')::text AS repost_of_uid,
+  COALESCE(p.quoted_post_uid::text, '')::text AS quoted_post_uid,
+  (pl.user_uid IS NOT NULL)::boolean AS liked,
+  (pc.user_uid IS NOT NULL)::boolean AS collected,
+  EXISTS (
+    SELECT 1
+    FROM posts rr
+    WHERE rr.repost_of_uid = p.uid
+      AND rr.author = $1::uuid
+      AND rr.status = 'NORMAL'::post_status
+  ) AS reposted,
+  COALESCE(
+    (
+      SELECT array_agg(
+          t.name
+          ORDER BY t.name
+        )
+      FROM post_tags pt2
+        JOIN tags t ON t.id = pt2.tag_id
+      WHERE pt2.post_id = p.id
+    ),
+    '{}'::text []
+  )::text [] AS tag_names
+FROM post_tags pt
+  JOIN posts p ON p.id = pt.post_id
+  JOIN users u ON u.uid = p.author
+  AND u.status = 'NORMAL'::user_status
+  LEFT JOIN post_likes pl ON pl.post_uid = p.uid
+  AND pl.user_uid = $1::uuid
+  LEFT JOIN post_collections pc ON pc.post_uid = p.uid
+  AND pc.user_uid = $1::uuid
+WHERE pt.tag_id = $2
+  AND p.status = 'NORMAL'::post_status
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE ub.blocker_uid = $1::uuid
+      AND ub.blocked_uid = p.author
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_mutes um
+    WHERE um.muter_uid = $1::uuid
+      AND um.muted_uid = p.author
+  )
+  AND (
+    p.author = $1::uuid
+    OR (
+      p.visibility = 'PUBLIC'::post_visibility
+      AND NOT u.is_private
+    )
+    OR (
+      p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+      AND EXISTS (
+        SELECT 1
+        FROM user_follows vf
+        WHERE vf.follower_uid = $1::uuid
+          AND vf.followee_uid = p.author
+      )
+    )
+  )
+  AND (
+    (
+      $3::timestamptz IS NULL
+      AND $4::uuid IS NULL
+    )
+    OR (p.created_at, p.uid) < (
+      $3::timestamptz,
+      $4::uuid
+    )
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT 20
+`
+
+type ListPostsByTagParams struct {
+	Viewer          uuid.NullUUID
+	TagID           int32
+	CursorCreatedAt sql.NullTime
+	CursorID        uuid.NullUUID
+}
+
+type ListPostsByTagRow struct {
+	Uid             uuid.UUID
+	Author          uuid.UUID
+	AuthorUid       uuid.UUID
+	AuthorNickname  string
+	AuthorAvatarUrl string
+	Text            string
+	Entities        string
+	Images          []string
+	Attachments     []string
+	CommentCount    int32
+	CollectionCount int32
+	LikeCount       int32
+	RepostCount     int32
+	EditCount       int32
+	Pinned          bool
+	Visibility      PostVisibility
+	LatestRepliedOn time.Time
+	Ip              string
+	Status          PostStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	RepostOfUid     string
+	QuotedPostUid   string
+	Liked           bool
+	Collected       bool
+	Reposted        bool
+	TagNames        []string
+}
+
+func (q *Queries) ListPostsByTag(ctx context.Context, arg ListPostsByTagParams) ([]ListPostsByTagRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPostsByTag,
+		arg.Viewer,
+		arg.TagID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsByTagRow
+	for rows.Next() {
+		var i ListPostsByTagRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Author,
+			&i.AuthorUid,
+			&i.AuthorNickname,
+			&i.AuthorAvatarUrl,
+			&i.Text,
+			&i.Entities,
+			pq.Array(&i.Images),
+			pq.Array(&i.Attachments),
+			&i.CommentCount,
+			&i.CollectionCount,
+			&i.LikeCount,
+			&i.RepostCount,
+			&i.EditCount,
+			&i.Pinned,
+			&i.Visibility,
+			&i.LatestRepliedOn,
+			&i.Ip,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepostOfUid,
+			&i.QuotedPostUid,
+			&i.Liked,
+			&i.Collected,
+			&i.Reposted,
+			pq.Array(&i.TagNames),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrendingTags = `-- name: ListTrendingTags :many
+SELECT t.uid,
+  t.name,
+  count(*)::integer AS post_count
+FROM posts p
+  JOIN post_tags pt ON pt.post_id = p.id
+  JOIN tags t ON t.id = pt.tag_id
+WHERE p.status = 'NORMAL'::post_status
+  AND p.visibility = 'PUBLIC'::post_visibility
+  AND p.created_at >= $1::timestamptz
+GROUP BY t.id
+ORDER BY post_count DESC,
+  max(p.created_at) DESC,
+  t.name
+LIMIT 20
+`
+
+type ListTrendingTagsRow struct {
+	Uid       uuid.UUID
+	Name      string
+	PostCount int32
+}
+
+func (q *Queries) ListTrendingTags(ctx context.Context, since time.Time) ([]ListTrendingTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTrendingTags, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTrendingTagsRow
+	for rows.Next() {
+		var i ListTrendingTagsRow
+		if err := rows.Scan(&i.Uid, &i.Name, &i.PostCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const movePostTags = `-- name: MovePostTags :exec
+INSERT INTO post_tags (post_id, tag_id)
+SELECT src.post_id,
+  $1::integer
+FROM post_tags src
+WHERE src.tag_id = $2::integer ON CONFLICT DO NOTHING
+`
+
+type MovePostTagsParams struct {
+	TargetID int32
+	SourceID int32
+}
+
+func (q *Queries) MovePostTags(ctx context.Context, arg MovePostTagsParams) error {
+	_, err := q.db.ExecContext(ctx, movePostTags, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveTagAliases = `-- name: MoveTagAliases :exec
+UPDATE tag_aliases
+SET tag_id = $1
+WHERE tag_id = $2
+`
+
+type MoveTagAliasesParams struct {
+	TargetID int32
+	SourceID int32
+}
+
+func (q *Queries) MoveTagAliases(ctx context.Context, arg MoveTagAliasesParams) error {
+	_, err := q.db.ExecContext(ctx, moveTagAliases, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const moveTagFollows = `-- name: MoveTagFollows :exec
+INSERT INTO tag_follows (user_uid, tag_id, created_at)
+SELECT src.user_uid,
+  $1::integer,
+  src.created_at
+FROM tag_follows src
+WHERE src.tag_id = $2::integer ON CONFLICT DO NOTHING
+`
+
+type MoveTagFollowsParams struct {
+	TargetID int32
+	SourceID int32
+}
+
+func (q *Queries) MoveTagFollows(ctx context.Context, arg MoveTagFollowsParams) error {
+	_, err := q.db.ExecContext(ctx, moveTagFollows, arg.TargetID, arg.SourceID)
+	return err
+}
+
+const removeTagFollow = `-- name: RemoveTagFollow :execrows
+DELETE FROM tag_follows
+WHERE user_uid = $1
+  AND tag_id = $2
+`
+
+type RemoveTagFollowParams struct {
+	UserUid uuid.UUID
+	TagID   int32
+}
+
+func (q *Queries) RemoveTagFollow(ctx context.Context, arg RemoveTagFollowParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeTagFollow, arg.UserUid, arg.TagID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const renameTag = `-- name: RenameTag :execrows
+UPDATE tags
+SET name = $1
+WHERE id = $2
+`
+
+type RenameTagParams struct {
+	Name string
+	ID   int32
+}
+
+func (q *Queries) RenameTag(ctx context.Context, arg RenameTagParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, renameTag, arg.Name, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertTagAlias = `-- name: UpsertTagAlias :exec
+INSERT INTO tag_aliases (name, tag_id)
+VALUES ($1, $2) ON CONFLICT (name) DO
+UPDATE
+SET tag_id = EXCLUDED.tag_id
+`
+
+type UpsertTagAliasParams struct {
+	Name  string
+	TagID int32
+}
+
+func (q *Queries) UpsertTagAlias(ctx context.Context, arg UpsertTagAliasParams) error {
+	_, err := q.db.ExecContext(ctx, upsertTagAlias, arg.Name, arg.TagID)
+	return err
+}
@@ -0,0 +1,234 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: timeline.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const backfillTimelineFromAuthor = `-- name: BackfillTimelineFromAuthor :execrows
+INSERT INTO timeline_entries (owner_uid, post_uid, author_uid, created_at)
+SELECT $1,
+  p.uid,
+  p.author,
+  p.created_at
+FROM posts p
+  JOIN users u ON u.uid = p.author
+  AND u.followers_count < $2::int
+WHERE p.author = $3
+  AND p.status = 'NORMAL'::post_status
+  AND p.visibility IN (
+    'PUBLIC'::post_visibility,
+    'FOLLOWERS'::post_visibility
+  )
+ORDER BY p.created_at DESC,
+  p.uid DESC
+LIMIT $4::int ON CONFLICT DO NOTHING
+`
+
+type BackfillTimelineFromAuthorParams struct {
+	OwnerUid        uuid.UUID
+	FanoutThreshold int32
+	AuthorUid       uuid.UUID
+	MaxEntries      int32
+}
+
+func (q *Queries) BackfillTimelineFromAuthor(ctx context.Context, arg BackfillTimelineFromAuthorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, backfillTimelineFromAuthor,
+		arg.OwnerUid,
+		arg.FanoutThreshold,
+		arg.AuthorUid,
+		arg.MaxEntries,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimTimelineFanout = `-- name: ClaimTimelineFanout :one
+SELECT post_uid
+FROM timeline_fanout_outbox
+ORDER BY enqueued_at
+LIMIT 1 FOR
+UPDATE SKIP LOCKED
+`
+
+func (q *Queries) ClaimTimelineFanout(ctx context.Context) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, claimTimelineFanout)
+	var post_uid uuid.UUID
+	err := row.Scan(&post_uid)
+	return post_uid, err
+}
+
+const deleteTimelineEntriesByAuthor = `-- name: DeleteTimelineEntriesByAuthor :execrows
+DELETE FROM timeline_entries
+WHERE owner_uid = $1
+  AND author_uid = $2
+`
+
+type DeleteTimelineEntriesByAuthorParams struct {
+	OwnerUid  uuid.UUID
+	AuthorUid uuid.UUID
+}
+
+func (q *Queries) DeleteTimelineEntriesByAuthor(ctx context.Context, arg DeleteTimelineEntriesByAuthorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTimelineEntriesByAuthor, arg.OwnerUid, arg.AuthorUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTimelineFanout = `-- name: DeleteTimelineFanout :exec
+DELETE FROM timeline_fanout_outbox
+WHERE post_uid = $1
+`
+
+func (q *Queries) DeleteTimelineFanout(ctx context.Context, postUid uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteTimelineFanout, postUid)
+	return err
+}
+
+const enqueueTimelineFanout = `-- name: EnqueueTimelineFanout :exec
+INSERT INTO timeline_fanout_outbox (post_uid)
+VALUES ($1) ON CONFLICT DO NOTHING
+`
+
+func (q *Queries) EnqueueTimelineFanout(ctx context.Context, postUid uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, enqueueTimelineFanout, postUid)
+	return err
+}
+
+const fanoutPostToFollowers = `-- name: FanoutPostToFollowers :execrows
+INSERT INTO timeline_entries (owner_uid, post_uid, author_uid, created_at)
+SELECT uf.follower_uid,
+  p.uid,
+  p.author,
+  p.created_at
+FROM posts p
+  JOIN users u ON u.uid = p.author
+  AND u.followers_count < $1::int
+  JOIN user_follows uf ON uf.followee_uid = p.author
+WHERE p.uid = $2
+  AND p.status = 'NORMAL'::post_status
+  AND p.visibility IN (
+    'PUBLIC'::post_visibility,
+    'FOLLOWERS'::post_visibility
+  ) ON CONFLICT DO NOTHING
+`
+
+type FanoutPostToFollowersParams struct {
+	FanoutThreshold int32
+	PostUid         uuid.UUID
+}
+
+func (q *Queries) FanoutPostToFollowers(ctx context.Context, arg FanoutPostToFollowersParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, fanoutPostToFollowers, arg.FanoutThreshold, arg.PostUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const rebuildTimelineEntries = `-- name: RebuildTimelineEntries :execrows
+INSERT INTO timeline_entries (owner_uid, post_uid, author_uid, created_at)
+SELECT ranked.owner_uid,
+  ranked.post_uid,
+  ranked.author_uid,
+  ranked.created_at
+FROM (
+    SELECT uf.follower_uid AS owner_uid,
+      p.uid AS post_uid,
+      p.author AS author_uid,
+      p.created_at,
+      row_number() OVER (
+        PARTITION BY uf.follower_uid
+        ORDER BY p.created_at DESC,
+          p.uid DESC
+      ) AS rn
+    FROM user_follows uf
+      JOIN users u ON u.uid = uf.followee_uid
+      AND u.followers_count < $1::int
+      JOIN posts p ON p.author = uf.followee_uid
+      AND p.status = 'NORMAL'::post_status
+      AND p.visibility IN (
+        'PUBLIC'::post_visibility,
+        'FOLLOWERS'::post_visibility
+      )
+  ) ranked
+WHERE ranked.rn <= $2::int
+`
+
+type RebuildTimelineEntriesParams struct {
+	FanoutThreshold int32
+	MaxEntries      int32
+}
+
+func (q *Queries) RebuildTimelineEntries(ctx context.Context, arg RebuildTimelineEntriesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, rebuildTimelineEntries, arg.FanoutThreshold, arg.MaxEntries)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const requeueTimelineFanout = `-- name: RequeueTimelineFanout :exec
+UPDATE timeline_fanout_outbox
+SET enqueued_at = now()
+WHERE post_uid = $1
+`
+
+func (q *Queries) RequeueTimelineFanout(ctx context.Context, postUid uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, requeueTimelineFanout, postUid)
+	return err
+}
+
+const trimFollowerTimelines = `-- name: TrimFollowerTimelines :execrows
+DELETE FROM timeline_entries te USING (
+    SELECT uf.follower_uid AS owner_uid,
+      cutoff.created_at,
+      cutoff.post_uid
+    FROM posts p
+      JOIN user_follows uf ON uf.followee_uid = p.author
+      CROSS JOIN LATERAL (
+        SELECT e.created_at,
+          e.post_uid
+        FROM timeline_entries e
+        WHERE e.owner_uid = uf.follower_uid
+        ORDER BY e.created_at DESC,
+          e.post_uid DESC OFFSET $1::int
+        LIMIT 1
+      ) cutoff
+    WHERE p.uid = $2
+  ) cutoffs
+WHERE te.owner_uid = cutoffs.owner_uid
+  AND (te.created_at, te.post_uid) <= (cutoffs.created_at, cutoffs.post_uid)
+`
+
+type TrimFollowerTimelinesParams struct {
+	MaxEntries int32
+	PostUid    uuid.UUID
+}
+
+func (q *Queries) TrimFollowerTimelines(ctx context.Context, arg TrimFollowerTimelinesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, trimFollowerTimelines, arg.MaxEntries, arg.PostUid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const truncateTimelineEntries = `-- name: TruncateTimelineEntries :exec
+TRUNCATE timeline_entries
+`
+
+func (q *Queries) TruncateTimelineEntries(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, truncateTimelineEntries)
+	return err
+}
@@ -13,6 +13,90 @@ import (
 	"github.com/google/uuid"
 )
 
+const autocompleteUsers = `-- name: AutocompleteUsers :many
+SELECT u.uid,
+  u.username,
+  u.role,
+  u.nickname,
+  u.avatar_url,
+  u.followers_count,
+  u.following_count,
+  (uf.follower_uid IS NOT NULL)::boolean AS is_following
+FROM users u
+  LEFT JOIN user_follows uf ON uf.followee_uid = u.uid
+  AND uf.follower_uid = $1
+WHERE u.status = 'NORMAL'::user_status
+  AND u.uid <> $1
+  AND (
+    lower(u.username) LIKE lower($2::text) || '%'
+    OR lower(u.nickname) LIKE lower($2::text) || '%'
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM user_blocks ub
+    WHERE (
+        ub.blocker_uid = $1
+        AND ub.blocked_uid = u.uid
+      )
+      OR (
+        ub.blocker_uid = u.uid
+        AND ub.blocked_uid = $1
+      )
+  )
+ORDER BY is_following DESC,
+  u.followers_count DESC,
+  u.username
+LIMIT 10
+`
+
+type AutocompleteUsersParams struct {
+	Viewer uuid.UUID
+	Prefix string
+}
+
+type AutocompleteUsersRow struct {
+	Uid            uuid.UUID
+	Username       string
+	Role           UserRole
+	Nickname       string
+	AvatarUrl      string
+	FollowersCount int32
+	FollowingCount int32
+	IsFollowing    bool
+}
+
+func (q *Queries) AutocompleteUsers(ctx context.Context, arg AutocompleteUsersParams) ([]AutocompleteUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, autocompleteUsers, arg.Viewer, arg.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AutocompleteUsersRow
+	for rows.Next() {
+		var i AutocompleteUsersRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Username,
+			&i.Role,
+			&i.Nickname,
+			&i.AvatarUrl,
+			&i.FollowersCount,
+			&i.FollowingCount,
+			&i.IsFollowing,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createUser = `-- name: CreateUser :exec
 INSERT INTO users (
     username,
@@ -53,6 +137,7 @@ SELECT uid,
   followers_count,
   following_count,
   description,
+  is_private,
   status,
   created_at
 FROM users
@@ -70,6 +155,7 @@ type GetUserByUidRow struct {
 	FollowersCount int32
 	FollowingCount int32
 	Description    string
+	IsPrivate      bool
 	Status         UserStatus
 	CreatedAt      time.Time
 }
@@ -87,6 +173,7 @@ func (q *Queries) GetUserByUid(ctx context.Context, uid uuid.UUID) (GetUserByUid
 		&i.FollowersCount,
 		&i.FollowingCount,
 		&i.Description,
+		&i.IsPrivate,
 		&i.Status,
 		&i.CreatedAt,
 	)
@@ -146,12 +233,27 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUs
 	return i, err
 }
 
+const getUserPasswordHash = `-- name: GetUserPasswordHash :one
+SELECT password_hash
+FROM users
+WHERE uid = $1
+  AND status = 'NORMAL'::user_status
+`
+
+func (q *Queries) GetUserPasswordHash(ctx context.Context, uid uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserPasswordHash, uid)
+	var password_hash string
+	err := row.Scan(&password_hash)
+	return password_hash, err
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
 SET username = COALESCE($2, username),
   email = COALESCE($3, email),
   nickname = COALESCE($4, nickname),
   avatar_url = COALESCE($5, avatar_url),
+  is_private = COALESCE($6::boolean, is_private),
   updated_at = now()
 WHERE uid = $1
   AND status = 'NORMAL'::user_status
@@ -163,6 +265,7 @@ type UpdateUserParams struct {
 	Email     sql.NullString
 	Nickname  sql.NullString
 	AvatarUrl sql.NullString
+	IsPrivate sql.NullBool
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
@@ -172,6 +275,23 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
 		arg.Email,
 		arg.Nickname,
 		arg.AvatarUrl,
+		arg.IsPrivate,
 	)
 	return err
 }
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $1
+WHERE uid = $2
+`
+
+type UpdateUserPasswordParams struct {
+	PasswordHash string
+	Uid          uuid.UUID
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserPassword, arg.PasswordHash, arg.Uid)
+	return err
+}
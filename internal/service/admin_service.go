@@ -0,0 +1,400 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/auth"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// roleRank orders user roles; an admin may only act on users ranked below them.
+var roleRank = map[db.UserRole]int{
+	db.UserRoleUSER:  1,
+	db.UserRoleADMIN: 2,
+	db.UserRoleHOST:  3,
+}
+
+type AdminService struct {
+	db       *db.Queries
+	dbx      *sql.DB
+	denylist *auth.Denylist
+}
+
+func NewAdminService(dbx *sql.DB, denylist *auth.Denylist) *AdminService {
+	return &AdminService{
+		db:       db.New(dbx),
+		dbx:      dbx,
+		denylist: denylist,
+	}
+}
+
+// SuspendUser archives the user and revokes the access tokens they hold; their refresh
+// tokens already stop working for archived users.
+func (s *AdminService) SuspendUser(ctx context.Context, uid string, req *api.SuspendUserRequest) error {
+	if err := s.setUserStatus(ctx, uid, req.Uid, db.UserStatusARCHIVED, db.AdminActionSUSPENDUSER, req.Reason); err != nil {
+		return err
+	}
+	return s.denylist.DenyUser(ctx, req.Uid)
+}
+
+func (s *AdminService) RestoreUser(ctx context.Context, uid string, req *api.RestoreUserRequest) error {
+	return s.setUserStatus(ctx, uid, req.Uid, db.UserStatusNORMAL, db.AdminActionRESTOREUSER, req.Reason)
+}
+
+func (s *AdminService) setUserStatus(ctx context.Context, actorUid, targetUid string, status db.UserStatus, action db.AdminAction, reason string) error {
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		if _, err := s.checkOutranks(ctx, qtx, actorUid, targetUid); err != nil {
+			return err
+		}
+		affected, err := qtx.SetUserStatus(ctx, db.SetUserStatusParams{
+			Uid:    util.UUID(targetUid),
+			Status: status,
+		})
+		if err != nil {
+			return fmt.Errorf("set user status: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("user is already %s", status)
+		}
+		return s.audit(ctx, qtx, actorUid, action, db.AdminTargetTypeUSER, targetUid, reason, "")
+	})
+}
+
+func (s *AdminService) SetUserRole(ctx context.Context, uid string, req *api.SetUserRoleRequest) error {
+	role := db.UserRole(req.Role)
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		actor, err := s.checkOutranks(ctx, qtx, uid, req.Uid)
+		if err != nil {
+			return err
+		}
+		if roleRank[role] >= roleRank[actor.Role] {
+			return fmt.Errorf("no permission to grant role %s", role)
+		}
+		affected, err := qtx.SetUserRole(ctx, db.SetUserRoleParams{
+			Uid:  util.UUID(req.Uid),
+			Role: role,
+		})
+		if err != nil {
+			return fmt.Errorf("set user role: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("user already has role %s", role)
+		}
+		return s.audit(ctx, qtx, uid, db.AdminActionSETUSERROLE, db.AdminTargetTypeUSER, req.Uid, req.Reason, string(role))
+	})
+}
+
+// checkOutranks loads the actor and target and rejects the action unless the actor
+// ranks strictly above the target.
+func (s *AdminService) checkOutranks(ctx context.Context, qtx *db.Queries, actorUid, targetUid string) (db.GetUserRoleAndStatusRow, error) {
+	actor, err := qtx.GetUserRoleAndStatus(ctx, util.UUID(actorUid))
+	if err != nil {
+		return db.GetUserRoleAndStatusRow{}, fmt.Errorf("get actor: %w", err)
+	}
+	target, err := qtx.GetUserRoleAndStatus(ctx, util.UUID(targetUid))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.GetUserRoleAndStatusRow{}, fmt.Errorf("user not found")
+		}
+		return db.GetUserRoleAndStatusRow{}, fmt.Errorf("get user: %w", err)
+	}
+	if roleRank[actor.Role] <= roleRank[target.Role] {
+		return db.GetUserRoleAndStatusRow{}, fmt.Errorf("no permission")
+	}
+	return actor, nil
+}
+
+func (s *AdminService) ArchivePost(ctx context.Context, uid string, req *api.ArchivePostRequest) error {
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		changed, err := setPostStatus(ctx, qtx, util.UUID(req.Uid), db.PostStatusARCHIVED)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return fmt.Errorf("post not found or already archived")
+		}
+		return s.audit(ctx, qtx, uid, db.AdminActionARCHIVEPOST, db.AdminTargetTypePOST, req.Uid, req.Reason, "")
+	})
+}
+
+func (s *AdminService) RestorePost(ctx context.Context, uid string, req *api.RestorePostRequest) error {
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		changed, err := setPostStatus(ctx, qtx, util.UUID(req.Uid), db.PostStatusNORMAL)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return fmt.Errorf("post not found or already normal")
+		}
+		return s.audit(ctx, qtx, uid, db.AdminActionRESTOREPOST, db.AdminTargetTypePOST, req.Uid, req.Reason, "")
+	})
+}
+
+// ListPostRevisions lists the edit history of any post, including archived posts and
+// those hidden from the moderator, so edits made after a report can be reviewed.
+func (s *AdminService) ListPostRevisions(ctx context.Context, req *api.ListPostRevisionsRequest) (*api.ListPostRevisionsResponse, error) {
+	return listPostRevisions(ctx, s.db, req)
+}
+
+func (s *AdminService) ArchiveComment(ctx context.Context, uid string, req *api.ArchiveCommentRequest) error {
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		changed, err := setCommentStatus(ctx, qtx, util.UUID(req.Uid), db.CommentStatusARCHIVED)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return fmt.Errorf("comment is already archived")
+		}
+		return s.audit(ctx, qtx, uid, db.AdminActionARCHIVECOMMENT, db.AdminTargetTypeCOMMENT, req.Uid, req.Reason, "")
+	})
+}
+
+func (s *AdminService) RestoreComment(ctx context.Context, uid string, req *api.RestoreCommentRequest) error {
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		changed, err := setCommentStatus(ctx, qtx, util.UUID(req.Uid), db.CommentStatusNORMAL)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return fmt.Errorf("comment is already normal")
+		}
+		return s.audit(ctx, qtx, uid, db.AdminActionRESTORECOMMENT, db.AdminTargetTypeCOMMENT, req.Uid, req.Reason, "")
+	})
+}
+
+func (s *AdminService) audit(ctx context.Context, qtx *db.Queries, actorUid string, action db.AdminAction, targetType db.AdminTargetType, targetUid, reason, detail string) error {
+	if err := qtx.CreateAdminAuditLog(ctx, db.CreateAdminAuditLogParams{
+		ActorUid:   util.UUID(actorUid),
+		Action:     action,
+		TargetType: targetType,
+		TargetUid:  util.UUID(targetUid),
+		Reason:     reason,
+		Detail:     detail,
+	}); err != nil {
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	return nil
+}
+
+// setPostStatus archives or restores any post regardless of its author. It reports
+// false when the post does not exist or already has the given status.
+func setPostStatus(ctx context.Context, qtx *db.Queries, postUid uuid.UUID, status db.PostStatus) (bool, error) {
+	affected, err := qtx.SetPostStatus(ctx, db.SetPostStatusParams{
+		Uid:    postUid,
+		Status: status,
+	})
+	if err != nil {
+		return false, fmt.Errorf("set post status: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// setCommentStatus archives or restores any comment and keeps the post comment
+// count or root reply count in sync, mirroring CommentService.DeleteComment. It
+// reports false when the comment already has the given status.
+func setCommentStatus(ctx context.Context, qtx *db.Queries, commentUid uuid.UUID, status db.CommentStatus) (bool, error) {
+	commentRow, err := qtx.GetCommentMetaForAdmin(ctx, commentUid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("comment not found")
+		}
+		return false, fmt.Errorf("get comment: %w", err)
+	}
+	affected, err := qtx.SetCommentStatus(ctx, db.SetCommentStatusParams{
+		Uid:    commentUid,
+		Status: status,
+	})
+	if err != nil {
+		return false, fmt.Errorf("set comment status: %w", err)
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	archive := status == db.CommentStatusARCHIVED
+	if commentRow.RootUid == commentUid {
+		if archive {
+			_, err = qtx.DecrementPostCommentCount(ctx, commentRow.PostUid)
+		} else {
+			_, err = qtx.IncrementPostCommentCount(ctx, commentRow.PostUid)
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("update post comment count: %w", err)
+		}
+		return true, nil
+	}
+	if archive {
+		_, err = qtx.DecrementCommentReplyCount(ctx, commentRow.RootUid)
+	} else {
+		_, err = qtx.IncrementCommentReplyCount(ctx, commentRow.RootUid)
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("update comment reply count: %w", err)
+	}
+	return true, nil
+}
+
+func (s *AdminService) ListArchivedUsers(ctx context.Context, req *api.ListArchivedRequest) (*api.ListArchivedUsersResponse, error) {
+	rows, err := s.db.ListArchivedUsers(ctx, db.ListArchivedUsersParams{
+		CursorArchivedAt: sql.NullTime{Time: time.Unix(req.CursorArchivedAt, 0).UTC(), Valid: req.CursorArchivedAt != 0},
+		CursorID:         uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list archived users: %w", err)
+	}
+
+	users := make([]*api.ArchivedUser, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.ArchivedUser{
+			User: &api.User{
+				Uid:            row.Uid.String(),
+				Username:       row.Username,
+				Role:           string(row.Role),
+				Email:          row.Email,
+				Nickname:       row.Nickname,
+				AvatarUrl:      row.AvatarUrl,
+				FollowersCount: row.FollowersCount,
+				FollowingCount: row.FollowingCount,
+			},
+			ArchivedAt: row.UpdatedAt.Unix(),
+		})
+	}
+
+	var nextCursorArchivedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorArchivedAt = last.UpdatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListArchivedUsersResponse{
+		Users:                users,
+		NextCursorArchivedAt: nextCursorArchivedAt,
+		NextCursorId:         nextCursorID,
+	}, nil
+}
+
+func (s *AdminService) ListArchivedPosts(ctx context.Context, req *api.ListArchivedRequest) (*api.ListArchivedPostsResponse, error) {
+	rows, err := s.db.ListArchivedPosts(ctx, db.ListArchivedPostsParams{
+		CursorArchivedAt: sql.NullTime{Time: time.Unix(req.CursorArchivedAt, 0).UTC(), Valid: req.CursorArchivedAt != 0},
+		CursorID:         uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list archived posts: %w", err)
+	}
+
+	posts := make([]*api.ArchivedPost, 0, len(rows))
+	for _, row := range rows {
+		posts = append(posts, &api.ArchivedPost{
+			Uid:            row.Uid.String(),
+			AuthorUid:      row.Author.String(),
+			AuthorNickname: row.AuthorNickname.String,
+			Text:           row.Text,
+			Images:         row.Images,
+			Visibility:     string(row.Visibility),
+			CreatedAt:      row.CreatedAt.Unix(),
+			ArchivedAt:     row.UpdatedAt.Unix(),
+		})
+	}
+
+	var nextCursorArchivedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorArchivedAt = last.UpdatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListArchivedPostsResponse{
+		Posts:                posts,
+		NextCursorArchivedAt: nextCursorArchivedAt,
+		NextCursorId:         nextCursorID,
+	}, nil
+}
+
+func (s *AdminService) ListArchivedComments(ctx context.Context, req *api.ListArchivedRequest) (*api.ListArchivedCommentsResponse, error) {
+	rows, err := s.db.ListArchivedComments(ctx, db.ListArchivedCommentsParams{
+		CursorArchivedAt: sql.NullTime{Time: time.Unix(req.CursorArchivedAt, 0).UTC(), Valid: req.CursorArchivedAt != 0},
+		CursorID:         uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list archived comments: %w", err)
+	}
+
+	comments := make([]*api.ArchivedComment, 0, len(rows))
+	for _, row := range rows {
+		comments = append(comments, &api.ArchivedComment{
+			Uid:            row.Uid.String(),
+			PostUid:        row.PostUid.String(),
+			RootUid:        row.RootUid.String(),
+			AuthorUid:      row.AuthorUid.String(),
+			AuthorNickname: row.AuthorNickname.String,
+			Content:        row.Content,
+			Images:         row.Images,
+			CreatedAt:      row.CreatedAt.Unix(),
+			ArchivedAt:     row.UpdatedAt.Unix(),
+		})
+	}
+
+	var nextCursorArchivedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorArchivedAt = last.UpdatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListArchivedCommentsResponse{
+		Comments:             comments,
+		NextCursorArchivedAt: nextCursorArchivedAt,
+		NextCursorId:         nextCursorID,
+	}, nil
+}
+
+func (s *AdminService) ListAuditLogs(ctx context.Context, req *api.ListAuditLogsRequest) (*api.ListAuditLogsResponse, error) {
+	rows, err := s.db.ListAdminAuditLogs(ctx, db.ListAdminAuditLogsParams{
+		TargetUid:       uuid.NullUUID{UUID: util.UUID(req.TargetUid), Valid: req.TargetUid != ""},
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list audit logs: %w", err)
+	}
+
+	logs := make([]*api.AuditLog, 0, len(rows))
+	for _, row := range rows {
+		logs = append(logs, &api.AuditLog{
+			Uid:           row.Uid.String(),
+			ActorUid:      row.ActorUid.String(),
+			ActorNickname: row.ActorNickname.String,
+			Action:        string(row.Action),
+			TargetType:    string(row.TargetType),
+			TargetUid:     row.TargetUid.String(),
+			Reason:        row.Reason,
+			Detail:        row.Detail,
+			CreatedAt:     row.CreatedAt.Unix(),
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListAuditLogsResponse{
+		Logs:                logs,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
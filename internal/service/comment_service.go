@@ -2,6 +2,7 @@ package service
 
 import (
 	"aeibi/api"
+	"aeibi/internal/event"
 	"aeibi/internal/repository/db"
 	"aeibi/util"
 	"context"
@@ -14,14 +15,18 @@ import (
 )
 
 type CommentService struct {
-	db  *db.Queries
-	dbx *sql.DB
+	db            *db.Queries
+	dbx           *sql.DB
+	notifications *NotificationService
+	events        event.Publisher
 }
 
-func NewCommentService(dbx *sql.DB) *CommentService {
+func NewCommentService(dbx *sql.DB, notifications *NotificationService, events event.Publisher) *CommentService {
 	return &CommentService{
-		db:  db.New(dbx),
-		dbx: dbx,
+		db:            db.New(dbx),
+		dbx:           dbx,
+		notifications: notifications,
+		events:        events,
 	}
 }
 
@@ -30,19 +35,48 @@ func (s *CommentService) CreateTopComment(ctx context.Context, uid string, req *
 	postUid := util.UUID(req.PostUid)
 	authorUid := util.UUID(uid)
 	var resp *api.CreateTopCommentResponse
+	var pending []event.Event
 	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
-		_, err := qtx.CreateComment(ctx, db.CreateCommentParams{
+		post, err := checkInteractable(ctx, qtx, authorUid, postUid)
+		if err != nil {
+			return err
+		}
+		entities, err := s.notifications.ResolveMentions(ctx, qtx, authorUid, req.Content)
+		if err != nil {
+			return err
+		}
+		encoded, err := util.EncodeEntities(entities)
+		if err != nil {
+			return fmt.Errorf("encode entities: %w", err)
+		}
+		_, err = qtx.CreateComment(ctx, db.CreateCommentParams{
 			Uid:       commentUid,
 			PostUid:   postUid,
 			AuthorUid: authorUid,
 			RootUid:   commentUid,
 			Content:   req.Content,
+			Entities:  encoded,
 			Images:    req.Images,
 		})
 		if err != nil {
 			return err
 		}
 		commentCount, err := qtx.IncrementPostCommentCount(ctx, postUid)
+		if err != nil {
+			return fmt.Errorf("increment post comment count: %w", err)
+		}
+		ev, err := s.notifications.Notify(ctx, qtx, db.NotificationTypeCOMMENT, post.Author, authorUid, commentUid, uuid.NullUUID{UUID: postUid, Valid: true})
+		if err != nil {
+			return err
+		}
+		if ev != nil {
+			pending = append(pending, *ev)
+		}
+		mentioned, err := s.notifications.SyncMentions(ctx, qtx, db.MentionSourceCOMMENT, commentUid, authorUid, postUid, entities)
+		if err != nil {
+			return err
+		}
+		pending = append(pending, mentioned...)
 		resp = &api.CreateTopCommentResponse{
 			Uid:          commentUid.String(),
 			CommentCount: commentCount,
@@ -51,29 +85,74 @@ func (s *CommentService) CreateTopComment(ctx context.Context, uid string, req *
 	}); err != nil {
 		return nil, err
 	}
+	pending = append(pending,
+		event.Comment(&api.CommentEvent{
+			Uid:       resp.Uid,
+			PostUid:   req.PostUid,
+			RootUid:   resp.Uid,
+			AuthorUid: uid,
+			Content:   req.Content,
+		}),
+		event.Counter(req.PostUid, "POST", req.PostUid, "COMMENT", resp.CommentCount),
+	)
+	s.events.Publish(ctx, pending...)
 	return resp, nil
 }
 
 func (s *CommentService) CreateReply(ctx context.Context, uid string, req *api.CreateReplyRequest) (*api.CreateReplyResponse, error) {
 	replyUid := uuid.New()
 	parentUid := util.UUID(req.ParentUid)
+	authorUid := util.UUID(uid)
 	commentRow, err := s.db.GetCommentMetaByUid(ctx, parentUid)
 	if err != nil {
 		return nil, err
 	}
 	var resp *api.CreateReplyResponse
+	var pending []event.Event
 	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
-		_, err := qtx.CreateComment(ctx, db.CreateCommentParams{
+		if _, err := checkInteractable(ctx, qtx, authorUid, commentRow.PostUid); err != nil {
+			return err
+		}
+		if err := checkNotBlocked(ctx, qtx, authorUid, commentRow.AuthorUid); err != nil {
+			return err
+		}
+		entities, err := s.notifications.ResolveMentions(ctx, qtx, authorUid, req.Content)
+		if err != nil {
+			return err
+		}
+		encoded, err := util.EncodeEntities(entities)
+		if err != nil {
+			return fmt.Errorf("encode entities: %w", err)
+		}
+		_, err = qtx.CreateComment(ctx, db.CreateCommentParams{
 			Uid:              replyUid,
 			PostUid:          commentRow.PostUid,
 			RootUid:          commentRow.RootUid,
+			AuthorUid:        authorUid,
 			ParentUid:        uuid.NullUUID{UUID: parentUid, Valid: true},
 			ReplyToAuthorUid: uuid.NullUUID{UUID: commentRow.AuthorUid, Valid: commentRow.RootUid == parentUid},
+			Content:          req.Content,
+			Entities:         encoded,
 		})
 		if err != nil {
 			return err
 		}
 		replyCount, err := qtx.IncrementCommentReplyCount(ctx, commentRow.RootUid)
+		if err != nil {
+			return fmt.Errorf("increment comment reply count: %w", err)
+		}
+		ev, err := s.notifications.Notify(ctx, qtx, db.NotificationTypeREPLY, commentRow.AuthorUid, authorUid, replyUid, uuid.NullUUID{UUID: commentRow.PostUid, Valid: true})
+		if err != nil {
+			return err
+		}
+		if ev != nil {
+			pending = append(pending, *ev)
+		}
+		mentioned, err := s.notifications.SyncMentions(ctx, qtx, db.MentionSourceCOMMENT, replyUid, authorUid, commentRow.PostUid, entities)
+		if err != nil {
+			return err
+		}
+		pending = append(pending, mentioned...)
 		resp = &api.CreateReplyResponse{
 			Uid:        replyUid.String(),
 			ReplyCount: replyCount,
@@ -82,12 +161,30 @@ func (s *CommentService) CreateReply(ctx context.Context, uid string, req *api.C
 	}); err != nil {
 		return nil, err
 	}
+	postUid := commentRow.PostUid.String()
+	rootUid := commentRow.RootUid.String()
+	pending = append(pending,
+		event.Comment(&api.CommentEvent{
+			Uid:       resp.Uid,
+			PostUid:   postUid,
+			RootUid:   rootUid,
+			ParentUid: req.ParentUid,
+			AuthorUid: uid,
+			Content:   req.Content,
+		}),
+		event.Counter(postUid, "COMMENT", rootUid, "REPLY", resp.ReplyCount),
+	)
+	s.events.Publish(ctx, pending...)
 	return resp, nil
 }
 
 func (s *CommentService) ListTopComments(ctx context.Context, viewerUid string, req *api.ListTopCommentsRequest) (*api.ListTopCommentsResponse, error) {
+	viewer := uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""}
+	if _, err := checkVisible(ctx, s.db, viewer, util.UUID(req.PostUid)); err != nil {
+		return nil, err
+	}
 	rows, err := s.db.ListTopComments(ctx, db.ListTopCommentsParams{
-		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		Viewer:          viewer,
 		PostUid:         util.UUID(req.PostUid),
 		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
 		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
@@ -118,6 +215,7 @@ func (s *CommentService) ListTopComments(ctx context.Context, viewerUid string,
 			ParentUid:        parentUid,
 			ReplyToAuthorUid: replyToAuthorUid,
 			Content:          row.Content,
+			Entities:         textEntities(row.Entities),
 			Images:           row.Images,
 			ReplyCount:       row.ReplyCount,
 			LikeCount:        row.LikeCount,
@@ -143,8 +241,19 @@ func (s *CommentService) ListTopComments(ctx context.Context, viewerUid string,
 }
 
 func (s *CommentService) ListReplies(ctx context.Context, viewerUid string, req *api.ListRepliesRequest) (*api.ListRepliesResponse, error) {
+	viewer := uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""}
+	root, err := s.db.GetCommentMetaByUid(ctx, util.UUID(req.Uid))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("comment not found")
+		}
+		return nil, fmt.Errorf("get comment: %w", err)
+	}
+	if _, err := checkVisible(ctx, s.db, viewer, root.PostUid); err != nil {
+		return nil, err
+	}
 	rows, err := s.db.ListReplies(ctx, db.ListRepliesParams{
-		Viewer:  uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		Viewer:  viewer,
 		RootUid: util.UUID(req.Uid),
 		Page:    req.Page,
 	})
@@ -174,6 +283,7 @@ func (s *CommentService) ListReplies(ctx context.Context, viewerUid string, req
 			ParentUid:        parentUid,
 			ReplyToAuthorUid: replyToAuthorUid,
 			Content:          row.Content,
+			Entities:         textEntities(row.Entities),
 			Images:           row.Images,
 			ReplyCount:       row.ReplyCount,
 			LikeCount:        row.LikeCount,
@@ -236,26 +346,55 @@ func (s *CommentService) LikeComment(ctx context.Context, uid string, req *api.L
 	commentUid := util.UUID(req.Uid)
 	userUid := util.UUID(uid)
 
-	var (
-		count int32
-		err   error
-	)
-
-	switch req.Action {
-	case api.ToggleAction_TOGGLE_ACTION_ADD:
-		count, err = s.db.AddCommentLike(ctx, db.AddCommentLikeParams{
-			CommentUid: commentUid,
-			UserUid:    userUid,
-		})
-	default:
-		count, err = s.db.RemoveCommentLike(ctx, db.RemoveCommentLikeParams{
-			CommentUid: commentUid,
-			UserUid:    userUid,
-		})
-	}
-	if err != nil {
-		return nil, fmt.Errorf("comment like: %w", err)
+	var count int32
+	var pending []event.Event
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		var err error
+		switch req.Action {
+		case api.ToggleAction_TOGGLE_ACTION_ADD:
+			count, err = qtx.AddCommentLike(ctx, db.AddCommentLikeParams{
+				CommentUid: commentUid,
+				UserUid:    userUid,
+			})
+		default:
+			count, err = qtx.RemoveCommentLike(ctx, db.RemoveCommentLikeParams{
+				CommentUid: commentUid,
+				UserUid:    userUid,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("comment like: %w", err)
+		}
+		commentRow, err := qtx.GetCommentMetaByUid(ctx, commentUid)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("get comment: %w", err)
+		}
+		if req.Action != api.ToggleAction_TOGGLE_ACTION_ADD {
+			pending = append(pending, event.Counter(commentRow.PostUid.String(), "COMMENT", req.Uid, "LIKE", count))
+			return nil
+		}
+		if _, err := checkInteractable(ctx, qtx, userUid, commentRow.PostUid); err != nil {
+			return err
+		}
+		if err := checkNotBlocked(ctx, qtx, userUid, commentRow.AuthorUid); err != nil {
+			return err
+		}
+		pending = append(pending, event.Counter(commentRow.PostUid.String(), "COMMENT", req.Uid, "LIKE", count))
+		ev, err := s.notifications.Notify(ctx, qtx, db.NotificationTypeCOMMENTLIKE, commentRow.AuthorUid, userUid, commentUid, uuid.NullUUID{UUID: commentRow.PostUid, Valid: true})
+		if err != nil {
+			return err
+		}
+		if ev != nil {
+			pending = append(pending, *ev)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
+	s.events.Publish(ctx, pending...)
 	return &api.LikeCommentResponse{
 		Count: count,
 	}, nil
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/config"
+	"aeibi/internal/event"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const defaultEventHeartbeat = 30 * time.Second
+
+// EventService streams events from the hub to connected clients.
+type EventService struct {
+	db        *db.Queries
+	hub       *event.Hub
+	heartbeat time.Duration
+}
+
+func NewEventService(dbx *sql.DB, hub *event.Hub, cfg config.EventsConfig) *EventService {
+	s := &EventService{
+		db:        db.New(dbx),
+		hub:       hub,
+		heartbeat: cfg.Heartbeat,
+	}
+	if s.heartbeat <= 0 {
+		s.heartbeat = defaultEventHeartbeat
+	}
+	return s
+}
+
+// StreamEvents sends the viewer's own events and, when a post is given, that post's
+// comment and counter events until ctx is done. Heartbeats keep idle connections open.
+func (s *EventService) StreamEvents(ctx context.Context, viewerUid string, req *api.StreamEventsRequest, send func(*api.Event) error) error {
+	if req.PostUid != "" {
+		_, err := s.db.GetPostVisibilityByUid(ctx, db.GetPostVisibilityByUidParams{
+			Uid:    util.UUID(req.PostUid),
+			Viewer: uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("post not found")
+			}
+			return fmt.Errorf("get post: %w", err)
+		}
+	}
+
+	sub := s.hub.Subscribe(viewerUid, req.PostUid)
+	defer s.hub.Unsubscribe(sub)
+
+	ticker := time.NewTicker(s.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := send(&api.Event{Type: event.TypeHeartbeat, CreatedAt: time.Now().Unix()}); err != nil {
+				return err
+			}
+		case ev := <-sub.C():
+			if err := send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"aeibi/api"
+	"aeibi/internal/event"
 	"aeibi/internal/repository/db"
 	"aeibi/util"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,42 +15,143 @@ import (
 )
 
 type FollowService struct {
-	db *db.Queries
+	db            *db.Queries
+	dbx           *sql.DB
+	timeline      *TimelineService
+	notifications *NotificationService
+	events        event.Publisher
 }
 
-func NewFollowService(dbx *sql.DB) *FollowService {
-	return &FollowService{db: db.New(dbx)}
+func NewFollowService(dbx *sql.DB, timeline *TimelineService, notifications *NotificationService, events event.Publisher) *FollowService {
+	return &FollowService{
+		db:            db.New(dbx),
+		dbx:           dbx,
+		timeline:      timeline,
+		notifications: notifications,
+		events:        events,
+	}
 }
 
 func (s *FollowService) Follow(ctx context.Context, uid string, req *api.FollowRequest) (*api.FollowResponse, error) {
+	followerUid := util.UUID(uid)
+	followeeUid := util.UUID(req.Uid)
 	var followingCount int32
 	var followersCount int32
-	switch req.Action {
-	case api.ToggleAction_TOGGLE_ACTION_ADD:
-		row, err := s.db.AddFollow(ctx, db.AddFollowParams{
-			FollowerUid: util.UUID(uid),
-			FolloweeUid: util.UUID(req.Uid),
-		})
-		if err != nil {
-			return nil, fmt.Errorf("follow: %w", err)
-		}
-		followingCount = row.FollowingCount
-		followersCount = row.FollowersCount
-	default:
-		row, err := s.db.RemoveFollow(ctx, db.RemoveFollowParams{
-			FollowerUid: util.UUID(uid),
-			FolloweeUid: util.UUID(req.Uid),
-		})
-		if err != nil {
-			return nil, fmt.Errorf("follow: %w", err)
+	var requested bool
+	var pending []event.Event
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		switch req.Action {
+		case api.ToggleAction_TOGGLE_ACTION_ADD:
+			if err := checkNotBlocked(ctx, qtx, followerUid, followeeUid); err != nil {
+				return err
+			}
+			blocking, err := qtx.IsBlockedByAny(ctx, db.IsBlockedByAnyParams{
+				BlockerUids: []uuid.UUID{followerUid},
+				BlockedUid:  followeeUid,
+			})
+			if err != nil {
+				return fmt.Errorf("follow: %w", err)
+			}
+			if blocking {
+				return fmt.Errorf("unblock this user before following")
+			}
+			private, err := qtx.IsPrivateUser(ctx, followeeUid)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("user not found")
+				}
+				return fmt.Errorf("follow: %w", err)
+			}
+			if private {
+				// Private accounts approve their followers: leave a pending request
+				// unless the user already follows them.
+				following, err := qtx.IsFollowing(ctx, db.IsFollowingParams{
+					FollowerUid: followerUid,
+					FolloweeUid: followeeUid,
+				})
+				if err != nil {
+					return fmt.Errorf("follow: %w", err)
+				}
+				if !following {
+					created, err := qtx.CreateFollowRequest(ctx, db.CreateFollowRequestParams{
+						RequesterUid: followerUid,
+						TargetUid:    followeeUid,
+					})
+					if err != nil {
+						return fmt.Errorf("follow request: %w", err)
+					}
+					if created > 0 {
+						ev, err := s.notifications.Notify(ctx, qtx, db.NotificationTypeFOLLOWREQUEST, followeeUid, followerUid, followeeUid, uuid.NullUUID{})
+						if err != nil {
+							return fmt.Errorf("follow request: %w", err)
+						}
+						if ev != nil {
+							pending = append(pending, *ev)
+						}
+					}
+					requested = true
+				}
+				row, err := qtx.GetFollowCounts(ctx, db.GetFollowCountsParams{
+					FollowerUid: followerUid,
+					FolloweeUid: followeeUid,
+				})
+				if err != nil {
+					return fmt.Errorf("follow: %w", err)
+				}
+				followingCount = row.FollowingCount
+				followersCount = row.FollowersCount
+				return nil
+			}
+			row, err := qtx.AddFollow(ctx, db.AddFollowParams{
+				FollowerUid: followerUid,
+				FolloweeUid: followeeUid,
+			})
+			if err != nil {
+				return fmt.Errorf("follow: %w", err)
+			}
+			if err := s.timeline.Backfill(ctx, qtx, followerUid, followeeUid); err != nil {
+				return fmt.Errorf("follow: %w", err)
+			}
+			ev, err := s.notifications.Notify(ctx, qtx, db.NotificationTypeFOLLOW, followeeUid, followerUid, followeeUid, uuid.NullUUID{})
+			if err != nil {
+				return fmt.Errorf("follow: %w", err)
+			}
+			if ev != nil {
+				pending = append(pending, *ev)
+			}
+			followingCount = row.FollowingCount
+			followersCount = row.FollowersCount
+		default:
+			// Unfollowing also withdraws a pending request.
+			if _, err := qtx.DeleteFollowRequest(ctx, db.DeleteFollowRequestParams{
+				RequesterUid: followerUid,
+				TargetUid:    followeeUid,
+			}); err != nil {
+				return fmt.Errorf("follow: %w", err)
+			}
+			row, err := qtx.RemoveFollow(ctx, db.RemoveFollowParams{
+				FollowerUid: followerUid,
+				FolloweeUid: followeeUid,
+			})
+			if err != nil {
+				return fmt.Errorf("follow: %w", err)
+			}
+			if err := s.timeline.Remove(ctx, qtx, followerUid, followeeUid); err != nil {
+				return fmt.Errorf("follow: %w", err)
+			}
+			followingCount = row.FollowingCount
+			followersCount = row.FollowersCount
 		}
-		followingCount = row.FollowingCount
-		followersCount = row.FollowersCount
+		return nil
+	}); err != nil {
+		return nil, err
 	}
+	s.events.Publish(ctx, pending...)
 
 	return &api.FollowResponse{
 		FollowingCount: followingCount,
 		FollowersCount: followersCount,
+		Requested:      requested,
 	}, nil
 }
 
@@ -127,3 +230,120 @@ func (s *FollowService) ListMyFollowing(ctx context.Context, uid string, req *ap
 		NextCursorId:        nextCursorID,
 	}, nil
 }
+
+// ApproveFollowRequest turns the requester's pending request into a follow, updating
+// both users' counters exactly as a direct follow does.
+func (s *FollowService) ApproveFollowRequest(ctx context.Context, uid string, req *api.ApproveFollowRequestRequest) error {
+	targetUid := util.UUID(uid)
+	requesterUid := util.UUID(req.Uid)
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		affected, err := qtx.DeleteFollowRequest(ctx, db.DeleteFollowRequestParams{
+			RequesterUid: requesterUid,
+			TargetUid:    targetUid,
+		})
+		if err != nil {
+			return fmt.Errorf("approve follow request: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("follow request not found")
+		}
+		if _, err := qtx.AddFollow(ctx, db.AddFollowParams{
+			FollowerUid: requesterUid,
+			FolloweeUid: targetUid,
+		}); err != nil {
+			return fmt.Errorf("approve follow request: %w", err)
+		}
+		if err := s.timeline.Backfill(ctx, qtx, requesterUid, targetUid); err != nil {
+			return fmt.Errorf("approve follow request: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *FollowService) RejectFollowRequest(ctx context.Context, uid string, req *api.RejectFollowRequestRequest) error {
+	affected, err := s.db.DeleteFollowRequest(ctx, db.DeleteFollowRequestParams{
+		RequesterUid: util.UUID(req.Uid),
+		TargetUid:    util.UUID(uid),
+	})
+	if err != nil {
+		return fmt.Errorf("reject follow request: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("follow request not found")
+	}
+	return nil
+}
+
+func (s *FollowService) ListIncomingFollowRequests(ctx context.Context, uid string, req *api.ListIncomingFollowRequestsRequest) (*api.ListIncomingFollowRequestsResponse, error) {
+	rows, err := s.db.ListIncomingFollowRequests(ctx, db.ListIncomingFollowRequestsParams{
+		Uid:             util.UUID(uid),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list follow requests: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.RequestedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListIncomingFollowRequestsResponse{
+		Users:               users,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *FollowService) ListOutgoingFollowRequests(ctx context.Context, uid string, req *api.ListOutgoingFollowRequestsRequest) (*api.ListOutgoingFollowRequestsResponse, error) {
+	rows, err := s.db.ListOutgoingFollowRequests(ctx, db.ListOutgoingFollowRequestsParams{
+		Uid:             util.UUID(uid),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list follow requests: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.RequestedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListOutgoingFollowRequestsResponse{
+		Users:               users,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
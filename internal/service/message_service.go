@@ -0,0 +1,957 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/event"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxMessageLength   = 1000
+	maxMessageImages   = 9
+	maxGroupNameLength = 64
+	maxGroupMembers    = 500
+)
+
+// conversationRoleRank orders group roles; they are independent of the global user_role.
+var conversationRoleRank = map[db.ConversationRole]int{
+	db.ConversationRoleMEMBER: 1,
+	db.ConversationRoleADMIN:  2,
+	db.ConversationRoleOWNER:  3,
+}
+
+// MessageService stores conversations and their messages. Every member keeps a read
+// pointer (the newest message read) and a denormalized unread count; new messages and
+// read receipts are pushed to members through the event stream.
+type MessageService struct {
+	db     *db.Queries
+	dbx    *sql.DB
+	events event.Publisher
+}
+
+func NewMessageService(dbx *sql.DB, events event.Publisher) *MessageService {
+	return &MessageService{
+		db:     db.New(dbx),
+		dbx:    dbx,
+		events: events,
+	}
+}
+
+// SendMessage posts into an existing conversation, or into the direct conversation with
+// req.RecipientUid, creating it on first contact. The recipient's message permission is
+// checked on every send, so tightening it also stops existing conversations.
+func (s *MessageService) SendMessage(ctx context.Context, uid string, req *api.SendMessageRequest) (*api.SendMessageResponse, error) {
+	content := strings.TrimSpace(req.Content)
+	images := util.NormalizeStrings(req.Images)
+	if content == "" && len(images) == 0 {
+		return nil, fmt.Errorf("message is empty")
+	}
+	if utf8.RuneCountInString(content) > maxMessageLength {
+		return nil, fmt.Errorf("message is longer than %d characters", maxMessageLength)
+	}
+	if len(images) > maxMessageImages {
+		return nil, fmt.Errorf("message has more than %d images", maxMessageImages)
+	}
+	if err := s.checkImages(ctx, images); err != nil {
+		return nil, err
+	}
+
+	senderUid := util.UUID(uid)
+	var msg *api.Message
+	var recipients []uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		var conversationUid uuid.UUID
+		if req.ConversationUid != "" {
+			conversationUid = util.UUID(req.ConversationUid)
+			membership, err := qtx.GetConversationMembership(ctx, db.GetConversationMembershipParams{
+				ConversationUid: conversationUid,
+				UserUid:         senderUid,
+			})
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("conversation not found")
+				}
+				return fmt.Errorf("get conversation: %w", err)
+			}
+			if membership.Type == db.ConversationTypeDIRECT {
+				peerUid, err := qtx.GetDirectPeer(ctx, db.GetDirectPeerParams{
+					ConversationUid: conversationUid,
+					UserUid:         senderUid,
+				})
+				if err != nil {
+					return fmt.Errorf("get conversation peer: %w", err)
+				}
+				if err := checkMessagePermission(ctx, qtx, senderUid, peerUid); err != nil {
+					return err
+				}
+			}
+		} else {
+			recipientUid := util.UUID(req.RecipientUid)
+			if recipientUid == senderUid {
+				return fmt.Errorf("cannot message yourself")
+			}
+			if err := checkMessagePermission(ctx, qtx, senderUid, recipientUid); err != nil {
+				return err
+			}
+			conversation, err := qtx.UpsertDirectConversation(ctx, directKey(senderUid, recipientUid))
+			if err != nil {
+				return fmt.Errorf("create conversation: %w", err)
+			}
+			conversationUid = conversation.Uid
+			for _, member := range []uuid.UUID{senderUid, recipientUid} {
+				if _, err := qtx.AddConversationMember(ctx, db.AddConversationMemberParams{
+					ConversationUid: conversationUid,
+					UserUid:         member,
+					Role:            db.ConversationRoleMEMBER,
+				}); err != nil {
+					return fmt.Errorf("add conversation member: %w", err)
+				}
+			}
+		}
+
+		row, err := qtx.CreateMessage(ctx, db.CreateMessageParams{
+			ConversationUid: conversationUid,
+			SenderUid:       senderUid,
+			Content:         content,
+			Images:          images,
+		})
+		if err != nil {
+			return fmt.Errorf("create message: %w", err)
+		}
+		if err := qtx.TouchConversation(ctx, db.TouchConversationParams{
+			LastMessageUid: uuid.NullUUID{UUID: row.Uid, Valid: true},
+			LastMessageAt:  row.CreatedAt,
+			Uid:            conversationUid,
+		}); err != nil {
+			return fmt.Errorf("update conversation: %w", err)
+		}
+		recipients, err = qtx.IncrementUnreadMessages(ctx, db.IncrementUnreadMessagesParams{
+			ConversationUid: conversationUid,
+			SenderUid:       senderUid,
+		})
+		if err != nil {
+			return fmt.Errorf("increment unread messages: %w", err)
+		}
+
+		msg = &api.Message{
+			Uid:             row.Uid.String(),
+			ConversationUid: conversationUid.String(),
+			Sender: &api.MessageSender{
+				Uid:       uid,
+				Nickname:  row.SenderNickname,
+				AvatarUrl: row.SenderAvatarUrl,
+			},
+			Content:   content,
+			Images:    images,
+			Type:      string(db.MessageTypeTEXT),
+			CreatedAt: row.CreatedAt.Unix(),
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// The sender's other devices receive the message as well.
+	s.publishMessages(ctx, append(recipients, senderUid), msg)
+
+	return &api.SendMessageResponse{Message: msg}, nil
+}
+
+func (s *MessageService) ListConversations(ctx context.Context, uid string, req *api.ListConversationsRequest) (*api.ListConversationsResponse, error) {
+	rows, err := s.db.ListConversations(ctx, db.ListConversationsParams{
+		UserUid:         util.UUID(uid),
+		CursorUpdatedAt: sql.NullTime{Time: time.Unix(req.CursorUpdatedAt, 0).UTC(), Valid: req.CursorUpdatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list conversations: %w", err)
+	}
+	conversations := make([]*api.Conversation, 0, len(rows))
+	for _, row := range rows {
+		conversations = append(conversations, conversationFromRow(row))
+	}
+
+	var nextCursorUpdatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorUpdatedAt = last.LastMessageAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListConversationsResponse{
+		Conversations:       conversations,
+		NextCursorUpdatedAt: nextCursorUpdatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *MessageService) GetConversation(ctx context.Context, uid string, req *api.GetConversationRequest) (*api.GetConversationResponse, error) {
+	row, err := s.db.GetConversation(ctx, db.GetConversationParams{
+		UserUid:         util.UUID(uid),
+		ConversationUid: util.UUID(req.Uid),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("conversation not found")
+		}
+		return nil, fmt.Errorf("get conversation: %w", err)
+	}
+	return &api.GetConversationResponse{
+		Conversation: conversationFromRow(db.ListConversationsRow(row)),
+	}, nil
+}
+
+func (s *MessageService) ListMessages(ctx context.Context, uid string, req *api.ListMessagesRequest) (*api.ListMessagesResponse, error) {
+	conversationUid := util.UUID(req.Uid)
+	if _, err := s.db.GetConversationMembership(ctx, db.GetConversationMembershipParams{
+		ConversationUid: conversationUid,
+		UserUid:         util.UUID(uid),
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("conversation not found")
+		}
+		return nil, fmt.Errorf("get conversation: %w", err)
+	}
+
+	rows, err := s.db.ListMessages(ctx, db.ListMessagesParams{
+		ConversationUid: conversationUid,
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list messages: %w", err)
+	}
+	messages := make([]*api.Message, 0, len(rows))
+	for _, row := range rows {
+		msg := &api.Message{
+			Uid:             row.Uid.String(),
+			ConversationUid: row.ConversationUid.String(),
+			Sender: &api.MessageSender{
+				Uid:       row.SenderUid.String(),
+				Nickname:  row.SenderNickname,
+				AvatarUrl: row.SenderAvatarUrl,
+			},
+			Content:      row.Content,
+			Images:       row.Images,
+			Type:         string(row.Type),
+			SystemAction: string(row.SystemAction.SystemMessageAction),
+			CreatedAt:    row.CreatedAt.Unix(),
+		}
+		if row.TargetUid.Valid {
+			msg.TargetUid = row.TargetUid.UUID.String()
+		}
+		messages = append(messages, msg)
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListMessagesResponse{
+		Messages:            messages,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+// MarkConversationRead moves the caller's read pointer forward to req.MessageUid, or to
+// the newest message, and sends a read receipt to the other members. Moving the pointer
+// backwards is a no-op.
+func (s *MessageService) MarkConversationRead(ctx context.Context, uid string, req *api.MarkConversationReadRequest) error {
+	conversationUid := util.UUID(req.Uid)
+	userUid := util.UUID(uid)
+	var members []uuid.UUID
+	var receipt *api.MessageReadEvent
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		if _, err := qtx.GetConversationMembership(ctx, db.GetConversationMembershipParams{
+			ConversationUid: conversationUid,
+			UserUid:         userUid,
+		}); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("conversation not found")
+			}
+			return fmt.Errorf("get conversation: %w", err)
+		}
+		target, err := qtx.GetReadTarget(ctx, db.GetReadTargetParams{
+			ConversationUid: conversationUid,
+			MessageUid:      uuid.NullUUID{UUID: util.UUID(req.MessageUid), Valid: req.MessageUid != ""},
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				if req.MessageUid != "" {
+					return fmt.Errorf("message not found")
+				}
+				return nil
+			}
+			return fmt.Errorf("get message: %w", err)
+		}
+		affected, err := qtx.MarkConversationRead(ctx, db.MarkConversationReadParams{
+			MessageUid:       target.Uid,
+			MessageCreatedAt: target.CreatedAt,
+			ConversationUid:  conversationUid,
+			UserUid:          userUid,
+		})
+		if err != nil {
+			return fmt.Errorf("mark conversation read: %w", err)
+		}
+		if affected == 0 {
+			return nil
+		}
+		members, err = qtx.ListConversationMemberUids(ctx, conversationUid)
+		if err != nil {
+			return fmt.Errorf("list conversation members: %w", err)
+		}
+		receipt = &api.MessageReadEvent{
+			ConversationUid:    req.Uid,
+			ReaderUid:          uid,
+			LastReadMessageUid: target.Uid.String(),
+			LastReadAt:         target.CreatedAt.Unix(),
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if receipt != nil {
+		pending := make([]event.Event, 0, len(members))
+		for _, member := range members {
+			pending = append(pending, event.MessageRead(member.String(), receipt))
+		}
+		s.events.Publish(ctx, pending...)
+	}
+	return nil
+}
+
+// CreateGroup creates a group owned by the caller and adds the invited members. Every
+// invitee's message permission applies as if the owner had messaged them directly.
+func (s *MessageService) CreateGroup(ctx context.Context, uid string, req *api.CreateGroupRequest) (*api.CreateGroupResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if utf8.RuneCountInString(name) > maxGroupNameLength {
+		return nil, fmt.Errorf("group name is longer than %d characters", maxGroupNameLength)
+	}
+	if req.AvatarUrl != "" {
+		if err := s.checkImages(ctx, []string{req.AvatarUrl}); err != nil {
+			return nil, err
+		}
+	}
+	ownerUid := util.UUID(uid)
+	var inviteeUids []uuid.UUID
+	for _, memberUid := range util.NormalizeStrings(req.MemberUids) {
+		if memberUid != uid {
+			inviteeUids = append(inviteeUids, util.UUID(memberUid))
+		}
+	}
+	if len(inviteeUids)+1 > maxGroupMembers {
+		return nil, fmt.Errorf("group cannot have more than %d members", maxGroupMembers)
+	}
+
+	var conversationUid uuid.UUID
+	var messages []*api.Message
+	var members []uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		var err error
+		conversationUid, err = qtx.CreateGroupConversation(ctx, db.CreateGroupConversationParams{
+			Name:      name,
+			AvatarUrl: req.AvatarUrl,
+		})
+		if err != nil {
+			return fmt.Errorf("create group: %w", err)
+		}
+		if _, err := qtx.AddConversationMember(ctx, db.AddConversationMemberParams{
+			ConversationUid: conversationUid,
+			UserUid:         ownerUid,
+			Role:            db.ConversationRoleOWNER,
+		}); err != nil {
+			return fmt.Errorf("add group owner: %w", err)
+		}
+		msg, err := postSystemMessage(ctx, qtx, conversationUid, ownerUid, db.SystemMessageActionGROUPCREATED, uuid.NullUUID{}, name)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, msg)
+
+		added, err := addGroupMembers(ctx, qtx, conversationUid, ownerUid, inviteeUids)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, added...)
+
+		members, err = qtx.ListConversationMemberUids(ctx, conversationUid)
+		if err != nil {
+			return fmt.Errorf("list group members: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	s.publishMessages(ctx, members, messages...)
+
+	resp, err := s.GetConversation(ctx, uid, &api.GetConversationRequest{Uid: conversationUid.String()})
+	if err != nil {
+		return nil, err
+	}
+	return &api.CreateGroupResponse{Conversation: resp.Conversation}, nil
+}
+
+// UpdateGroup renames the group or replaces its avatar. Owners and admins only.
+func (s *MessageService) UpdateGroup(ctx context.Context, uid string, req *api.UpdateGroupRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if utf8.RuneCountInString(name) > maxGroupNameLength {
+		return fmt.Errorf("group name is longer than %d characters", maxGroupNameLength)
+	}
+	if req.AvatarUrl != "" {
+		if err := s.checkImages(ctx, []string{req.AvatarUrl}); err != nil {
+			return err
+		}
+	}
+	conversationUid := util.UUID(req.Uid)
+	actorUid := util.UUID(uid)
+	var msg *api.Message
+	var members []uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		group, role, err := lockGroup(ctx, qtx, conversationUid, actorUid)
+		if err != nil {
+			return err
+		}
+		if conversationRoleRank[role] < conversationRoleRank[db.ConversationRoleADMIN] {
+			return fmt.Errorf("permission denied")
+		}
+		if name == "" {
+			name = group.Name
+		}
+		avatarUrl := req.AvatarUrl
+		if avatarUrl == "" {
+			avatarUrl = group.AvatarUrl
+		}
+		if name == group.Name && avatarUrl == group.AvatarUrl {
+			return nil
+		}
+		if err := qtx.UpdateGroupConversation(ctx, db.UpdateGroupConversationParams{
+			Name:      name,
+			AvatarUrl: avatarUrl,
+			Uid:       conversationUid,
+		}); err != nil {
+			return fmt.Errorf("update group: %w", err)
+		}
+		msg, err = postSystemMessage(ctx, qtx, conversationUid, actorUid, db.SystemMessageActionGROUPUPDATED, uuid.NullUUID{}, name)
+		if err != nil {
+			return err
+		}
+		members, err = qtx.ListConversationMemberUids(ctx, conversationUid)
+		if err != nil {
+			return fmt.Errorf("list group members: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if msg != nil {
+		s.publishMessages(ctx, members, msg)
+	}
+	return nil
+}
+
+func (s *MessageService) ListGroupMembers(ctx context.Context, uid string, req *api.ListGroupMembersRequest) (*api.ListGroupMembersResponse, error) {
+	conversationUid := util.UUID(req.Uid)
+	membership, err := s.db.GetConversationMembership(ctx, db.GetConversationMembershipParams{
+		ConversationUid: conversationUid,
+		UserUid:         util.UUID(uid),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("group not found")
+		}
+		return nil, fmt.Errorf("get group: %w", err)
+	}
+	if membership.Type != db.ConversationTypeGROUP {
+		return nil, fmt.Errorf("group not found")
+	}
+
+	rows, err := s.db.ListConversationMembers(ctx, db.ListConversationMembersParams{
+		ConversationUid: conversationUid,
+		CursorJoinedAt:  sql.NullTime{Time: time.Unix(req.CursorJoinedAt, 0).UTC(), Valid: req.CursorJoinedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list group members: %w", err)
+	}
+	members := make([]*api.GroupMember, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, &api.GroupMember{
+			Uid:       row.UserUid.String(),
+			Nickname:  row.Nickname,
+			AvatarUrl: row.AvatarUrl,
+			Role:      string(row.Role),
+			JoinedAt:  row.JoinedAt.Unix(),
+		})
+	}
+
+	var nextCursorJoinedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorJoinedAt = last.JoinedAt.Unix()
+		nextCursorID = last.UserUid.String()
+	}
+
+	return &api.ListGroupMembersResponse{
+		Members:            members,
+		NextCursorJoinedAt: nextCursorJoinedAt,
+		NextCursorId:       nextCursorID,
+	}, nil
+}
+
+// InviteGroupMembers adds members to a group, up to maxGroupMembers. Owners and admins only.
+func (s *MessageService) InviteGroupMembers(ctx context.Context, uid string, req *api.InviteGroupMembersRequest) error {
+	conversationUid := util.UUID(req.Uid)
+	actorUid := util.UUID(uid)
+	var inviteeUids []uuid.UUID
+	for _, memberUid := range util.NormalizeStrings(req.MemberUids) {
+		if memberUid != uid {
+			inviteeUids = append(inviteeUids, util.UUID(memberUid))
+		}
+	}
+	var messages []*api.Message
+	var members []uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		_, role, err := lockGroup(ctx, qtx, conversationUid, actorUid)
+		if err != nil {
+			return err
+		}
+		if conversationRoleRank[role] < conversationRoleRank[db.ConversationRoleADMIN] {
+			return fmt.Errorf("permission denied")
+		}
+		messages, err = addGroupMembers(ctx, qtx, conversationUid, actorUid, inviteeUids)
+		if err != nil {
+			return err
+		}
+		members, err = qtx.ListConversationMemberUids(ctx, conversationUid)
+		if err != nil {
+			return fmt.Errorf("list group members: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	s.publishMessages(ctx, members, messages...)
+	return nil
+}
+
+// KickGroupMember removes a member with a lower role than the caller's.
+func (s *MessageService) KickGroupMember(ctx context.Context, uid string, req *api.KickGroupMemberRequest) error {
+	if req.MemberUid == uid {
+		return fmt.Errorf("cannot kick yourself, leave the group instead")
+	}
+	conversationUid := util.UUID(req.Uid)
+	actorUid := util.UUID(uid)
+	targetUid := util.UUID(req.MemberUid)
+	var msg *api.Message
+	var members []uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		_, role, err := lockGroup(ctx, qtx, conversationUid, actorUid)
+		if err != nil {
+			return err
+		}
+		targetRole, err := qtx.GetConversationMemberRole(ctx, db.GetConversationMemberRoleParams{
+			ConversationUid: conversationUid,
+			UserUid:         targetUid,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("member not found")
+			}
+			return fmt.Errorf("get member role: %w", err)
+		}
+		if role == db.ConversationRoleMEMBER || conversationRoleRank[role] <= conversationRoleRank[targetRole] {
+			return fmt.Errorf("permission denied")
+		}
+		if _, err := qtx.RemoveConversationMember(ctx, db.RemoveConversationMemberParams{
+			ConversationUid: conversationUid,
+			UserUid:         targetUid,
+		}); err != nil {
+			return fmt.Errorf("remove group member: %w", err)
+		}
+		msg, err = postSystemMessage(ctx, qtx, conversationUid, actorUid, db.SystemMessageActionMEMBERREMOVED, uuid.NullUUID{UUID: targetUid, Valid: true}, "")
+		if err != nil {
+			return err
+		}
+		members, err = qtx.ListConversationMemberUids(ctx, conversationUid)
+		if err != nil {
+			return fmt.Errorf("list group members: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// The removed member is told as well.
+	s.publishMessages(ctx, append(members, targetUid), msg)
+	return nil
+}
+
+// SetGroupMemberRole promotes or demotes a member. Owner only; granting OWNER transfers
+// ownership and leaves the previous owner an admin.
+func (s *MessageService) SetGroupMemberRole(ctx context.Context, uid string, req *api.SetGroupMemberRoleRequest) error {
+	if req.MemberUid == uid {
+		return fmt.Errorf("cannot change your own role")
+	}
+	conversationUid := util.UUID(req.Uid)
+	actorUid := util.UUID(uid)
+	targetUid := util.UUID(req.MemberUid)
+	newRole := db.ConversationRole(req.Role)
+	var messages []*api.Message
+	var members []uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		_, role, err := lockGroup(ctx, qtx, conversationUid, actorUid)
+		if err != nil {
+			return err
+		}
+		if role != db.ConversationRoleOWNER {
+			return fmt.Errorf("only the group owner can change roles")
+		}
+		affected, err := qtx.SetConversationMemberRole(ctx, db.SetConversationMemberRoleParams{
+			Role:            newRole,
+			ConversationUid: conversationUid,
+			UserUid:         targetUid,
+		})
+		if err != nil {
+			return fmt.Errorf("set member role: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("member not found or already %s", newRole)
+		}
+		msg, err := postSystemMessage(ctx, qtx, conversationUid, actorUid, db.SystemMessageActionROLECHANGED, uuid.NullUUID{UUID: targetUid, Valid: true}, string(newRole))
+		if err != nil {
+			return err
+		}
+		messages = append(messages, msg)
+
+		if newRole == db.ConversationRoleOWNER {
+			if _, err := qtx.SetConversationMemberRole(ctx, db.SetConversationMemberRoleParams{
+				Role:            db.ConversationRoleADMIN,
+				ConversationUid: conversationUid,
+				UserUid:         actorUid,
+			}); err != nil {
+				return fmt.Errorf("set member role: %w", err)
+			}
+			msg, err := postSystemMessage(ctx, qtx, conversationUid, actorUid, db.SystemMessageActionROLECHANGED, uuid.NullUUID{UUID: actorUid, Valid: true}, string(db.ConversationRoleADMIN))
+			if err != nil {
+				return err
+			}
+			messages = append(messages, msg)
+		}
+
+		members, err = qtx.ListConversationMemberUids(ctx, conversationUid)
+		if err != nil {
+			return fmt.Errorf("list group members: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	s.publishMessages(ctx, members, messages...)
+	return nil
+}
+
+// LeaveGroup removes the caller from a group. An owner must transfer ownership first
+// unless they are the last member.
+func (s *MessageService) LeaveGroup(ctx context.Context, uid string, req *api.LeaveGroupRequest) error {
+	conversationUid := util.UUID(req.Uid)
+	actorUid := util.UUID(uid)
+	var msg *api.Message
+	var members []uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		_, role, err := lockGroup(ctx, qtx, conversationUid, actorUid)
+		if err != nil {
+			return err
+		}
+		if role == db.ConversationRoleOWNER {
+			count, err := qtx.CountConversationMembers(ctx, conversationUid)
+			if err != nil {
+				return fmt.Errorf("count group members: %w", err)
+			}
+			if count > 1 {
+				return fmt.Errorf("transfer ownership before leaving the group")
+			}
+		}
+		if _, err := qtx.RemoveConversationMember(ctx, db.RemoveConversationMemberParams{
+			ConversationUid: conversationUid,
+			UserUid:         actorUid,
+		}); err != nil {
+			return fmt.Errorf("remove group member: %w", err)
+		}
+		msg, err = postSystemMessage(ctx, qtx, conversationUid, actorUid, db.SystemMessageActionMEMBERLEFT, uuid.NullUUID{UUID: actorUid, Valid: true}, "")
+		if err != nil {
+			return err
+		}
+		members, err = qtx.ListConversationMemberUids(ctx, conversationUid)
+		if err != nil {
+			return fmt.Errorf("list group members: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	s.publishMessages(ctx, append(members, actorUid), msg)
+	return nil
+}
+
+func (s *MessageService) GetUnreadMessageCount(ctx context.Context, uid string) (*api.GetUnreadMessageCountResponse, error) {
+	count, err := s.db.CountUnreadMessages(ctx, util.UUID(uid))
+	if err != nil {
+		return nil, fmt.Errorf("count unread messages: %w", err)
+	}
+	return &api.GetUnreadMessageCountResponse{UnreadCount: count}, nil
+}
+
+func (s *MessageService) GetMessageSettings(ctx context.Context, uid string) (*api.MessageSettings, error) {
+	permission, err := s.db.GetMessagePermission(ctx, util.UUID(uid))
+	if err != nil {
+		return nil, fmt.Errorf("get message permission: %w", err)
+	}
+	return &api.MessageSettings{Permission: string(permission)}, nil
+}
+
+func (s *MessageService) UpdateMessageSettings(ctx context.Context, uid string, req *api.MessageSettings) error {
+	if err := s.db.SetMessagePermission(ctx, db.SetMessagePermissionParams{
+		MessagePermission: db.MessagePermission(req.Permission),
+		Uid:               util.UUID(uid),
+	}); err != nil {
+		return fmt.Errorf("set message permission: %w", err)
+	}
+	return nil
+}
+
+// checkImages requires every image to be a file uploaded through FileService.
+func (s *MessageService) checkImages(ctx context.Context, images []string) error {
+	if len(images) == 0 {
+		return nil
+	}
+	files, err := s.db.GetFilesByUrls(ctx, images)
+	if err != nil {
+		return fmt.Errorf("get files: %w", err)
+	}
+	if len(files) != len(images) {
+		return fmt.Errorf("image not found")
+	}
+	for _, file := range files {
+		if !strings.HasPrefix(file.ContentType, "image/") {
+			return fmt.Errorf("%s is not an image", file.Url)
+		}
+	}
+	return nil
+}
+
+// publishMessages sends each message to every recipient's event stream.
+func (s *MessageService) publishMessages(ctx context.Context, recipients []uuid.UUID, messages ...*api.Message) {
+	pending := make([]event.Event, 0, len(recipients)*len(messages))
+	for _, msg := range messages {
+		for _, recipient := range recipients {
+			pending = append(pending, event.Message(recipient.String(), msg))
+		}
+	}
+	s.events.Publish(ctx, pending...)
+}
+
+// lockGroup locks a group conversation for a membership change and returns it with the
+// caller's role. Non-members get "group not found".
+func lockGroup(ctx context.Context, qtx *db.Queries, conversationUid, userUid uuid.UUID) (db.LockConversationRow, db.ConversationRole, error) {
+	group, err := qtx.LockConversation(ctx, conversationUid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return group, "", fmt.Errorf("group not found")
+		}
+		return group, "", fmt.Errorf("get group: %w", err)
+	}
+	if group.Type != db.ConversationTypeGROUP {
+		return group, "", fmt.Errorf("group not found")
+	}
+	role, err := qtx.GetConversationMemberRole(ctx, db.GetConversationMemberRoleParams{
+		ConversationUid: conversationUid,
+		UserUid:         userUid,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return group, "", fmt.Errorf("group not found")
+		}
+		return group, "", fmt.Errorf("get member role: %w", err)
+	}
+	return group, role, nil
+}
+
+// addGroupMembers adds invitees as members, skipping existing ones, and posts a
+// MEMBER_ADDED system message for each. The group must be locked by the caller.
+func addGroupMembers(ctx context.Context, qtx *db.Queries, conversationUid, inviterUid uuid.UUID, inviteeUids []uuid.UUID) ([]*api.Message, error) {
+	count, err := qtx.CountConversationMembers(ctx, conversationUid)
+	if err != nil {
+		return nil, fmt.Errorf("count group members: %w", err)
+	}
+	var messages []*api.Message
+	for _, inviteeUid := range inviteeUids {
+		if err := checkMessagePermission(ctx, qtx, inviterUid, inviteeUid); err != nil {
+			return nil, fmt.Errorf("invite %s: %w", inviteeUid, err)
+		}
+		if count >= maxGroupMembers {
+			return nil, fmt.Errorf("group cannot have more than %d members", maxGroupMembers)
+		}
+		affected, err := qtx.AddConversationMember(ctx, db.AddConversationMemberParams{
+			ConversationUid: conversationUid,
+			UserUid:         inviteeUid,
+			Role:            db.ConversationRoleMEMBER,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("add group member: %w", err)
+		}
+		if affected == 0 {
+			continue
+		}
+		count++
+		msg, err := postSystemMessage(ctx, qtx, conversationUid, inviterUid, db.SystemMessageActionMEMBERADDED, uuid.NullUUID{UUID: inviteeUid, Valid: true}, "")
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// postSystemMessage records a membership change in the conversation. System messages
+// do not count towards unread counts.
+func postSystemMessage(ctx context.Context, qtx *db.Queries, conversationUid, actorUid uuid.UUID, action db.SystemMessageAction, targetUid uuid.NullUUID, content string) (*api.Message, error) {
+	row, err := qtx.CreateSystemMessage(ctx, db.CreateSystemMessageParams{
+		ConversationUid: conversationUid,
+		SenderUid:       actorUid,
+		SystemAction:    action,
+		TargetUid:       targetUid,
+		Content:         content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create system message: %w", err)
+	}
+	if err := qtx.TouchConversation(ctx, db.TouchConversationParams{
+		LastMessageUid: uuid.NullUUID{UUID: row.Uid, Valid: true},
+		LastMessageAt:  row.CreatedAt,
+		Uid:            conversationUid,
+	}); err != nil {
+		return nil, fmt.Errorf("update conversation: %w", err)
+	}
+	msg := &api.Message{
+		Uid:             row.Uid.String(),
+		ConversationUid: conversationUid.String(),
+		Sender: &api.MessageSender{
+			Uid:       actorUid.String(),
+			Nickname:  row.SenderNickname,
+			AvatarUrl: row.SenderAvatarUrl,
+		},
+		Content:      content,
+		Images:       []string{},
+		Type:         string(db.MessageTypeSYSTEM),
+		SystemAction: string(action),
+		CreatedAt:    row.CreatedAt.Unix(),
+	}
+	if targetUid.Valid {
+		msg.TargetUid = targetUid.UUID.String()
+	}
+	return msg, nil
+}
+
+// checkMessagePermission reports whether recipientUid accepts messages from senderUid.
+func checkMessagePermission(ctx context.Context, qtx *db.Queries, senderUid, recipientUid uuid.UUID) error {
+	target, err := qtx.GetMessagingTarget(ctx, db.GetMessagingTargetParams{
+		SenderUid: senderUid,
+		Uid:       recipientUid,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("user not found")
+		}
+		return fmt.Errorf("get user: %w", err)
+	}
+	if target.Status != db.UserStatusNORMAL {
+		return fmt.Errorf("user not found")
+	}
+	if target.BlockedSender {
+		return fmt.Errorf("you have been blocked by this user")
+	}
+	switch target.MessagePermission {
+	case db.MessagePermissionNOBODY:
+		return fmt.Errorf("user does not accept messages")
+	case db.MessagePermissionFOLLOWING:
+		if !target.FollowsSender {
+			return fmt.Errorf("user only accepts messages from people they follow")
+		}
+	}
+	return nil
+}
+
+// directKey identifies the direct conversation between two users regardless of order.
+func directKey(a, b uuid.UUID) string {
+	if a.String() > b.String() {
+		a, b = b, a
+	}
+	return a.String() + ":" + b.String()
+}
+
+func conversationFromRow(row db.ListConversationsRow) *api.Conversation {
+	conversation := &api.Conversation{
+		Uid:         row.Uid.String(),
+		Type:        string(row.Type),
+		Name:        row.Name,
+		AvatarUrl:   row.AvatarUrl,
+		MemberCount: row.MemberCount,
+		MyRole:      string(row.Role),
+		UnreadCount: row.UnreadCount,
+		MyRead:      readReceipt(row.LastReadMessageUid, row.LastReadAt),
+		UpdatedAt:   row.LastMessageAt.Unix(),
+		CreatedAt:   row.CreatedAt.Unix(),
+	}
+	if row.PeerUid != uuid.Nil {
+		conversation.Peer = &api.MessageSender{
+			Uid:       row.PeerUid.String(),
+			Nickname:  row.PeerNickname,
+			AvatarUrl: row.PeerAvatarUrl,
+		}
+		conversation.PeerRead = readReceipt(row.PeerLastReadMessageUid, row.PeerLastReadAt)
+	}
+	if row.LastMessageUid.Valid {
+		conversation.LastMessage = &api.Message{
+			Uid:             row.LastMessageUid.UUID.String(),
+			ConversationUid: row.Uid.String(),
+			Sender:          &api.MessageSender{Uid: row.LastMessageSenderUid.UUID.String()},
+			Content:         row.LastMessageContent.String,
+			Images:          row.LastMessageImages,
+			Type:            string(row.LastMessageType.MessageType),
+			SystemAction:    string(row.LastMessageSystemAction.SystemMessageAction),
+			CreatedAt:       row.LastMessageCreatedAt.Time.Unix(),
+		}
+		if row.LastMessageTargetUid.Valid {
+			conversation.LastMessage.TargetUid = row.LastMessageTargetUid.UUID.String()
+		}
+	}
+	return conversation
+}
+
+func readReceipt(messageUid uuid.NullUUID, readAt sql.NullTime) *api.ReadReceipt {
+	receipt := &api.ReadReceipt{}
+	if messageUid.Valid {
+		receipt.LastReadMessageUid = messageUid.UUID.String()
+	}
+	if readAt.Valid {
+		receipt.LastReadAt = readAt.Time.Unix()
+	}
+	return receipt
+}
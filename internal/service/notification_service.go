@@ -0,0 +1,234 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/event"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationService struct {
+	db  *db.Queries
+	dbx *sql.DB
+}
+
+func NewNotificationService(dbx *sql.DB) *NotificationService {
+	return &NotificationService{
+		db:  db.New(dbx),
+		dbx: dbx,
+	}
+}
+
+// Notify records a notification for recipient inside the caller's transaction. Likes
+// and follows are folded into the recipient's unread notification for the same target,
+// counting each actor once. Actions on one's own content are ignored. The returned
+// event, nil when nothing was recorded, must be published after the commit.
+func (s *NotificationService) Notify(ctx context.Context, qtx *db.Queries, typ db.NotificationType, recipient, actor, target uuid.UUID, postUid uuid.NullUUID) (*event.Event, error) {
+	if recipient == actor {
+		return nil, nil
+	}
+
+	switch typ {
+	case db.NotificationTypePOSTLIKE, db.NotificationTypeCOMMENTLIKE, db.NotificationTypeFOLLOW:
+		notificationUid, err := qtx.UpsertNotificationGroup(ctx, db.UpsertNotificationGroupParams{
+			RecipientUid: recipient,
+			Type:         typ,
+			TargetUid:    target,
+			PostUid:      postUid,
+			ActorUid:     actor,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("upsert notification: %w", err)
+		}
+		if err := qtx.AddNotificationActor(ctx, db.AddNotificationActorParams{
+			NotificationUid: notificationUid,
+			ActorUid:        actor,
+		}); err != nil {
+			return nil, fmt.Errorf("add notification actor: %w", err)
+		}
+	default:
+		if err := qtx.CreateNotification(ctx, db.CreateNotificationParams{
+			RecipientUid: recipient,
+			Type:         typ,
+			TargetUid:    target,
+			PostUid:      postUid,
+			ActorUid:     actor,
+		}); err != nil {
+			return nil, fmt.Errorf("create notification: %w", err)
+		}
+	}
+
+	n := &api.NotificationEvent{
+		Type:      string(typ),
+		ActorUid:  actor.String(),
+		TargetUid: target.String(),
+	}
+	if postUid.Valid {
+		n.PostUid = postUid.UUID.String()
+	}
+	ev := event.Notification(recipient.String(), n)
+	return &ev, nil
+}
+
+// ResolveMentions extracts the @username tokens in text and links those naming an active
+// user who has not blocked author. Unknown usernames stay plain text and are dropped.
+func (s *NotificationService) ResolveMentions(ctx context.Context, qtx *db.Queries, author uuid.UUID, text string) ([]util.Entity, error) {
+	mentions := util.ExtractMentions(text)
+	if len(mentions) == 0 {
+		return mentions, nil
+	}
+	usernames := make([]string, 0, len(mentions))
+	for _, mention := range mentions {
+		usernames = append(usernames, mention.Text)
+	}
+	rows, err := qtx.GetMentionableUsers(ctx, db.GetMentionableUsersParams{
+		Usernames: util.NormalizeStrings(usernames),
+		AuthorUid: author,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get mentioned users: %w", err)
+	}
+	uids := make(map[string]string, len(rows))
+	for _, row := range rows {
+		uids[row.Username] = row.Uid.String()
+	}
+
+	entities := make([]util.Entity, 0, len(mentions))
+	for _, mention := range mentions {
+		uid, ok := uids[mention.Text]
+		if !ok {
+			continue
+		}
+		mention.Uid = uid
+		entities = append(entities, mention)
+	}
+	return entities, nil
+}
+
+// SyncMentions replaces the mentions recorded for a post or comment with the users
+// linked by entities and notifies those mentioned for the first time, provided they can
+// see the post. The returned events must be published after the commit.
+func (s *NotificationService) SyncMentions(ctx context.Context, qtx *db.Queries, source db.MentionSource, sourceUid, author, postUid uuid.UUID, entities []util.Entity) ([]event.Event, error) {
+	mentioned := make([]uuid.UUID, 0, len(entities))
+	for _, entity := range entities {
+		if entity.Type == util.EntityMention {
+			mentioned = append(mentioned, util.UUID(entity.Uid))
+		}
+	}
+	added, err := qtx.SyncMentions(ctx, db.SyncMentionsParams{
+		SourceType:    source,
+		SourceUid:     sourceUid,
+		AuthorUid:     author,
+		PostUid:       postUid,
+		MentionedUids: mentioned,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sync mentions: %w", err)
+	}
+
+	var pending []event.Event
+	for _, recipient := range added {
+		if _, err := qtx.GetPostVisibilityByUid(ctx, db.GetPostVisibilityByUidParams{
+			Uid:    postUid,
+			Viewer: uuid.NullUUID{UUID: recipient, Valid: true},
+		}); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return nil, fmt.Errorf("get post: %w", err)
+		}
+		ev, err := s.Notify(ctx, qtx, db.NotificationTypeMENTION, recipient, author, sourceUid, uuid.NullUUID{UUID: postUid, Valid: true})
+		if err != nil {
+			return nil, err
+		}
+		if ev != nil {
+			pending = append(pending, *ev)
+		}
+	}
+	return pending, nil
+}
+
+func (s *NotificationService) ListNotifications(ctx context.Context, uid string, req *api.ListNotificationsRequest) (*api.ListNotificationsResponse, error) {
+	rows, err := s.db.ListNotifications(ctx, db.ListNotificationsParams{
+		RecipientUid:    util.UUID(uid),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list notifications: %w", err)
+	}
+
+	notifications := make([]*api.Notification, 0, len(rows))
+	for _, row := range rows {
+		postUid := ""
+		if row.PostUid.Valid {
+			postUid = row.PostUid.UUID.String()
+		}
+		notifications = append(notifications, &api.Notification{
+			Uid:       row.Uid.String(),
+			Type:      string(row.Type),
+			TargetUid: row.TargetUid.String(),
+			PostUid:   postUid,
+			Actor: &api.NotificationActor{
+				Uid:       row.ActorUid.String(),
+				Nickname:  row.ActorNickname,
+				AvatarUrl: row.ActorAvatarUrl,
+			},
+			ActorCount: row.ActorCount,
+			Preview:    row.Preview,
+			Read:       row.ReadAt.Valid,
+			CreatedAt:  row.CreatedAt.Unix(),
+			UpdatedAt:  row.UpdatedAt.Unix(),
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListNotificationsResponse{
+		Notifications:       notifications,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *NotificationService) GetUnreadCount(ctx context.Context, uid string) (*api.GetUnreadCountResponse, error) {
+	count, err := s.db.CountUnreadNotifications(ctx, util.UUID(uid))
+	if err != nil {
+		return nil, fmt.Errorf("count unread notifications: %w", err)
+	}
+	return &api.GetUnreadCountResponse{Count: count}, nil
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, uid string, req *api.MarkReadRequest) error {
+	uids := make([]uuid.UUID, 0, len(req.Uids))
+	for _, u := range req.Uids {
+		uids = append(uids, util.UUID(u))
+	}
+	if _, err := s.db.MarkNotificationsRead(ctx, db.MarkNotificationsReadParams{
+		RecipientUid: util.UUID(uid),
+		Uids:         uids,
+	}); err != nil {
+		return fmt.Errorf("mark notifications read: %w", err)
+	}
+	return nil
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, uid string) error {
+	if _, err := s.db.MarkAllNotificationsRead(ctx, util.UUID(uid)); err != nil {
+		return fmt.Errorf("mark all notifications read: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"aeibi/api"
+	"aeibi/internal/event"
 	"aeibi/internal/repository/db"
 	"aeibi/internal/repository/oss"
 	"aeibi/util"
@@ -9,46 +10,116 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type PostService struct {
-	db  *db.Queries
-	dbx *sql.DB
-	oss *oss.OSS
+	db            *db.Queries
+	dbx           *sql.DB
+	oss           *oss.OSS
+	timeline      *TimelineService
+	notifications *NotificationService
+	events        event.Publisher
 }
 
-func NewPostService(dbx *sql.DB, ossClient *oss.OSS) *PostService {
+func NewPostService(dbx *sql.DB, ossClient *oss.OSS, timeline *TimelineService, notifications *NotificationService, events event.Publisher) *PostService {
 	return &PostService{
-		db:  db.New(dbx),
-		dbx: dbx,
-		oss: ossClient,
+		db:            db.New(dbx),
+		dbx:           dbx,
+		oss:           ossClient,
+		timeline:      timeline,
+		notifications: notifications,
+		events:        events,
 	}
 }
 
 func (s *PostService) CreatePost(ctx context.Context, uid string, req *api.CreatePostRequest) (*api.CreatePostResponse, error) {
 	var resp *api.CreatePostResponse
+	var pending []event.Event
+	visibility := db.PostVisibilityPUBLIC
+	if req.Visibility != "" {
+		visibility = db.PostVisibility(req.Visibility)
+	}
+	postStatus := db.PostStatusNORMAL
+	var publishAt sql.NullTime
+	if req.PublishAt != 0 {
+		postStatus = db.PostStatusSCHEDULED
+		publishAt = sql.NullTime{Time: time.Unix(req.PublishAt, 0).UTC(), Valid: true}
+	} else if req.Status == string(db.PostStatusDRAFT) {
+		postStatus = db.PostStatusDRAFT
+	}
 	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		if req.QuotedPostUid != "" {
+			if _, err := checkShareable(ctx, qtx, util.UUID(uid), util.UUID(req.QuotedPostUid)); err != nil {
+				return err
+			}
+		}
+		mentions, err := s.notifications.ResolveMentions(ctx, qtx, util.UUID(uid), req.Text)
+		if err != nil {
+			return err
+		}
+		hashtags := util.ExtractHashtags(req.Text)
+		entities := util.MergeEntities(mentions, hashtags)
+		encoded, err := util.EncodeEntities(entities)
+		if err != nil {
+			return fmt.Errorf("encode entities: %w", err)
+		}
 		row, err := qtx.CreatePost(ctx, db.CreatePostParams{
-			Author:      util.UUID(uid),
-			Text:        req.Text,
-			Images:      req.Images,
-			Attachments: req.Attachments,
-			Visibility:  db.PostVisibility(req.Visibility),
-			Pinned:      req.Pinned,
+			Author:        util.UUID(uid),
+			Text:          req.Text,
+			Entities:      encoded,
+			Images:        req.Images,
+			Attachments:   req.Attachments,
+			Visibility:    visibility,
+			Pinned:        req.Pinned,
+			QuotedPostUid: uuid.NullUUID{UUID: util.UUID(req.QuotedPostUid), Valid: req.QuotedPostUid != ""},
+			Status:        postStatus,
+			PublishAt:     publishAt,
 		})
 		if err != nil {
 			return fmt.Errorf("create post: %w", err)
 		}
 		err = qtx.UpsertPostTags(ctx, db.UpsertPostTagsParams{
 			PostID: row.ID,
-			Tags:   util.NormalizeStrings(req.Tags),
+			Tags:   util.NormalizeStrings(append(req.Tags, util.HashtagNames(hashtags)...)),
 		})
 		if err != nil {
 			return fmt.Errorf("create post: %w", err)
 		}
+		if req.Poll != nil {
+			pollUid, err := qtx.CreatePoll(ctx, db.CreatePollParams{
+				PostUid:   row.Uid,
+				Multiple:  req.Poll.Multiple,
+				ExpiresAt: time.Unix(req.Poll.ExpiresAt, 0).UTC(),
+			})
+			if err != nil {
+				return fmt.Errorf("create poll: %w", err)
+			}
+			options := make([]string, 0, len(req.Poll.Options))
+			for _, option := range req.Poll.Options {
+				options = append(options, strings.TrimSpace(option))
+			}
+			if err := qtx.CreatePollOptions(ctx, db.CreatePollOptionsParams{
+				PollUid: pollUid,
+				Options: options,
+			}); err != nil {
+				return fmt.Errorf("create poll: %w", err)
+			}
+		}
+		// Drafts notify the people they mention and reach timelines when they are published
+		if postStatus == db.PostStatusNORMAL {
+			pending, err = s.notifications.SyncMentions(ctx, qtx, db.MentionSourcePOST, row.Uid, util.UUID(uid), row.Uid, entities)
+			if err != nil {
+				return err
+			}
+			if err := s.timeline.EnqueueFanout(ctx, qtx, row.Uid); err != nil {
+				return err
+			}
+		}
 		resp = &api.CreatePostResponse{
 			Uid: row.Uid.String(),
 		}
@@ -56,6 +127,10 @@ func (s *PostService) CreatePost(ctx context.Context, uid string, req *api.Creat
 	}); err != nil {
 		return nil, err
 	}
+	if postStatus == db.PostStatusNORMAL {
+		s.timeline.Wake()
+	}
+	s.events.Publish(ctx, pending...)
 	return resp, nil
 }
 
@@ -70,9 +145,6 @@ func (s *PostService) GetPost(ctx context.Context, viewerUid string, req *api.Ge
 		}
 		return nil, fmt.Errorf("get post: %w", err)
 	}
-	if postRow.Visibility == db.PostVisibilityPRIVATE {
-		return nil, fmt.Errorf("post not found")
-	}
 	fileRow, err := s.db.GetFilesByUrls(ctx, postRow.Attachments)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, fmt.Errorf("get attachments: %w", err)
@@ -87,7 +159,7 @@ func (s *PostService) GetPost(ctx context.Context, viewerUid string, req *api.Ge
 			Checksum:    file.Checksum,
 		})
 	}
-	return &api.GetPostResponse{Post: &api.Post{
+	post := &api.Post{
 		Uid: postRow.Uid.String(),
 		Author: &api.PostAuthor{
 			Uid:         postRow.AuthorUid.String(),
@@ -96,6 +168,7 @@ func (s *PostService) GetPost(ctx context.Context, viewerUid string, req *api.Ge
 			IsFollowing: false, // TODO: compute with viewer context
 		},
 		Text:            postRow.Text,
+		Entities:        textEntities(postRow.Entities),
 		Images:          postRow.Images,
 		Attachments:     attachments,
 		Tags:            postRow.TagNames,
@@ -110,7 +183,18 @@ func (s *PostService) GetPost(ctx context.Context, viewerUid string, req *api.Ge
 		Collected:       postRow.Collected,
 		CreatedAt:       postRow.CreatedAt.Unix(),
 		UpdatedAt:       postRow.UpdatedAt.Unix(),
-	}}, nil
+		RepostCount:     postRow.RepostCount,
+		Edited:          postRow.EditCount > 0,
+		EditCount:       postRow.EditCount,
+		Reposted:        postRow.Reposted,
+		RepostOfUid:     postRow.RepostOfUid,
+		QuotedPostUid:   postRow.QuotedPostUid,
+	}
+	if err := embedPosts(ctx, s.db, viewerUid, post); err != nil {
+		return nil, err
+	}
+
+	return &api.GetPostResponse{Post: post}, nil
 }
 
 func (s *PostService) GetMyPost(ctx context.Context, uid string, req *api.GetPostRequest) (*api.GetPostResponse, error) {
@@ -124,9 +208,6 @@ func (s *PostService) GetMyPost(ctx context.Context, uid string, req *api.GetPos
 		}
 		return nil, fmt.Errorf("get post: %w", err)
 	}
-	if postRow.Visibility == db.PostVisibilityPRIVATE && uid != postRow.Author.String() {
-		return nil, fmt.Errorf("post not found")
-	}
 	fileRow, err := s.db.GetFilesByUrls(ctx, postRow.Attachments)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, fmt.Errorf("get attachments: %w", err)
@@ -141,7 +222,7 @@ func (s *PostService) GetMyPost(ctx context.Context, uid string, req *api.GetPos
 			Checksum:    file.Checksum,
 		})
 	}
-	return &api.GetPostResponse{Post: &api.Post{
+	post := &api.Post{
 		Uid: postRow.Uid.String(),
 		Author: &api.PostAuthor{
 			Uid:         postRow.AuthorUid.String(),
@@ -150,6 +231,7 @@ func (s *PostService) GetMyPost(ctx context.Context, uid string, req *api.GetPos
 			IsFollowing: false, // TODO: compute with viewer context
 		},
 		Text:            postRow.Text,
+		Entities:        textEntities(postRow.Entities),
 		Images:          postRow.Images,
 		Attachments:     attachments,
 		Tags:            postRow.TagNames,
@@ -164,7 +246,18 @@ func (s *PostService) GetMyPost(ctx context.Context, uid string, req *api.GetPos
 		Collected:       postRow.Collected,
 		CreatedAt:       postRow.CreatedAt.Unix(),
 		UpdatedAt:       postRow.UpdatedAt.Unix(),
-	}}, nil
+		RepostCount:     postRow.RepostCount,
+		Edited:          postRow.EditCount > 0,
+		EditCount:       postRow.EditCount,
+		Reposted:        postRow.Reposted,
+		RepostOfUid:     postRow.RepostOfUid,
+		QuotedPostUid:   postRow.QuotedPostUid,
+	}
+	if err := embedPosts(ctx, s.db, uid, post); err != nil {
+		return nil, err
+	}
+
+	return &api.GetPostResponse{Post: post}, nil
 }
 
 func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.ListPostsRequest) (*api.ListPostsResponse, error) {
@@ -178,10 +271,6 @@ func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.
 	}
 	posts := make([]*api.Post, 0, len(rows))
 	for _, row := range rows {
-		if row.Visibility == db.PostVisibilityPRIVATE {
-			continue
-		}
-
 		fileRow, err := s.db.GetFilesByUrls(ctx, row.Attachments)
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			continue
@@ -206,6 +295,7 @@ func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.
 				IsFollowing: false, // TODO: compute with viewer context
 			},
 			Text:            row.Text,
+			Entities:        textEntities(row.Entities),
 			Images:          row.Images,
 			Attachments:     attachments,
 			Tags:            row.TagNames,
@@ -220,9 +310,19 @@ func (s *PostService) ListPosts(ctx context.Context, viewerUid string, req *api.
 			Collected:       row.Collected,
 			CreatedAt:       row.CreatedAt.Unix(),
 			UpdatedAt:       row.UpdatedAt.Unix(),
+			RepostCount:     row.RepostCount,
+			Edited:          row.EditCount > 0,
+			EditCount:       row.EditCount,
+			Reposted:        row.Reposted,
+			RepostOfUid:     row.RepostOfUid,
+			QuotedPostUid:   row.QuotedPostUid,
 		})
 	}
 
+	if err := embedPosts(ctx, s.db, viewerUid, posts...); err != nil {
+		return nil, err
+	}
+
 	var nextCursorCreatedAt int64
 	var nextCursorID string
 	if len(rows) > 0 {
@@ -251,10 +351,6 @@ func (s *PostService) ListPostsByAuthor(ctx context.Context, viewerUid string, r
 
 	posts := make([]*api.Post, 0, len(rows))
 	for _, row := range rows {
-		if row.Visibility == db.PostVisibilityPRIVATE {
-			continue
-		}
-
 		fileRow, err := s.db.GetFilesByUrls(ctx, row.Attachments)
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			continue
@@ -280,6 +376,7 @@ func (s *PostService) ListPostsByAuthor(ctx context.Context, viewerUid string, r
 				IsFollowing: false, // TODO: compute with viewer context
 			},
 			Text:            row.Text,
+			Entities:        textEntities(row.Entities),
 			Images:          row.Images,
 			Attachments:     attachments,
 			Tags:            row.TagNames,
@@ -294,9 +391,19 @@ func (s *PostService) ListPostsByAuthor(ctx context.Context, viewerUid string, r
 			Collected:       row.Collected,
 			CreatedAt:       row.CreatedAt.Unix(),
 			UpdatedAt:       row.UpdatedAt.Unix(),
+			RepostCount:     row.RepostCount,
+			Edited:          row.EditCount > 0,
+			EditCount:       row.EditCount,
+			Reposted:        row.Reposted,
+			RepostOfUid:     row.RepostOfUid,
+			QuotedPostUid:   row.QuotedPostUid,
 		})
 	}
 
+	if err := embedPosts(ctx, s.db, viewerUid, posts...); err != nil {
+		return nil, err
+	}
+
 	var nextCursorCreatedAt int64
 	var nextCursorID string
 	if len(rows) > 0 {
@@ -350,6 +457,162 @@ func (s *PostService) ListMyPosts(ctx context.Context, uid string, req *api.List
 				IsFollowing: false, // TODO: compute with viewer context
 			},
 			Text:            row.Text,
+			Entities:        textEntities(row.Entities),
+			Images:          row.Images,
+			Attachments:     attachments,
+			Tags:            row.TagNames,
+			CommentCount:    row.CommentCount,
+			CollectionCount: row.CollectionCount,
+			LikeCount:       row.LikeCount,
+			Visibility:      string(row.Visibility),
+			LatestRepliedOn: row.LatestRepliedOn.Unix(),
+			Ip:              row.Ip,
+			Pinned:          row.Pinned,
+			Liked:           row.Liked,
+			Collected:       row.Collected,
+			CreatedAt:       row.CreatedAt.Unix(),
+			UpdatedAt:       row.UpdatedAt.Unix(),
+			RepostCount:     row.RepostCount,
+			Edited:          row.EditCount > 0,
+			EditCount:       row.EditCount,
+			Reposted:        row.Reposted,
+			RepostOfUid:     row.RepostOfUid,
+			QuotedPostUid:   row.QuotedPostUid,
+		})
+	}
+
+	if err := embedPosts(ctx, s.db, uid, posts...); err != nil {
+		return nil, err
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListPostsResponse{
+		Posts:               posts,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+// ListMyDrafts lists the caller's drafts and scheduled posts, which no other listing shows.
+func (s *PostService) ListMyDrafts(ctx context.Context, uid string, req *api.ListPostsRequest) (*api.ListPostsResponse, error) {
+	rows, err := s.db.ListDraftsByAuthor(ctx, db.ListDraftsByAuthorParams{
+		Author:          util.UUID(uid),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list drafts: %w", err)
+	}
+
+	posts := make([]*api.Post, 0, len(rows))
+	for _, row := range rows {
+		fileRow, err := s.db.GetFilesByUrls(ctx, row.Attachments)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+
+		attachments := make([]*api.Attachment, 0, len(row.Attachments))
+		for _, file := range fileRow {
+			attachments = append(attachments, &api.Attachment{
+				Url:         file.Url,
+				Name:        file.Name,
+				ContentType: file.ContentType,
+				Size:        file.Size,
+				Checksum:    file.Checksum,
+			})
+		}
+
+		var publishAt int64
+		if row.PublishAt.Valid {
+			publishAt = row.PublishAt.Time.Unix()
+		}
+		posts = append(posts, &api.Post{
+			Uid: row.Uid.String(),
+			Author: &api.PostAuthor{
+				Uid:       row.AuthorUid.String(),
+				Nickname:  row.AuthorNickname,
+				AvatarUrl: row.AuthorAvatarUrl,
+			},
+			Text:          row.Text,
+			Entities:      textEntities(row.Entities),
+			Images:        row.Images,
+			Attachments:   attachments,
+			Tags:          row.TagNames,
+			Visibility:    string(row.Visibility),
+			Pinned:        row.Pinned,
+			CreatedAt:     row.CreatedAt.Unix(),
+			UpdatedAt:     row.UpdatedAt.Unix(),
+			QuotedPostUid: row.QuotedPostUid,
+			Status:        string(row.Status),
+			PublishAt:     publishAt,
+		})
+	}
+
+	if err := embedPosts(ctx, s.db, uid, posts...); err != nil {
+		return nil, err
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListPostsResponse{
+		Posts:               posts,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *PostService) ListHomeTimeline(ctx context.Context, uid string, req *api.ListPostsRequest) (*api.ListPostsResponse, error) {
+	rows, err := s.db.ListHomeTimeline(ctx, db.ListHomeTimelineParams{
+		Viewer:          util.UUID(uid),
+		FanoutThreshold: s.timeline.FanoutThreshold(),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list home timeline: %w", err)
+	}
+
+	posts := make([]*api.Post, 0, len(rows))
+	for _, row := range rows {
+		fileRow, err := s.db.GetFilesByUrls(ctx, row.Attachments)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+
+		attachments := make([]*api.Attachment, 0, len(row.Attachments))
+		for _, file := range fileRow {
+			attachments = append(attachments, &api.Attachment{
+				Url:         file.Url,
+				Name:        file.Name,
+				ContentType: file.ContentType,
+				Size:        file.Size,
+				Checksum:    file.Checksum,
+			})
+		}
+
+		posts = append(posts, &api.Post{
+			Uid: row.Uid.String(),
+			Author: &api.PostAuthor{
+				Uid:         row.AuthorUid.String(),
+				Nickname:    row.AuthorNickname,
+				AvatarUrl:   row.AuthorAvatarUrl,
+				IsFollowing: false, // TODO: compute with viewer context
+			},
+			Text:            row.Text,
+			Entities:        textEntities(row.Entities),
 			Images:          row.Images,
 			Attachments:     attachments,
 			Tags:            row.TagNames,
@@ -364,9 +627,19 @@ func (s *PostService) ListMyPosts(ctx context.Context, uid string, req *api.List
 			Collected:       row.Collected,
 			CreatedAt:       row.CreatedAt.Unix(),
 			UpdatedAt:       row.UpdatedAt.Unix(),
+			RepostCount:     row.RepostCount,
+			Edited:          row.EditCount > 0,
+			EditCount:       row.EditCount,
+			Reposted:        row.Reposted,
+			RepostOfUid:     row.RepostOfUid,
+			QuotedPostUid:   row.QuotedPostUid,
 		})
 	}
 
+	if err := embedPosts(ctx, s.db, uid, posts...); err != nil {
+		return nil, err
+	}
+
 	var nextCursorCreatedAt int64
 	var nextCursorID string
 	if len(rows) > 0 {
@@ -394,10 +667,6 @@ func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *ap
 
 	posts := make([]*api.Post, 0, len(rows))
 	for _, row := range rows {
-		if row.Visibility == db.PostVisibilityPRIVATE && uid != row.Author.String() {
-			continue
-		}
-
 		fileRow, err := s.db.GetFilesByUrls(ctx, row.Attachments)
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			continue
@@ -423,6 +692,7 @@ func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *ap
 				IsFollowing: false, // TODO: compute with viewer context
 			},
 			Text:            row.Text,
+			Entities:        textEntities(row.Entities),
 			Images:          row.Images,
 			Attachments:     attachments,
 			Tags:            row.TagNames,
@@ -437,9 +707,19 @@ func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *ap
 			Collected:       row.Collected,
 			CreatedAt:       row.CreatedAt.Unix(),
 			UpdatedAt:       row.UpdatedAt.Unix(),
+			RepostCount:     row.RepostCount,
+			Edited:          row.EditCount > 0,
+			EditCount:       row.EditCount,
+			Reposted:        row.Reposted,
+			RepostOfUid:     row.RepostOfUid,
+			QuotedPostUid:   row.QuotedPostUid,
 		})
 	}
 
+	if err := embedPosts(ctx, s.db, uid, posts...); err != nil {
+		return nil, err
+	}
+
 	var nextCursorCreatedAt int64
 	var nextCursorID string
 	if len(rows) > 0 {
@@ -456,6 +736,8 @@ func (s *PostService) ListMyCollections(ctx context.Context, uid string, req *ap
 }
 
 func (s *PostService) UpdatePost(ctx context.Context, uid string, req *api.UpdatePostRequest) error {
+	var pending []event.Event
+	var justPublished bool
 	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
 		params := db.UpdatePostByUidAndAuthorParams{
 			Uid:    util.UUID(req.Uid),
@@ -465,8 +747,74 @@ func (s *PostService) UpdatePost(ctx context.Context, uid string, req *api.Updat
 		for _, path := range req.UpdateMask.GetPaths() {
 			paths[path] = struct{}{}
 		}
-		if _, ok := paths["text"]; ok {
+		current, err := qtx.LockPostByUidAndAuthor(ctx, db.LockPostByUidAndAuthorParams{
+			Uid:    params.Uid,
+			Author: params.Author,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("post not found")
+			}
+			return fmt.Errorf("get post: %w", err)
+		}
+		published := current == db.PostStatusNORMAL
+
+		// Drafts can be scheduled, unscheduled or published now; published posts stay put.
+		_, statusChanged := paths["status"]
+		_, publishAtChanged := paths["publish_at"]
+		if published && (statusChanged || publishAtChanged) {
+			return fmt.Errorf("post is already published")
+		}
+		if publishAtChanged {
+			params.UpdatePublishAt = true
+			params.Status = db.NullPostStatus{PostStatus: db.PostStatusDRAFT, Valid: true}
+			if req.Post.PublishAt != 0 {
+				params.PublishAt = sql.NullTime{Time: time.Unix(req.Post.PublishAt, 0).UTC(), Valid: true}
+				params.Status = db.NullPostStatus{PostStatus: db.PostStatusSCHEDULED, Valid: true}
+			}
+		}
+		if statusChanged && (req.Post.Status == string(db.PostStatusNORMAL) || !publishAtChanged) {
+			params.UpdatePublishAt = true
+			params.PublishAt = sql.NullTime{}
+			params.Status = db.NullPostStatus{PostStatus: db.PostStatus(req.Post.Status), Valid: true}
+		}
+
+		_, textChanged := paths["text"]
+		_, tagsChanged := paths["tags"]
+		// Tags are the explicit ones merged with the #hashtags of the text. Whichever half
+		// is not being updated is read back before the text is overwritten.
+		var entities []util.Entity
+		var explicitTags, inlineTags []string
+		if tagsChanged {
+			explicitTags = req.Post.Tags
+		}
+		if textChanged {
+			var mentions []util.Entity
+			mentions, err = s.notifications.ResolveMentions(ctx, qtx, params.Author, req.Post.Text)
+			if err != nil {
+				return err
+			}
+			hashtags := util.ExtractHashtags(req.Post.Text)
+			entities = util.MergeEntities(mentions, hashtags)
+			var encoded string
+			encoded, err = util.EncodeEntities(entities)
+			if err != nil {
+				return fmt.Errorf("encode entities: %w", err)
+			}
 			params.Text = sql.NullString{String: req.Post.Text, Valid: true}
+			params.Entities = sql.NullString{String: encoded, Valid: true}
+			inlineTags = util.HashtagNames(hashtags)
+			if !tagsChanged {
+				explicitTags, err = qtx.ListExplicitPostTags(ctx, params.Uid)
+				if err != nil {
+					return fmt.Errorf("list post tags: %w", err)
+				}
+			}
+		} else if tagsChanged {
+			inlineTags, err = qtx.ListInlinePostTags(ctx, params.Uid)
+			if err != nil {
+				return fmt.Errorf("list post tags: %w", err)
+			}
 		}
 		if _, ok := paths["images"]; ok {
 			params.Images = req.Post.Images
@@ -481,29 +829,82 @@ func (s *PostService) UpdatePost(ctx context.Context, uid string, req *api.Updat
 			params.Pinned = sql.NullBool{Bool: req.Post.Pinned, Valid: true}
 		}
 
-		id, err := qtx.UpdatePostByUidAndAuthor(ctx, params)
+		// Keep the version being replaced. Pinning is not an edit of the content, and
+		// drafts have no history until they are published.
+		_, imagesChanged := paths["images"]
+		_, attachmentsChanged := paths["attachments"]
+		_, visibilityChanged := paths["visibility"]
+		params.Edited = published && (textChanged || tagsChanged || imagesChanged || attachmentsChanged || visibilityChanged)
+		if params.Edited {
+			if _, err := qtx.CreatePostRevision(ctx, db.CreatePostRevisionParams{
+				Uid:    params.Uid,
+				Author: params.Author,
+			}); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("post not found")
+				}
+				return fmt.Errorf("create post revision: %w", err)
+			}
+		}
+
+		row, err := qtx.UpdatePostByUidAndAuthor(ctx, params)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return fmt.Errorf("post not found")
 			}
 			return fmt.Errorf("update post: %w", err)
 		}
-		if _, ok := paths["tags"]; ok {
+		if textChanged || tagsChanged {
 			err = qtx.UpsertPostTags(ctx, db.UpsertPostTagsParams{
-				PostID: id,
-				Tags:   util.NormalizeStrings(req.Post.Tags),
+				PostID: row.ID,
+				Tags:   util.NormalizeStrings(append(explicitTags, inlineTags...)),
 			})
 			if err != nil {
 				return fmt.Errorf("update post: %w", err)
 			}
 		}
+		justPublished = !published && row.Status == db.PostStatusNORMAL
+		if justPublished && !textChanged {
+			entities, err = util.DecodeEntities(row.Entities)
+			if err != nil {
+				return fmt.Errorf("decode entities: %w", err)
+			}
+		}
+		if row.Status == db.PostStatusNORMAL && (textChanged || justPublished) {
+			pending, err = s.notifications.SyncMentions(ctx, qtx, db.MentionSourcePOST, params.Uid, params.Author, params.Uid, entities)
+			if err != nil {
+				return err
+			}
+		}
+		if justPublished {
+			if err := s.timeline.EnqueueFanout(ctx, qtx, params.Uid); err != nil {
+				return err
+			}
+		}
 		return nil
 	}); err != nil {
 		return err
 	}
+	if justPublished {
+		s.timeline.Wake()
+	}
+	s.events.Publish(ctx, pending...)
 	return nil
 }
 
+func (s *PostService) ListPostRevisions(ctx context.Context, viewerUid string, req *api.ListPostRevisionsRequest) (*api.ListPostRevisionsResponse, error) {
+	if _, err := s.db.GetPostVisibilityByUid(ctx, db.GetPostVisibilityByUidParams{
+		Uid:    util.UUID(req.Uid),
+		Viewer: uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("post not found")
+		}
+		return nil, fmt.Errorf("get post: %w", err)
+	}
+	return listPostRevisions(ctx, s.db, req)
+}
+
 func (s *PostService) DeletePost(ctx context.Context, uid string, req *api.DeletePostRequest) error {
 	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
 		affected, err := qtx.ArchivePostByUidAndAuthor(ctx, db.ArchivePostByUidAndAuthorParams{
@@ -513,6 +914,15 @@ func (s *PostService) DeletePost(ctx context.Context, uid string, req *api.Delet
 		if err != nil {
 			return fmt.Errorf("archive post: %w", err)
 		}
+		if affected == 0 {
+			affected, err = qtx.DeleteDraftByUidAndAuthor(ctx, db.DeleteDraftByUidAndAuthorParams{
+				Uid:    util.UUID(req.Uid),
+				Author: util.UUID(uid),
+			})
+			if err != nil {
+				return fmt.Errorf("delete draft: %w", err)
+			}
+		}
 		if affected == 0 {
 			return fmt.Errorf("post not found or no permission")
 		}
@@ -524,26 +934,45 @@ func (s *PostService) LikePost(ctx context.Context, uid string, req *api.LikePos
 	postUid := util.UUID(req.Uid)
 	userUid := util.UUID(uid)
 
-	var (
-		count int32
-		err   error
-	)
-
-	switch req.Action {
-	case api.ToggleAction_TOGGLE_ACTION_ADD:
-		count, err = s.db.AddPostLike(ctx, db.AddPostLikeParams{
-			PostUid: postUid,
-			UserUid: userUid,
-		})
-	default:
-		count, err = s.db.RemovePostLike(ctx, db.RemovePostLikeParams{
-			PostUid: postUid,
-			UserUid: userUid,
-		})
-	}
-	if err != nil {
-		return nil, fmt.Errorf("post like: %w", err)
+	var count int32
+	var pending []event.Event
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		var err error
+		switch req.Action {
+		case api.ToggleAction_TOGGLE_ACTION_ADD:
+			post, err := checkInteractable(ctx, qtx, userUid, postUid)
+			if err != nil {
+				return err
+			}
+			count, err = qtx.AddPostLike(ctx, db.AddPostLikeParams{
+				PostUid: postUid,
+				UserUid: userUid,
+			})
+			if err != nil {
+				return fmt.Errorf("post like: %w", err)
+			}
+			ev, err := s.notifications.Notify(ctx, qtx, db.NotificationTypePOSTLIKE, post.Author, userUid, postUid, uuid.NullUUID{UUID: postUid, Valid: true})
+			if err != nil {
+				return err
+			}
+			if ev != nil {
+				pending = append(pending, *ev)
+			}
+		default:
+			count, err = qtx.RemovePostLike(ctx, db.RemovePostLikeParams{
+				PostUid: postUid,
+				UserUid: userUid,
+			})
+			if err != nil {
+				return fmt.Errorf("post like: %w", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
+	pending = append(pending, event.Counter(req.Uid, "POST", req.Uid, "LIKE", count))
+	s.events.Publish(ctx, pending...)
 	return &api.LikePostResponse{
 		Count: count,
 	}, nil
@@ -560,6 +989,9 @@ func (s *PostService) CollectPost(ctx context.Context, uid string, req *api.Coll
 
 	switch req.Action {
 	case api.ToggleAction_TOGGLE_ACTION_ADD:
+		if _, err := checkInteractable(ctx, s.db, userUid, postUid); err != nil {
+			return nil, err
+		}
 		count, err = s.db.AddPostCollection(ctx, db.AddPostCollectionParams{
 			PostUid: postUid,
 			UserUid: userUid,
@@ -573,7 +1005,407 @@ func (s *PostService) CollectPost(ctx context.Context, uid string, req *api.Coll
 	if err != nil {
 		return nil, fmt.Errorf("post collection: %w", err)
 	}
+	s.events.Publish(ctx, event.Counter(req.Uid, "POST", req.Uid, "COLLECTION", count))
 	return &api.CollectPostResponse{
 		Count: count,
 	}, nil
 }
+
+func (s *PostService) RepostPost(ctx context.Context, uid string, req *api.RepostPostRequest) (*api.RepostPostResponse, error) {
+	postUid := util.UUID(req.Uid)
+	userUid := util.UUID(uid)
+
+	var count int32
+	var repostUid uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		switch req.Action {
+		case api.ToggleAction_TOGGLE_ACTION_ADD:
+			target, err := checkShareable(ctx, qtx, userUid, postUid)
+			if err != nil {
+				return err
+			}
+			if target.Visibility != db.PostVisibilityPUBLIC || target.AuthorIsPrivate {
+				return fmt.Errorf("only public posts can be reposted")
+			}
+			row, err := qtx.CreateRepost(ctx, db.CreateRepostParams{
+				Author:  userUid,
+				PostUid: postUid,
+			})
+			if err != nil {
+				return fmt.Errorf("repost: %w", err)
+			}
+			count = row.RepostCount
+			repostUid = row.RepostUid
+			if err := s.timeline.EnqueueFanout(ctx, qtx, repostUid); err != nil {
+				return err
+			}
+		default:
+			var err error
+			count, err = qtx.RemoveRepost(ctx, db.RemoveRepostParams{
+				Author:  userUid,
+				PostUid: postUid,
+			})
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("post not found")
+				}
+				return fmt.Errorf("repost: %w", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if repostUid != uuid.Nil {
+		s.timeline.Wake()
+	}
+	s.events.Publish(ctx, event.Counter(req.Uid, "POST", req.Uid, "REPOST", count))
+	return &api.RepostPostResponse{
+		Count: count,
+	}, nil
+}
+
+func (s *PostService) VotePoll(ctx context.Context, uid string, req *api.VotePollRequest) (*api.VotePollResponse, error) {
+	postUid := util.UUID(req.Uid)
+	userUid := util.UUID(uid)
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		post, err := qtx.GetPostVisibilityByUid(ctx, db.GetPostVisibilityByUidParams{
+			Uid:    postUid,
+			Viewer: uuid.NullUUID{UUID: userUid, Valid: true},
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("post not found")
+			}
+			return fmt.Errorf("get post: %w", err)
+		}
+		if err := checkNotBlocked(ctx, qtx, userUid, post.Author); err != nil {
+			return err
+		}
+		poll, err := qtx.GetPollByPostUid(ctx, postUid)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("poll not found")
+			}
+			return fmt.Errorf("get poll: %w", err)
+		}
+		if !poll.ExpiresAt.After(time.Now()) {
+			return fmt.Errorf("poll is closed")
+		}
+
+		positions := make([]int32, 0, len(req.Choices))
+		for _, choice := range req.Choices {
+			if choice < 0 || choice >= poll.OptionCount {
+				return fmt.Errorf("choice is invalid")
+			}
+			if !slices.Contains(positions, choice) {
+				positions = append(positions, choice)
+			}
+		}
+		if !poll.Multiple && len(positions) != 1 {
+			return fmt.Errorf("poll allows a single choice")
+		}
+
+		// The ballot's primary key is (poll, user), so a second vote inserts nothing
+		voted, err := qtx.VotePoll(ctx, db.VotePollParams{
+			UserUid:   userUid,
+			Positions: positions,
+			PollUid:   poll.Uid,
+		})
+		if err != nil {
+			return fmt.Errorf("vote poll: %w", err)
+		}
+		if voted == 0 {
+			return fmt.Errorf("already voted")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	polls, err := getPolls(ctx, s.db, uid, postUid)
+	if err != nil {
+		return nil, err
+	}
+	return &api.VotePollResponse{
+		Poll: polls[req.Uid],
+	}, nil
+}
+
+// checkVisible fails with "post not found" unless viewer, nil for anonymous callers, can
+// see postUid.
+func checkVisible(ctx context.Context, q *db.Queries, viewer uuid.NullUUID, postUid uuid.UUID) (db.GetPostVisibilityByUidRow, error) {
+	row, err := q.GetPostVisibilityByUid(ctx, db.GetPostVisibilityByUidParams{
+		Uid:    postUid,
+		Viewer: viewer,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return row, fmt.Errorf("post not found")
+		}
+		return row, fmt.Errorf("get post: %w", err)
+	}
+	return row, nil
+}
+
+// checkInteractable fails unless userUid may comment on, like or collect postUid: the
+// post must be visible to userUid and its author must not have blocked them.
+func checkInteractable(ctx context.Context, qtx *db.Queries, userUid, postUid uuid.UUID) (db.GetPostVisibilityByUidRow, error) {
+	row, err := checkVisible(ctx, qtx, uuid.NullUUID{UUID: userUid, Valid: true}, postUid)
+	if err != nil {
+		return row, err
+	}
+	if err := checkNotBlocked(ctx, qtx, userUid, row.Author); err != nil {
+		return row, err
+	}
+	return row, nil
+}
+
+// checkShareable fails unless userUid may repost or quote postUid: the post must be an
+// original (not itself a repost) that userUid can see and whose author has not blocked them.
+func checkShareable(ctx context.Context, qtx *db.Queries, userUid, postUid uuid.UUID) (db.GetPostVisibilityByUidRow, error) {
+	row, err := checkVisible(ctx, qtx, uuid.NullUUID{UUID: userUid, Valid: true}, postUid)
+	if err != nil {
+		return row, err
+	}
+	if row.IsRepost {
+		return row, fmt.Errorf("cannot share a repost, share the original post instead")
+	}
+	if err := checkNotBlocked(ctx, qtx, userUid, row.Author); err != nil {
+		return row, err
+	}
+	return row, nil
+}
+
+// listPostRevisions lists the earlier versions of a post, most recently replaced first,
+// without checking whether the caller may see the post.
+func listPostRevisions(ctx context.Context, q *db.Queries, req *api.ListPostRevisionsRequest) (*api.ListPostRevisionsResponse, error) {
+	rows, err := q.ListPostRevisions(ctx, db.ListPostRevisionsParams{
+		PostUid:         util.UUID(req.Uid),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list post revisions: %w", err)
+	}
+
+	revisions := make([]*api.PostRevision, 0, len(rows))
+	for _, row := range rows {
+		fileRow, err := q.GetFilesByUrls(ctx, row.Attachments)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("get attachments: %w", err)
+		}
+		attachments := make([]*api.Attachment, 0, len(row.Attachments))
+		for _, file := range fileRow {
+			attachments = append(attachments, &api.Attachment{
+				Url:         file.Url,
+				Name:        file.Name,
+				ContentType: file.ContentType,
+				Size:        file.Size,
+				Checksum:    file.Checksum,
+			})
+		}
+		revisions = append(revisions, &api.PostRevision{
+			Uid:         row.Uid.String(),
+			Revision:    row.Revision,
+			Text:        row.Text,
+			Entities:    textEntities(row.Entities),
+			Images:      row.Images,
+			Attachments: attachments,
+			Tags:        row.Tags,
+			Visibility:  string(row.Visibility),
+			PublishedAt: row.PublishedAt.Unix(),
+			ReplacedAt:  row.CreatedAt.Unix(),
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListPostRevisionsResponse{
+		Revisions:           revisions,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+// textEntities converts entities stored as JSON text to their API form. The column is
+// only written through util.EncodeEntities, so undecodable values are treated as empty.
+func textEntities(raw string) []*api.TextEntity {
+	entities, err := util.DecodeEntities(raw)
+	if err != nil {
+		return []*api.TextEntity{}
+	}
+	out := make([]*api.TextEntity, 0, len(entities))
+	for _, entity := range entities {
+		out = append(out, &api.TextEntity{
+			Type:  entity.Type,
+			Start: int32(entity.Start),
+			End:   int32(entity.End),
+			Text:  entity.Text,
+			Uid:   entity.Uid,
+		})
+	}
+	return out
+}
+
+// embedPosts fills in the reposted and quoted originals of posts as viewerUid sees them,
+// and the polls of both. An original that is archived, hidden from the viewer or deleted
+// becomes a tombstone that carries only its uid.
+func embedPosts(ctx context.Context, q *db.Queries, viewerUid string, posts ...*api.Post) error {
+	if err := attachPolls(ctx, q, viewerUid, posts...); err != nil {
+		return err
+	}
+	var uids []uuid.UUID
+	for _, post := range posts {
+		if post.RepostOfUid != "" {
+			uids = append(uids, util.UUID(post.RepostOfUid))
+		}
+		if post.QuotedPostUid != "" {
+			uids = append(uids, util.UUID(post.QuotedPostUid))
+		}
+	}
+	if len(uids) == 0 {
+		return nil
+	}
+	rows, err := q.GetEmbeddedPosts(ctx, db.GetEmbeddedPostsParams{
+		Uids:   uids,
+		Viewer: uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+	})
+	if err != nil {
+		return fmt.Errorf("get embedded posts: %w", err)
+	}
+
+	embedded := make(map[string]*api.Post, len(rows))
+	for _, row := range rows {
+		if !row.Visible {
+			continue
+		}
+		fileRow, err := q.GetFilesByUrls(ctx, row.Attachments)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("get attachments: %w", err)
+		}
+		attachments := make([]*api.Attachment, 0, len(row.Attachments))
+		for _, file := range fileRow {
+			attachments = append(attachments, &api.Attachment{
+				Url:         file.Url,
+				Name:        file.Name,
+				ContentType: file.ContentType,
+				Size:        file.Size,
+				Checksum:    file.Checksum,
+			})
+		}
+		embedded[row.Uid.String()] = &api.Post{
+			Uid: row.Uid.String(),
+			Author: &api.PostAuthor{
+				Uid:       row.AuthorUid.String(),
+				Nickname:  row.AuthorNickname,
+				AvatarUrl: row.AuthorAvatarUrl,
+			},
+			Text:            row.Text,
+			Entities:        textEntities(row.Entities),
+			Images:          row.Images,
+			Attachments:     attachments,
+			Tags:            row.TagNames,
+			CommentCount:    row.CommentCount,
+			CollectionCount: row.CollectionCount,
+			LikeCount:       row.LikeCount,
+			RepostCount:     row.RepostCount,
+			Edited:          row.EditCount > 0,
+			EditCount:       row.EditCount,
+			Visibility:      string(row.Visibility),
+			CreatedAt:       row.CreatedAt.Unix(),
+			UpdatedAt:       row.UpdatedAt.Unix(),
+		}
+	}
+
+	originals := make([]*api.Post, 0, len(embedded))
+	for _, post := range embedded {
+		originals = append(originals, post)
+	}
+	if err := attachPolls(ctx, q, viewerUid, originals...); err != nil {
+		return err
+	}
+
+	embed := func(uid string) *api.Post {
+		if uid == "" {
+			return nil
+		}
+		if post, ok := embedded[uid]; ok {
+			return post
+		}
+		return &api.Post{Uid: uid, Tombstone: true}
+	}
+	for _, post := range posts {
+		post.RepostOf = embed(post.RepostOfUid)
+		post.QuotedPost = embed(post.QuotedPostUid)
+	}
+	return nil
+}
+
+// attachPolls sets the poll of each post that carries one.
+func attachPolls(ctx context.Context, q *db.Queries, viewerUid string, posts ...*api.Post) error {
+	uids := make([]uuid.UUID, 0, len(posts))
+	for _, post := range posts {
+		uids = append(uids, util.UUID(post.Uid))
+	}
+	if len(uids) == 0 {
+		return nil
+	}
+	polls, err := getPolls(ctx, q, viewerUid, uids...)
+	if err != nil {
+		return err
+	}
+	for _, post := range posts {
+		post.Poll = polls[post.Uid]
+	}
+	return nil
+}
+
+// getPolls returns the polls of the given posts keyed by post uid. Vote counts are left
+// out until the viewer has voted or the poll has closed, so early results do not sway
+// the vote.
+func getPolls(ctx context.Context, q *db.Queries, viewerUid string, postUids ...uuid.UUID) (map[string]*api.Poll, error) {
+	rows, err := q.GetPollsByPostUids(ctx, db.GetPollsByPostUidsParams{
+		Viewer:   uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		PostUids: postUids,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get polls: %w", err)
+	}
+
+	now := time.Now()
+	polls := make(map[string]*api.Poll, len(rows))
+	for _, row := range rows {
+		closed := !row.ExpiresAt.After(now)
+		resultsVisible := closed || row.Voted
+		options := make([]*api.PollOption, 0, len(row.OptionTexts))
+		for i, text := range row.OptionTexts {
+			option := &api.PollOption{
+				Index: int32(i),
+				Text:  text,
+			}
+			if resultsVisible && i < len(row.OptionVotes) {
+				option.VoteCount = row.OptionVotes[i]
+			}
+			options = append(options, option)
+		}
+		polls[row.PostUid.String()] = &api.Poll{
+			Uid:            row.Uid.String(),
+			Multiple:       row.Multiple,
+			ExpiresAt:      row.ExpiresAt.Unix(),
+			Closed:         closed,
+			VoterCount:     row.VoterCount,
+			Options:        options,
+			Voted:          row.Voted,
+			MyChoices:      row.MyPositions,
+			ResultsVisible: resultsVisible,
+		}
+	}
+	return polls, nil
+}
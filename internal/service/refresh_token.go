@@ -0,0 +1,74 @@
+package service
+
+import (
+	"aeibi/util"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const defaultRefreshReuseGrace = 5 * time.Second
+
+// maxSuccessorChain bounds how many rotations graceSuccessor follows to reach the
+// session's current refresh token.
+const maxSuccessorChain = 10
+
+// successorKey derives the key a rotated token's successor is sealed with. Only holders
+// of the rotated token can derive it; the prefix keeps it apart from the stored hash.
+func successorKey(token string) *[32]byte {
+	key := sha256.Sum256([]byte("refresh-successor:" + token))
+	return &key
+}
+
+// sealSuccessor encrypts successor, the token that replaces token, so that it can be
+// recovered by presenting token again.
+func sealSuccessor(token, successor string) ([]byte, error) {
+	var nonce [24]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return secretbox.Seal(nonce[:], []byte(successor), &nonce, successorKey(token)), nil
+}
+
+func openSuccessor(token string, sealed []byte) (string, bool) {
+	if len(sealed) < 24+secretbox.Overhead {
+		return "", false
+	}
+	var nonce [24]byte
+	copy(nonce[:], sealed[:24])
+	opened, ok := secretbox.Open(nil, sealed[24:], &nonce, successorKey(token))
+	if !ok {
+		return "", false
+	}
+	return string(opened), true
+}
+
+// graceSuccessor returns the session's current refresh token for token, which was
+// rotated at rotatedAt and sealed its successor into sealed. Successors rotated in turn
+// are followed through lookup, which returns the sealed successor of a rotated token hash
+// or nil when there is none. It returns "" when the grace window has passed or the chain
+// does not lead to currentHash, in which case the reuse must be treated as theft.
+func graceSuccessor(token string, sealed []byte, rotatedAt, now time.Time, grace time.Duration, currentHash string, lookup func(hash string) ([]byte, error)) (string, error) {
+	if now.Sub(rotatedAt) > grace {
+		return "", nil
+	}
+	for range maxSuccessorChain {
+		next, ok := openSuccessor(token, sealed)
+		if !ok {
+			return "", nil
+		}
+		hash := util.SHA256([]byte(next))
+		if hash == currentHash {
+			return next, nil
+		}
+		var err error
+		if sealed, err = lookup(hash); err != nil || sealed == nil {
+			return "", err
+		}
+		token = next
+	}
+	return "", nil
+}
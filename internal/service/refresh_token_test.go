@@ -0,0 +1,71 @@
+package service
+
+import (
+	"aeibi/util"
+	"testing"
+	"time"
+)
+
+// rotation seals each token's successor the way RefreshToken does and returns the sealed
+// successors by token hash.
+func rotation(t *testing.T, tokens ...string) map[string][]byte {
+	t.Helper()
+	sealed := make(map[string][]byte)
+	for i := 0; i+1 < len(tokens); i++ {
+		box, err := sealSuccessor(tokens[i], tokens[i+1])
+		if err != nil {
+			t.Fatalf("seal successor: %v", err)
+		}
+		sealed[util.SHA256([]byte(tokens[i]))] = box
+	}
+	return sealed
+}
+
+func TestGraceSuccessor(t *testing.T) {
+	now := time.Now()
+	grace := 5 * time.Second
+
+	tests := []struct {
+		name      string
+		tokens    []string
+		rotatedAt time.Time
+		tamper    bool
+		want      string
+	}{
+		{name: "reuse after grace revokes", tokens: []string{"a", "b"}, rotatedAt: now.Add(-grace - time.Second)},
+		{name: "retry within grace returns successor", tokens: []string{"a", "b"}, rotatedAt: now.Add(-time.Second), want: "b"},
+		{name: "chain within grace returns current token", tokens: []string{"a", "b", "c", "d"}, rotatedAt: now.Add(-time.Second), want: "d"},
+		{name: "tampered successor revokes", tokens: []string{"a", "b"}, rotatedAt: now.Add(-time.Second), tamper: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sealed := rotation(t, tt.tokens...)
+			first := sealed[util.SHA256([]byte(tt.tokens[0]))]
+			if tt.tamper {
+				first[len(first)-1] ^= 1
+			}
+			current := util.SHA256([]byte(tt.tokens[len(tt.tokens)-1]))
+			got, err := graceSuccessor(tt.tokens[0], first, tt.rotatedAt, now, grace, current, func(hash string) ([]byte, error) {
+				return sealed[hash], nil
+			})
+			if err != nil {
+				t.Fatalf("graceSuccessor: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("graceSuccessor = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraceSuccessorRequiresRotatedToken(t *testing.T) {
+	now := time.Now()
+	sealed := rotation(t, "a", "b")
+	// Only the holder of the rotated token can recover its successor.
+	got, err := graceSuccessor("guess", sealed[util.SHA256([]byte("a"))], now, now, time.Minute, util.SHA256([]byte("b")), func(string) ([]byte, error) {
+		return nil, nil
+	})
+	if err != nil || got != "" {
+		t.Fatalf("graceSuccessor = %q, %v, want empty", got, err)
+	}
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RelationService manages blocks and mutes. A block cuts follows in both directions and
+// stops the blocked user from following, commenting on, liking or messaging the blocker;
+// a mute only hides the muted user's posts and comments from the muter.
+type RelationService struct {
+	db       *db.Queries
+	dbx      *sql.DB
+	timeline *TimelineService
+}
+
+func NewRelationService(dbx *sql.DB, timeline *TimelineService) *RelationService {
+	return &RelationService{
+		db:       db.New(dbx),
+		dbx:      dbx,
+		timeline: timeline,
+	}
+}
+
+func (s *RelationService) Block(ctx context.Context, uid string, req *api.BlockRequest) error {
+	blockerUid := util.UUID(uid)
+	blockedUid := util.UUID(req.Uid)
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		switch req.Action {
+		case api.ToggleAction_TOGGLE_ACTION_ADD:
+			if _, err := qtx.AddBlock(ctx, db.AddBlockParams{
+				BlockerUid: blockerUid,
+				BlockedUid: blockedUid,
+			}); err != nil {
+				return fmt.Errorf("block: %w", err)
+			}
+			for _, edge := range [][2]uuid.UUID{{blockerUid, blockedUid}, {blockedUid, blockerUid}} {
+				if _, err := qtx.RemoveFollow(ctx, db.RemoveFollowParams{
+					FollowerUid: edge[0],
+					FolloweeUid: edge[1],
+				}); err != nil {
+					return fmt.Errorf("block: %w", err)
+				}
+				if err := s.timeline.Remove(ctx, qtx, edge[0], edge[1]); err != nil {
+					return fmt.Errorf("block: %w", err)
+				}
+				if _, err := qtx.DeleteFollowRequest(ctx, db.DeleteFollowRequestParams{
+					RequesterUid: edge[0],
+					TargetUid:    edge[1],
+				}); err != nil {
+					return fmt.Errorf("block: %w", err)
+				}
+			}
+		default:
+			if _, err := qtx.RemoveBlock(ctx, db.RemoveBlockParams{
+				BlockerUid: blockerUid,
+				BlockedUid: blockedUid,
+			}); err != nil {
+				return fmt.Errorf("unblock: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *RelationService) Mute(ctx context.Context, uid string, req *api.MuteRequest) error {
+	params := db.AddMuteParams{
+		MuterUid: util.UUID(uid),
+		MutedUid: util.UUID(req.Uid),
+	}
+	switch req.Action {
+	case api.ToggleAction_TOGGLE_ACTION_ADD:
+		if _, err := s.db.AddMute(ctx, params); err != nil {
+			return fmt.Errorf("mute: %w", err)
+		}
+	default:
+		if _, err := s.db.RemoveMute(ctx, db.RemoveMuteParams(params)); err != nil {
+			return fmt.Errorf("unmute: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *RelationService) ListMyBlocks(ctx context.Context, uid string, req *api.ListMyBlocksRequest) (*api.ListMyBlocksResponse, error) {
+	rows, err := s.db.ListBlocks(ctx, db.ListBlocksParams{
+		Uid:             util.UUID(uid),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list blocks: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.BlockedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListMyBlocksResponse{
+		Users:               users,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *RelationService) ListMyMutes(ctx context.Context, uid string, req *api.ListMyMutesRequest) (*api.ListMyMutesResponse, error) {
+	rows, err := s.db.ListMutes(ctx, db.ListMutesParams{
+		Uid:             util.UUID(uid),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list mutes: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.MutedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListMyMutesResponse{
+		Users:               users,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+// checkNotBlocked fails when any of blockerUids has blocked userUid.
+func checkNotBlocked(ctx context.Context, qtx *db.Queries, userUid uuid.UUID, blockerUids ...uuid.UUID) error {
+	blocked, err := qtx.IsBlockedByAny(ctx, db.IsBlockedByAnyParams{
+		BlockerUids: blockerUids,
+		BlockedUid:  userUid,
+	})
+	if err != nil {
+		return fmt.Errorf("check block: %w", err)
+	}
+	if blocked {
+		return fmt.Errorf("you have been blocked by this user")
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReportService struct {
+	db    *db.Queries
+	dbx   *sql.DB
+	admin *AdminService
+}
+
+func NewReportService(dbx *sql.DB, admin *AdminService) *ReportService {
+	return &ReportService{
+		db:    db.New(dbx),
+		dbx:   dbx,
+		admin: admin,
+	}
+}
+
+// CreateReport files a report against a post, comment or user. A second report from
+// the same user on a still pending target updates the existing one instead.
+func (s *ReportService) CreateReport(ctx context.Context, uid string, req *api.CreateReportRequest) (*api.CreateReportResponse, error) {
+	targetType := db.ReportTargetType(req.TargetType)
+	targetUid := util.UUID(req.TargetUid)
+
+	found, err := s.db.ReportTargetExists(ctx, db.ReportTargetExistsParams{
+		TargetType: targetType,
+		TargetUid:  targetUid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("check report target: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("report target not found")
+	}
+
+	reportUid, err := s.db.CreateReport(ctx, db.CreateReportParams{
+		ReporterUid: util.UUID(uid),
+		TargetType:  targetType,
+		TargetUid:   targetUid,
+		Reason:      db.ReportReason(req.Reason),
+		Detail:      req.Detail,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create report: %w", err)
+	}
+	return &api.CreateReportResponse{Uid: reportUid.String()}, nil
+}
+
+func (s *ReportService) ListReports(ctx context.Context, req *api.ListReportsRequest) (*api.ListReportsResponse, error) {
+	reportStatus := db.ReportStatusOPEN
+	if req.Status != "" {
+		reportStatus = db.ReportStatus(req.Status)
+	}
+	rows, err := s.db.ListReports(ctx, db.ListReportsParams{
+		Status:          reportStatus,
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list reports: %w", err)
+	}
+
+	reports := make([]*api.Report, 0, len(rows))
+	for _, row := range rows {
+		var handlerUid string
+		if row.HandlerUid.Valid {
+			handlerUid = row.HandlerUid.UUID.String()
+		}
+		reports = append(reports, &api.Report{
+			Uid:              row.Uid.String(),
+			ReporterUid:      row.ReporterUid.String(),
+			ReporterNickname: row.ReporterNickname.String,
+			TargetType:       string(row.TargetType),
+			TargetUid:        row.TargetUid.String(),
+			Reason:           string(row.Reason),
+			Detail:           row.Detail,
+			Status:           string(row.Status),
+			HandlerUid:       handlerUid,
+			Note:             row.Note,
+			PendingCount:     row.PendingCount,
+			CreatedAt:        row.CreatedAt.Unix(),
+			UpdatedAt:        row.UpdatedAt.Unix(),
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListReportsResponse{
+		Reports:             reports,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *ReportService) ClaimReport(ctx context.Context, uid string, req *api.ClaimReportRequest) error {
+	affected, err := s.db.ClaimReport(ctx, db.ClaimReportParams{
+		Uid:        util.UUID(req.Uid),
+		HandlerUid: util.UUID(uid),
+	})
+	if err != nil {
+		return fmt.Errorf("claim report: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("report not found or already claimed")
+	}
+	return nil
+}
+
+// ResolveReport archives the reported target through the same paths as the admin
+// moderation endpoints and closes every pending report on that target. A suspended
+// user's access tokens are revoked once the suspension has committed.
+func (s *ReportService) ResolveReport(ctx context.Context, uid string, req *api.ResolveReportRequest) error {
+	suspended := ""
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		report, err := s.getPendingReport(ctx, qtx, uid, req.Uid)
+		if err != nil {
+			return err
+		}
+
+		targetUid := report.TargetUid.String()
+		detail := "report " + req.Uid
+		switch report.TargetType {
+		case db.ReportTargetTypePOST:
+			changed, err := setPostStatus(ctx, qtx, report.TargetUid, db.PostStatusARCHIVED)
+			if err != nil {
+				return err
+			}
+			if changed {
+				if err := s.admin.audit(ctx, qtx, uid, db.AdminActionARCHIVEPOST, db.AdminTargetTypePOST, targetUid, req.Note, detail); err != nil {
+					return err
+				}
+			}
+		case db.ReportTargetTypeCOMMENT:
+			changed, err := setCommentStatus(ctx, qtx, report.TargetUid, db.CommentStatusARCHIVED)
+			if err != nil {
+				return err
+			}
+			if changed {
+				if err := s.admin.audit(ctx, qtx, uid, db.AdminActionARCHIVECOMMENT, db.AdminTargetTypeCOMMENT, targetUid, req.Note, detail); err != nil {
+					return err
+				}
+			}
+		case db.ReportTargetTypeUSER:
+			if _, err := s.admin.checkOutranks(ctx, qtx, uid, targetUid); err != nil {
+				return err
+			}
+			affected, err := qtx.SetUserStatus(ctx, db.SetUserStatusParams{
+				Uid:    report.TargetUid,
+				Status: db.UserStatusARCHIVED,
+			})
+			if err != nil {
+				return fmt.Errorf("set user status: %w", err)
+			}
+			if affected > 0 {
+				if err := s.admin.audit(ctx, qtx, uid, db.AdminActionSUSPENDUSER, db.AdminTargetTypeUSER, targetUid, req.Note, detail); err != nil {
+					return err
+				}
+				suspended = targetUid
+			}
+		default:
+			return fmt.Errorf("unknown report target type %s", report.TargetType)
+		}
+
+		if _, err := qtx.ResolvePendingReportsByTarget(ctx, db.ResolvePendingReportsByTargetParams{
+			HandlerUid: util.UUID(uid),
+			Note:       req.Note,
+			TargetType: report.TargetType,
+			TargetUid:  report.TargetUid,
+		}); err != nil {
+			return fmt.Errorf("resolve reports: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if suspended != "" {
+		return s.admin.denylist.DenyUser(ctx, suspended)
+	}
+	return nil
+}
+
+func (s *ReportService) DismissReport(ctx context.Context, uid string, req *api.DismissReportRequest) error {
+	affected, err := s.db.CloseReport(ctx, db.CloseReportParams{
+		Uid:        util.UUID(req.Uid),
+		Status:     db.ReportStatusDISMISSED,
+		HandlerUid: util.UUID(uid),
+		Note:       req.Note,
+	})
+	if err != nil {
+		return fmt.Errorf("dismiss report: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("report not found or claimed by another moderator")
+	}
+	return nil
+}
+
+// getPendingReport loads a report the moderator may still act on: open, or claimed
+// by the moderator themselves.
+func (s *ReportService) getPendingReport(ctx context.Context, qtx *db.Queries, uid, reportUid string) (db.GetReportByUidRow, error) {
+	report, err := qtx.GetReportByUid(ctx, util.UUID(reportUid))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.GetReportByUidRow{}, fmt.Errorf("report not found")
+		}
+		return db.GetReportByUidRow{}, fmt.Errorf("get report: %w", err)
+	}
+	switch report.Status {
+	case db.ReportStatusOPEN:
+	case db.ReportStatusCLAIMED:
+		if report.HandlerUid.UUID != util.UUID(uid) {
+			return db.GetReportByUidRow{}, fmt.Errorf("report claimed by another moderator")
+		}
+	default:
+		return db.GetReportByUidRow{}, fmt.Errorf("report is already %s", report.Status)
+	}
+	return report, nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"aeibi/internal/config"
+	"aeibi/internal/event"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+const (
+	defaultSchedulerInterval  = 15 * time.Second
+	defaultSchedulerBatchSize = 100
+)
+
+// SchedulerService publishes scheduled posts once their publish_at has passed. Every
+// replica runs it; a post is claimed by flipping it to NORMAL under a row lock that other
+// replicas skip, so each post is published exactly once.
+type SchedulerService struct {
+	db            *db.Queries
+	dbx           *sql.DB
+	timeline      *TimelineService
+	notifications *NotificationService
+	events        event.Publisher
+	interval      time.Duration
+	batchSize     int32
+}
+
+func NewSchedulerService(dbx *sql.DB, cfg config.SchedulerConfig, timeline *TimelineService, notifications *NotificationService, events event.Publisher) *SchedulerService {
+	s := &SchedulerService{
+		db:            db.New(dbx),
+		dbx:           dbx,
+		timeline:      timeline,
+		notifications: notifications,
+		events:        events,
+		interval:      cfg.Interval,
+		batchSize:     int32(cfg.BatchSize),
+	}
+	if s.interval <= 0 {
+		s.interval = defaultSchedulerInterval
+	}
+	if s.batchSize <= 0 {
+		s.batchSize = defaultSchedulerBatchSize
+	}
+	return s
+}
+
+// Run publishes due posts every interval and blocks until ctx is done.
+func (s *SchedulerService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				n, err := s.publishDue(ctx)
+				if err != nil {
+					slog.Warn("publish scheduled posts", "error", err)
+					break
+				}
+				if n < int(s.batchSize) {
+					break
+				}
+			}
+		}
+	}
+}
+
+// publishDue publishes one batch of due posts and returns how many it published.
+func (s *SchedulerService) publishDue(ctx context.Context) (int, error) {
+	var rows []db.PublishDuePostsRow
+	var pending []event.Event
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		var err error
+		rows, err = qtx.PublishDuePosts(ctx, s.batchSize)
+		if err != nil {
+			return fmt.Errorf("publish due posts: %w", err)
+		}
+		for _, row := range rows {
+			entities, err := util.DecodeEntities(row.Entities)
+			if err != nil {
+				return fmt.Errorf("decode entities: %w", err)
+			}
+			evs, err := s.notifications.SyncMentions(ctx, qtx, db.MentionSourcePOST, row.Uid, row.Author, row.Uid, entities)
+			if err != nil {
+				return err
+			}
+			pending = append(pending, evs...)
+			if err := s.timeline.EnqueueFanout(ctx, qtx, row.Uid); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if len(rows) > 0 {
+		s.timeline.Wake()
+	}
+	s.events.Publish(ctx, pending...)
+	return len(rows), nil
+}
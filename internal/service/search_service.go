@@ -0,0 +1,240 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// snippetWidth is the length in runes of highlighted search snippets.
+const snippetWidth = 120
+
+// SearchService matches posts, comments and users with tsvector queries, falling back
+// to trigram substring matching for text such as Chinese that has no word boundaries.
+type SearchService struct {
+	db  *db.Queries
+	dbx *sql.DB
+}
+
+func NewSearchService(dbx *sql.DB) *SearchService {
+	return &SearchService{
+		db:  db.New(dbx),
+		dbx: dbx,
+	}
+}
+
+func (s *SearchService) SearchPosts(ctx context.Context, viewerUid string, req *api.SearchRequest) (*api.SearchPostsResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	rows, err := s.db.SearchPosts(ctx, db.SearchPostsParams{
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		Query:           query,
+		Pattern:         util.EscapeLike(query),
+		AuthorUid:       uuid.NullUUID{UUID: util.UUID(req.AuthorUid), Valid: req.AuthorUid != ""},
+		Tag:             sql.NullString{String: req.Tag, Valid: req.Tag != ""},
+		CreatedAfter:    sql.NullTime{Time: time.Unix(req.CreatedAfter, 0).UTC(), Valid: req.CreatedAfter != 0},
+		CreatedBefore:   sql.NullTime{Time: time.Unix(req.CreatedBefore, 0).UTC(), Valid: req.CreatedBefore != 0},
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search posts: %w", err)
+	}
+
+	terms := util.SearchTerms(query)
+	hits := make([]*api.PostHit, 0, len(rows))
+	for _, row := range rows {
+		fileRow, err := s.db.GetFilesByUrls(ctx, row.Attachments)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		attachments := make([]*api.Attachment, 0, len(row.Attachments))
+		for _, file := range fileRow {
+			attachments = append(attachments, &api.Attachment{
+				Url:         file.Url,
+				Name:        file.Name,
+				ContentType: file.ContentType,
+				Size:        file.Size,
+				Checksum:    file.Checksum,
+			})
+		}
+
+		hits = append(hits, &api.PostHit{
+			Post: &api.Post{
+				Uid: row.Uid.String(),
+				Author: &api.PostAuthor{
+					Uid:         row.AuthorUid.String(),
+					Nickname:    row.AuthorNickname,
+					AvatarUrl:   row.AuthorAvatarUrl,
+					IsFollowing: false, // TODO: compute with viewer context
+				},
+				Text:            row.Text,
+				Entities:        textEntities(row.Entities),
+				Images:          row.Images,
+				Attachments:     attachments,
+				Tags:            row.TagNames,
+				CommentCount:    row.CommentCount,
+				CollectionCount: row.CollectionCount,
+				LikeCount:       row.LikeCount,
+				Visibility:      string(row.Visibility),
+				LatestRepliedOn: row.LatestRepliedOn.Unix(),
+				Ip:              row.Ip,
+				Pinned:          row.Pinned,
+				Liked:           row.Liked,
+				Collected:       row.Collected,
+				CreatedAt:       row.CreatedAt.Unix(),
+				UpdatedAt:       row.UpdatedAt.Unix(),
+				RepostCount:     row.RepostCount,
+				Edited:          row.EditCount > 0,
+				EditCount:       row.EditCount,
+				Reposted:        row.Reposted,
+				RepostOfUid:     row.RepostOfUid,
+				QuotedPostUid:   row.QuotedPostUid,
+			},
+			Snippet: util.Highlight(row.Text, terms, snippetWidth),
+		})
+	}
+
+	posts := make([]*api.Post, 0, len(hits))
+	for _, hit := range hits {
+		posts = append(posts, hit.Post)
+	}
+	if err := embedPosts(ctx, s.db, viewerUid, posts...); err != nil {
+		return nil, err
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.SearchPostsResponse{
+		Hits:                hits,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *SearchService) SearchComments(ctx context.Context, viewerUid string, req *api.SearchRequest) (*api.SearchCommentsResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	rows, err := s.db.SearchComments(ctx, db.SearchCommentsParams{
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		Query:           query,
+		Pattern:         util.EscapeLike(query),
+		AuthorUid:       uuid.NullUUID{UUID: util.UUID(req.AuthorUid), Valid: req.AuthorUid != ""},
+		Tag:             sql.NullString{String: req.Tag, Valid: req.Tag != ""},
+		CreatedAfter:    sql.NullTime{Time: time.Unix(req.CreatedAfter, 0).UTC(), Valid: req.CreatedAfter != 0},
+		CreatedBefore:   sql.NullTime{Time: time.Unix(req.CreatedBefore, 0).UTC(), Valid: req.CreatedBefore != 0},
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search comments: %w", err)
+	}
+
+	terms := util.SearchTerms(query)
+	hits := make([]*api.CommentHit, 0, len(rows))
+	for _, row := range rows {
+		parentUid := ""
+		if row.ParentUid.Valid {
+			parentUid = row.ParentUid.UUID.String()
+		}
+		replyToAuthorUid := ""
+		if row.ReplyToAuthorUid.Valid {
+			replyToAuthorUid = row.ReplyToAuthorUid.UUID.String()
+		}
+		hits = append(hits, &api.CommentHit{
+			Comment: &api.Comment{
+				Uid: row.Uid.String(),
+				Author: &api.CommentAuthor{
+					Uid:       row.AuthorUid.String(),
+					Nickname:  row.AuthorNickname,
+					AvatarUrl: row.AuthorAvatarUrl,
+				},
+				PostUid:          row.PostUid.String(),
+				RootUid:          row.RootUid.String(),
+				ParentUid:        parentUid,
+				ReplyToAuthorUid: replyToAuthorUid,
+				Content:          row.Content,
+				Entities:         textEntities(row.Entities),
+				Images:           row.Images,
+				ReplyCount:       row.ReplyCount,
+				LikeCount:        row.LikeCount,
+				Liked:            row.Liked,
+				CreatedAt:        row.CreatedAt.Unix(),
+				UpdatedAt:        row.UpdatedAt.Unix(),
+			},
+			Snippet: util.Highlight(row.Content, terms, snippetWidth),
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.SearchCommentsResponse{
+		Hits:                hits,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *SearchService) SearchUsers(ctx context.Context, viewerUid string, req *api.SearchRequest) (*api.SearchUsersResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	rows, err := s.db.SearchUsers(ctx, db.SearchUsersParams{
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		Query:           query,
+		Pattern:         util.EscapeLike(query),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search users: %w", err)
+	}
+
+	terms := util.SearchTerms(query)
+	hits := make([]*api.UserHit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, &api.UserHit{
+			User: &api.User{
+				Uid:            row.Uid.String(),
+				Username:       row.Username,
+				Role:           string(row.Role),
+				Nickname:       row.Nickname,
+				AvatarUrl:      row.AvatarUrl,
+				FollowersCount: row.FollowersCount,
+				FollowingCount: row.FollowingCount,
+				IsFollowing:    row.IsFollowing,
+			},
+			Snippet: util.Highlight(row.Nickname, terms, snippetWidth),
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.SearchUsersResponse{
+		Hits:                hits,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
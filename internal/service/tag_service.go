@@ -0,0 +1,358 @@
+package service
+
+import (
+	"aeibi/api"
+	"aeibi/internal/repository/db"
+	"aeibi/util"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultTrendingWindow = 24 * time.Hour
+	maxTrendingWindow     = 30 * 24 * time.Hour
+)
+
+// TagService serves tag pages, composer autocomplete and trending tags. Renamed and
+// merged tags leave their old names behind as aliases so existing links and new posts
+// using the old name keep resolving to the surviving tag.
+type TagService struct {
+	db    *db.Queries
+	dbx   *sql.DB
+	admin *AdminService
+}
+
+func NewTagService(dbx *sql.DB, admin *AdminService) *TagService {
+	return &TagService{
+		db:    db.New(dbx),
+		dbx:   dbx,
+		admin: admin,
+	}
+}
+
+func (s *TagService) GetTag(ctx context.Context, viewerUid string, req *api.GetTagRequest) (*api.GetTagResponse, error) {
+	row, err := s.db.GetTagByName(ctx, strings.TrimSpace(req.Name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("tag not found")
+		}
+		return nil, fmt.Errorf("get tag: %w", err)
+	}
+	var isFollowing bool
+	if viewerUid != "" {
+		isFollowing, err = s.db.IsFollowingTag(ctx, db.IsFollowingTagParams{
+			UserUid: util.UUID(viewerUid),
+			TagID:   row.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get tag follow: %w", err)
+		}
+	}
+	return &api.GetTagResponse{
+		Tag: &api.Tag{
+			Uid:           row.Uid.String(),
+			Name:          row.Name,
+			PostCount:     row.PostCount,
+			FollowerCount: row.FollowerCount,
+			IsFollowing:   isFollowing,
+		},
+	}, nil
+}
+
+func (s *TagService) ListPostsByTag(ctx context.Context, viewerUid string, req *api.ListPostsByTagRequest) (*api.ListPostsResponse, error) {
+	tag, err := s.db.GetTagByName(ctx, strings.TrimSpace(req.Name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &api.ListPostsResponse{}, nil
+		}
+		return nil, fmt.Errorf("get tag: %w", err)
+	}
+	rows, err := s.db.ListPostsByTag(ctx, db.ListPostsByTagParams{
+		Viewer:          uuid.NullUUID{UUID: util.UUID(viewerUid), Valid: viewerUid != ""},
+		TagID:           tag.ID,
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list posts by tag: %w", err)
+	}
+	posts := make([]*api.Post, 0, len(rows))
+	for _, row := range rows {
+		fileRow, err := s.db.GetFilesByUrls(ctx, row.Attachments)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		attachments := make([]*api.Attachment, 0, len(row.Attachments))
+		for _, file := range fileRow {
+			attachments = append(attachments, &api.Attachment{
+				Url:         file.Url,
+				Name:        file.Name,
+				ContentType: file.ContentType,
+				Size:        file.Size,
+				Checksum:    file.Checksum,
+			})
+		}
+
+		posts = append(posts, &api.Post{
+			Uid: row.Uid.String(),
+			Author: &api.PostAuthor{
+				Uid:         row.AuthorUid.String(),
+				Nickname:    row.AuthorNickname,
+				AvatarUrl:   row.AuthorAvatarUrl,
+				IsFollowing: false, // TODO: compute with viewer context
+			},
+			Text:            row.Text,
+			Entities:        textEntities(row.Entities),
+			Images:          row.Images,
+			Attachments:     attachments,
+			Tags:            row.TagNames,
+			CommentCount:    row.CommentCount,
+			CollectionCount: row.CollectionCount,
+			LikeCount:       row.LikeCount,
+			Visibility:      string(row.Visibility),
+			LatestRepliedOn: row.LatestRepliedOn.Unix(),
+			Ip:              row.Ip,
+			Pinned:          row.Pinned,
+			Liked:           row.Liked,
+			Collected:       row.Collected,
+			CreatedAt:       row.CreatedAt.Unix(),
+			UpdatedAt:       row.UpdatedAt.Unix(),
+			RepostCount:     row.RepostCount,
+			Edited:          row.EditCount > 0,
+			EditCount:       row.EditCount,
+			Reposted:        row.Reposted,
+			RepostOfUid:     row.RepostOfUid,
+			QuotedPostUid:   row.QuotedPostUid,
+		})
+	}
+
+	if err := embedPosts(ctx, s.db, viewerUid, posts...); err != nil {
+		return nil, err
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.CreatedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListPostsResponse{
+		Posts:               posts,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+func (s *TagService) AutocompleteTags(ctx context.Context, req *api.AutocompleteTagsRequest) (*api.AutocompleteTagsResponse, error) {
+	rows, err := s.db.AutocompleteTags(ctx, util.EscapeLike(strings.TrimSpace(req.Prefix)))
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete tags: %w", err)
+	}
+	tags := make([]*api.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, &api.Tag{
+			Uid:       row.Uid.String(),
+			Name:      row.Name,
+			PostCount: row.PostCount,
+		})
+	}
+	return &api.AutocompleteTagsResponse{Tags: tags}, nil
+}
+
+func (s *TagService) ListTrendingTags(ctx context.Context, req *api.ListTrendingTagsRequest) (*api.ListTrendingTagsResponse, error) {
+	window := time.Duration(req.WindowHours) * time.Hour
+	if window <= 0 {
+		window = defaultTrendingWindow
+	}
+	window = min(window, maxTrendingWindow)
+
+	rows, err := s.db.ListTrendingTags(ctx, time.Now().Add(-window).UTC())
+	if err != nil {
+		return nil, fmt.Errorf("list trending tags: %w", err)
+	}
+	tags := make([]*api.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, &api.Tag{
+			Uid:       row.Uid.String(),
+			Name:      row.Name,
+			PostCount: row.PostCount,
+		})
+	}
+	return &api.ListTrendingTagsResponse{Tags: tags}, nil
+}
+
+// FollowTag adds or removes a tag from the caller's followed tags; posts carrying a
+// followed tag are merged into the home timeline at read time.
+func (s *TagService) FollowTag(ctx context.Context, uid string, req *api.FollowTagRequest) (*api.FollowTagResponse, error) {
+	var followerCount int32
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		tag, err := qtx.GetTagByName(ctx, strings.TrimSpace(req.Name))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("tag not found")
+			}
+			return fmt.Errorf("get tag: %w", err)
+		}
+		params := db.AddTagFollowParams{
+			UserUid: util.UUID(uid),
+			TagID:   tag.ID,
+		}
+		followerCount = tag.FollowerCount
+		switch req.Action {
+		case api.ToggleAction_TOGGLE_ACTION_ADD:
+			affected, err := qtx.AddTagFollow(ctx, params)
+			if err != nil {
+				return fmt.Errorf("follow tag: %w", err)
+			}
+			followerCount += int32(affected)
+		default:
+			affected, err := qtx.RemoveTagFollow(ctx, db.RemoveTagFollowParams(params))
+			if err != nil {
+				return fmt.Errorf("unfollow tag: %w", err)
+			}
+			followerCount -= int32(affected)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &api.FollowTagResponse{FollowerCount: followerCount}, nil
+}
+
+func (s *TagService) ListMyFollowedTags(ctx context.Context, uid string, req *api.ListMyFollowedTagsRequest) (*api.ListMyFollowedTagsResponse, error) {
+	rows, err := s.db.ListFollowedTags(ctx, db.ListFollowedTagsParams{
+		Uid:             util.UUID(uid),
+		CursorCreatedAt: sql.NullTime{Time: time.Unix(req.CursorCreatedAt, 0).UTC(), Valid: req.CursorCreatedAt != 0},
+		CursorID:        uuid.NullUUID{UUID: util.UUID(req.CursorId), Valid: req.CursorId != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list followed tags: %w", err)
+	}
+	tags := make([]*api.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, &api.Tag{
+			Uid:           row.Uid.String(),
+			Name:          row.Name,
+			FollowerCount: row.FollowerCount,
+			IsFollowing:   true,
+		})
+	}
+
+	var nextCursorCreatedAt int64
+	var nextCursorID string
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		nextCursorCreatedAt = last.FollowedAt.Unix()
+		nextCursorID = last.Uid.String()
+	}
+
+	return &api.ListMyFollowedTagsResponse{
+		Tags:                tags,
+		NextCursorCreatedAt: nextCursorCreatedAt,
+		NextCursorId:        nextCursorID,
+	}, nil
+}
+
+// RenameTag changes a tag's name in place; post_tags rows keep pointing at the same
+// tag id. Renaming onto the name of another existing tag is refused, use MergeTags.
+func (s *TagService) RenameTag(ctx context.Context, uid string, req *api.RenameTagRequest) error {
+	newName := strings.TrimSpace(req.NewName)
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		tag, err := qtx.GetTagByName(ctx, strings.TrimSpace(req.Name))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("tag not found")
+			}
+			return fmt.Errorf("get tag: %w", err)
+		}
+		if tag.Name == newName {
+			return fmt.Errorf("tag is already named %s", newName)
+		}
+		existing, err := qtx.GetTagByName(ctx, newName)
+		if err == nil && existing.ID != tag.ID {
+			return fmt.Errorf("tag %s already exists, merge instead", newName)
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("get tag: %w", err)
+		}
+
+		// The new name may be one of this tag's own aliases; it stops being one.
+		if err := qtx.DeleteTagAlias(ctx, newName); err != nil {
+			return fmt.Errorf("delete tag alias: %w", err)
+		}
+		if _, err := qtx.RenameTag(ctx, db.RenameTagParams{
+			Name: newName,
+			ID:   tag.ID,
+		}); err != nil {
+			return fmt.Errorf("rename tag: %w", err)
+		}
+		if err := qtx.UpsertTagAlias(ctx, db.UpsertTagAliasParams{
+			Name:  tag.Name,
+			TagID: tag.ID,
+		}); err != nil {
+			return fmt.Errorf("create tag alias: %w", err)
+		}
+		return s.admin.audit(ctx, qtx, uid, db.AdminActionRENAMETAG, db.AdminTargetTypeTAG, tag.Uid.String(), req.Reason, fmt.Sprintf("%s -> %s", tag.Name, newName))
+	})
+}
+
+// MergeTags moves every post and follower from source onto target, then deletes source and keeps
+// its name (and any of its aliases) as aliases of target.
+func (s *TagService) MergeTags(ctx context.Context, uid string, req *api.MergeTagsRequest) error {
+	return db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		source, err := qtx.GetTagByName(ctx, strings.TrimSpace(req.Source))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("source tag not found")
+			}
+			return fmt.Errorf("get source tag: %w", err)
+		}
+		target, err := qtx.GetTagByName(ctx, strings.TrimSpace(req.Target))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("target tag not found")
+			}
+			return fmt.Errorf("get target tag: %w", err)
+		}
+		if source.ID == target.ID {
+			return fmt.Errorf("cannot merge a tag into itself")
+		}
+
+		if err := qtx.MovePostTags(ctx, db.MovePostTagsParams{
+			TargetID: target.ID,
+			SourceID: source.ID,
+		}); err != nil {
+			return fmt.Errorf("move post tags: %w", err)
+		}
+		if err := qtx.MoveTagAliases(ctx, db.MoveTagAliasesParams{
+			TargetID: target.ID,
+			SourceID: source.ID,
+		}); err != nil {
+			return fmt.Errorf("move tag aliases: %w", err)
+		}
+		if err := qtx.MoveTagFollows(ctx, db.MoveTagFollowsParams{
+			TargetID: target.ID,
+			SourceID: source.ID,
+		}); err != nil {
+			return fmt.Errorf("move tag follows: %w", err)
+		}
+		if err := qtx.DeleteTag(ctx, source.ID); err != nil {
+			return fmt.Errorf("delete tag: %w", err)
+		}
+		if err := qtx.UpsertTagAlias(ctx, db.UpsertTagAliasParams{
+			Name:  source.Name,
+			TagID: target.ID,
+		}); err != nil {
+			return fmt.Errorf("create tag alias: %w", err)
+		}
+		return s.admin.audit(ctx, qtx, uid, db.AdminActionMERGETAG, db.AdminTargetTypeTAG, target.Uid.String(), req.Reason, fmt.Sprintf("%s -> %s", source.Name, target.Name))
+	})
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"aeibi/internal/config"
+	"aeibi/internal/repository/db"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultFanoutThreshold      = 10000
+	defaultTimelineEntries      = 800
+	defaultTimelineWorkers      = 4
+	defaultTimelinePollInterval = time.Second
+)
+
+// TimelineService materializes home timelines into timeline_entries.
+// Posts from authors below the fan-out threshold are pushed to followers by
+// background workers draining timeline_fanout_outbox; heavier authors are merged in
+// at read time.
+type TimelineService struct {
+	db              *db.Queries
+	dbx             *sql.DB
+	fanoutThreshold int32
+	maxEntries      int32
+	workers         int
+	pollInterval    time.Duration
+	wake            chan struct{}
+}
+
+func NewTimelineService(dbx *sql.DB, cfg config.TimelineConfig) *TimelineService {
+	s := &TimelineService{
+		db:              db.New(dbx),
+		dbx:             dbx,
+		fanoutThreshold: int32(cfg.FanoutThreshold),
+		maxEntries:      int32(cfg.MaxEntries),
+		workers:         cfg.Workers,
+		pollInterval:    cfg.PollInterval,
+		wake:            make(chan struct{}, 1),
+	}
+	if s.fanoutThreshold <= 0 {
+		s.fanoutThreshold = defaultFanoutThreshold
+	}
+	if s.maxEntries <= 0 {
+		s.maxEntries = defaultTimelineEntries
+	}
+	if s.workers <= 0 {
+		s.workers = defaultTimelineWorkers
+	}
+	if s.pollInterval <= 0 {
+		s.pollInterval = defaultTimelinePollInterval
+	}
+	return s
+}
+
+// FanoutThreshold is the follower count at which an author switches to fan-out-on-read.
+func (s *TimelineService) FanoutThreshold() int32 {
+	return s.fanoutThreshold
+}
+
+// Run starts the fan-out workers and blocks until ctx is done. Workers drain the outbox
+// whenever they are woken and every poll interval, so posts enqueued by other instances
+// or left over from a previous run are delivered too.
+func (s *TimelineService) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ticker := time.NewTicker(s.pollInterval)
+			defer ticker.Stop()
+			for {
+				s.drain(ctx)
+				select {
+				case <-ctx.Done():
+					return
+				case <-s.wake:
+				case <-ticker.C:
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// EnqueueFanout records in the caller's transaction that a newly published post must be
+// delivered to the author's followers, so the fan-out survives restarts. Call Wake once
+// the transaction has committed.
+func (s *TimelineService) EnqueueFanout(ctx context.Context, qtx *db.Queries, postUid uuid.UUID) error {
+	if err := qtx.EnqueueTimelineFanout(ctx, postUid); err != nil {
+		return fmt.Errorf("enqueue timeline fanout: %w", err)
+	}
+	return nil
+}
+
+// Wake prompts an idle worker to drain the outbox instead of waiting for the next poll.
+func (s *TimelineService) Wake() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// drain fans out queued posts until the outbox is empty or a fan-out fails.
+func (s *TimelineService) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		postUid, err := s.fanoutNext(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			slog.Warn("timeline fanout", "post", postUid, "error", err)
+			if postUid != uuid.Nil {
+				// Move the post to the back of the outbox so it does not hold up the rest.
+				if err := s.db.RequeueTimelineFanout(ctx, postUid); err != nil {
+					slog.Warn("requeue timeline fanout", "post", postUid, "error", err)
+				}
+			}
+			return
+		}
+		if postUid == uuid.Nil {
+			return
+		}
+	}
+}
+
+// fanoutNext claims the oldest queued post, copies it into its author's followers'
+// timelines, trims each of them back to maxEntries and removes it from the outbox, all
+// in one transaction. It returns uuid.Nil when the outbox is empty.
+func (s *TimelineService) fanoutNext(ctx context.Context) (uuid.UUID, error) {
+	var postUid uuid.UUID
+	err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		var err error
+		postUid, err = qtx.ClaimTimelineFanout(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("claim timeline fanout: %w", err)
+		}
+		if _, err := qtx.FanoutPostToFollowers(ctx, db.FanoutPostToFollowersParams{
+			PostUid:         postUid,
+			FanoutThreshold: s.fanoutThreshold,
+		}); err != nil {
+			return fmt.Errorf("fanout post: %w", err)
+		}
+		if _, err := qtx.TrimFollowerTimelines(ctx, db.TrimFollowerTimelinesParams{
+			PostUid:    postUid,
+			MaxEntries: s.maxEntries,
+		}); err != nil {
+			return fmt.Errorf("trim timelines: %w", err)
+		}
+		if err := qtx.DeleteTimelineFanout(ctx, postUid); err != nil {
+			return fmt.Errorf("delete timeline fanout: %w", err)
+		}
+		return nil
+	})
+	return postUid, err
+}
+
+// Backfill copies the followee's recent posts into the follower's timeline.
+func (s *TimelineService) Backfill(ctx context.Context, qtx *db.Queries, followerUid, followeeUid uuid.UUID) error {
+	if _, err := qtx.BackfillTimelineFromAuthor(ctx, db.BackfillTimelineFromAuthorParams{
+		OwnerUid:        followerUid,
+		AuthorUid:       followeeUid,
+		FanoutThreshold: s.fanoutThreshold,
+		MaxEntries:      s.maxEntries,
+	}); err != nil {
+		return fmt.Errorf("backfill timeline: %w", err)
+	}
+	return nil
+}
+
+// Remove drops the followee's posts from the follower's timeline.
+func (s *TimelineService) Remove(ctx context.Context, qtx *db.Queries, followerUid, followeeUid uuid.UUID) error {
+	if _, err := qtx.DeleteTimelineEntriesByAuthor(ctx, db.DeleteTimelineEntriesByAuthorParams{
+		OwnerUid:  followerUid,
+		AuthorUid: followeeUid,
+	}); err != nil {
+		return fmt.Errorf("remove timeline entries: %w", err)
+	}
+	return nil
+}
+
+// Rebuild recomputes every materialized timeline from posts and user_follows.
+func (s *TimelineService) Rebuild(ctx context.Context) (int64, error) {
+	var inserted int64
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		if err := qtx.TruncateTimelineEntries(ctx); err != nil {
+			return fmt.Errorf("truncate timeline entries: %w", err)
+		}
+		n, err := qtx.RebuildTimelineEntries(ctx, db.RebuildTimelineEntriesParams{
+			FanoutThreshold: s.fanoutThreshold,
+			MaxEntries:      s.maxEntries,
+		})
+		if err != nil {
+			return fmt.Errorf("rebuild timeline entries: %w", err)
+		}
+		inserted = n
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return inserted, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"aeibi/api"
+	"aeibi/internal/auth"
 	"aeibi/internal/config"
 	"aeibi/internal/repository/db"
 	"aeibi/internal/repository/oss"
@@ -10,6 +11,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,18 +20,22 @@ import (
 )
 
 type UserService struct {
-	db  *db.Queries
-	dbx *sql.DB
-	oss *oss.OSS
-	cfg *config.Config
+	db       *db.Queries
+	dbx      *sql.DB
+	oss      *oss.OSS
+	cfg      *config.Config
+	keys     *util.JWTKeySet
+	denylist *auth.Denylist
 }
 
-func NewUserService(dbx *sql.DB, ossClient *oss.OSS, cfg *config.Config) *UserService {
+func NewUserService(dbx *sql.DB, ossClient *oss.OSS, cfg *config.Config, keys *util.JWTKeySet, denylist *auth.Denylist) *UserService {
 	return &UserService{
-		db:  db.New(dbx),
-		dbx: dbx,
-		oss: ossClient,
-		cfg: cfg,
+		db:       db.New(dbx),
+		dbx:      dbx,
+		oss:      ossClient,
+		cfg:      cfg,
+		keys:     keys,
+		denylist: denylist,
 	}
 }
 
@@ -93,10 +100,39 @@ func (s *UserService) GetUser(ctx context.Context, viewerUid string, req *api.Ge
 			FollowersCount: row.FollowersCount,
 			FollowingCount: row.FollowingCount,
 			IsFollowing:    isFollowing,
+			IsPrivate:      row.IsPrivate,
 		},
 	}, nil
 }
 
+// AutocompleteUsers suggests users whose username or nickname starts with the prefix,
+// listing the people the viewer follows first. Usernames are included so the client can
+// insert the @mention.
+func (s *UserService) AutocompleteUsers(ctx context.Context, viewerUid string, req *api.AutocompleteUsersRequest) (*api.AutocompleteUsersResponse, error) {
+	rows, err := s.db.AutocompleteUsers(ctx, db.AutocompleteUsersParams{
+		Viewer: util.UUID(viewerUid),
+		Prefix: util.EscapeLike(strings.TrimLeft(strings.TrimSpace(req.Prefix), "@")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete users: %w", err)
+	}
+
+	users := make([]*api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &api.User{
+			Uid:            row.Uid.String(),
+			Username:       row.Username,
+			Role:           string(row.Role),
+			Nickname:       row.Nickname,
+			AvatarUrl:      row.AvatarUrl,
+			FollowersCount: row.FollowersCount,
+			FollowingCount: row.FollowingCount,
+			IsFollowing:    row.IsFollowing,
+		})
+	}
+	return &api.AutocompleteUsersResponse{Users: users}, nil
+}
+
 func (s *UserService) GetMe(ctx context.Context, uid string) (*api.GetMeResponse, error) {
 	row, err := s.db.GetUserByUid(ctx, util.UUID(uid))
 	if err != nil {
@@ -117,6 +153,7 @@ func (s *UserService) GetMe(ctx context.Context, uid string) (*api.GetMeResponse
 			FollowersCount: row.FollowersCount,
 			FollowingCount: row.FollowingCount,
 			IsFollowing:    false,
+			IsPrivate:      row.IsPrivate,
 		},
 	}, nil
 }
@@ -139,6 +176,9 @@ func (s *UserService) UpdateMe(ctx context.Context, uid string, req *api.UpdateM
 	if _, ok := paths["avatar_url"]; ok {
 		params.AvatarUrl = sql.NullString{String: req.User.AvatarUrl, Valid: true}
 	}
+	if _, ok := paths["is_private"]; ok {
+		params.IsPrivate = sql.NullBool{Bool: req.User.IsPrivate, Valid: true}
+	}
 	err := s.db.UpdateUser(ctx, params)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -149,8 +189,9 @@ func (s *UserService) UpdateMe(ctx context.Context, uid string, req *api.UpdateM
 	return nil
 }
 
-func (s *UserService) Login(ctx context.Context, req *api.LoginRequest) (*api.LoginResponse, error) {
+func (s *UserService) Login(ctx context.Context, req *api.LoginRequest, userAgent, ip string) (*api.LoginResponse, error) {
 	var resp *api.LoginResponse
+	var replaced []uuid.UUID
 	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
 		row, err := qtx.GetUserByUsername(ctx, req.Account)
 		if err != nil {
@@ -162,17 +203,30 @@ func (s *UserService) Login(ctx context.Context, req *api.LoginRequest) (*api.Lo
 		if err := bcrypt.CompareHashAndPassword([]byte(row.PasswordHash), []byte(req.Password)); err != nil {
 			return fmt.Errorf("invalid credentials")
 		}
-		accessToken, refreshToken, err := s.genToken(row.Uid.String())
+		sessionUid := uuid.New()
+		accessToken, refreshToken, err := s.genToken(row.Uid.String(), string(row.Role), sessionUid.String())
 		if err != nil {
 			return err
 		}
 
-		if err := qtx.UpsertRefreshToken(ctx, db.UpsertRefreshTokenParams{
-			Uid:       row.Uid,
-			Token:     refreshToken,
-			ExpiresAt: time.Now().Add(s.cfg.Auth.RefreshTTL),
+		// Logging in again from a known device replaces that device's session.
+		replaced, err = qtx.DeleteDeviceSession(ctx, db.DeleteDeviceSessionParams{
+			Uid:      row.Uid,
+			DeviceID: req.DeviceId,
+		})
+		if err != nil {
+			return fmt.Errorf("delete device session: %w", err)
+		}
+		if err := qtx.CreateSession(ctx, db.CreateSessionParams{
+			SessionUid: sessionUid,
+			Uid:        row.Uid,
+			TokenHash:  util.SHA256([]byte(refreshToken)),
+			ExpiresAt:  time.Now().Add(s.cfg.Auth.RefreshTTL),
+			DeviceID:   req.DeviceId,
+			UserAgent:  userAgent,
+			Ip:         ip,
 		}); err != nil {
-			return fmt.Errorf("save refresh token: %w", err)
+			return fmt.Errorf("create session: %w", err)
 		}
 
 		resp = &api.LoginResponse{
@@ -185,32 +239,111 @@ func (s *UserService) Login(ctx context.Context, req *api.LoginRequest) (*api.Lo
 	}); err != nil {
 		return nil, err
 	}
+	if err := s.denySessions(ctx, replaced...); err != nil {
+		return nil, err
+	}
 
 	return resp, nil
 }
 
-func (s *UserService) RefreshToken(ctx context.Context, req *api.RefreshTokenRequest) (*api.RefreshTokenResponse, error) {
+// RefreshToken rotates the session's refresh token. Only the hash of the current token
+// is stored; rotated hashes are kept with the session, which forms the rotation family.
+// Presenting a rotated token means it was stolen or replayed, so the whole family is
+// revoked and the reuse is reported. The exception is a token rotated within the reuse
+// grace window, which clients racing each other or retrying a lost response present
+// legitimately: it gets a fresh access token and the session's current refresh token.
+func (s *UserService) RefreshToken(ctx context.Context, req *api.RefreshTokenRequest, userAgent, ip string) (*api.RefreshTokenResponse, error) {
 	var resp *api.RefreshTokenResponse
+	var reused uuid.NullUUID
+	tokenHash := util.SHA256([]byte(req.RefreshToken))
 	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
-		row, err := qtx.GetRefreshToken(ctx, req.RefreshToken)
+		row, err := qtx.GetRefreshToken(ctx, tokenHash)
+		if errors.Is(err, sql.ErrNoRows) {
+			rotated, err := qtx.GetRotatedRefreshToken(ctx, tokenHash)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("invalid refresh token")
+				}
+				return fmt.Errorf("get rotated refresh token: %w", err)
+			}
+			successor, err := graceSuccessor(req.RefreshToken, rotated.Successor, rotated.RotatedAt, time.Now(), s.reuseGrace(), rotated.CurrentTokenHash,
+				func(hash string) ([]byte, error) {
+					next, err := qtx.GetRotatedRefreshToken(ctx, hash)
+					if errors.Is(err, sql.ErrNoRows) {
+						return nil, nil
+					}
+					if err != nil {
+						return nil, fmt.Errorf("get rotated refresh token: %w", err)
+					}
+					if next.SessionUid != rotated.SessionUid {
+						return nil, nil
+					}
+					return next.Successor, nil
+				})
+			if err != nil {
+				return err
+			}
+			if successor != "" {
+				user, err := qtx.GetUserByUid(ctx, rotated.Uid)
+				if err != nil {
+					if errors.Is(err, sql.ErrNoRows) {
+						return fmt.Errorf("invalid refresh token")
+					}
+					return fmt.Errorf("get user: %w", err)
+				}
+				accessToken, err := s.genAccessToken(rotated.Uid.String(), string(user.Role), rotated.SessionUid.String())
+				if err != nil {
+					return err
+				}
+				resp = &api.RefreshTokenResponse{
+					Tokens: &api.TokenPair{
+						AccessToken:  accessToken,
+						RefreshToken: successor,
+					},
+				}
+				return nil
+			}
+			if _, err := qtx.DeleteSession(ctx, db.DeleteSessionParams{
+				Uid:        rotated.Uid,
+				SessionUid: rotated.SessionUid,
+			}); err != nil {
+				return fmt.Errorf("delete session: %w", err)
+			}
+			slog.Warn("refresh token reuse detected, session revoked",
+				"uid", rotated.Uid, "session", rotated.SessionUid, "device_id", rotated.DeviceID,
+				"session_ip", rotated.Ip, "ip", ip, "user_agent", userAgent)
+			reused = uuid.NullUUID{UUID: rotated.SessionUid, Valid: true}
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get refresh token: %w", err)
+		}
+
+		user, err := qtx.GetUserByUid(ctx, row.Uid)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return fmt.Errorf("invalid refresh token")
 			}
-			return fmt.Errorf("get refresh token: %w", err)
+			return fmt.Errorf("get user: %w", err)
 		}
 
 		now := time.Now()
-		uid := row.Uid
-		accessToken, refreshToken, err := s.genToken(uid.String())
+		accessToken, refreshToken, err := s.genToken(row.Uid.String(), string(user.Role), row.SessionUid.String())
 		if err != nil {
 			return err
 		}
 
-		if err := qtx.UpsertRefreshToken(ctx, db.UpsertRefreshTokenParams{
-			Uid:       uid,
-			Token:     refreshToken,
-			ExpiresAt: now.Add(s.cfg.Auth.RefreshTTL),
+		successor, err := sealSuccessor(req.RefreshToken, refreshToken)
+		if err != nil {
+			return err
+		}
+		if err := qtx.RotateSessionToken(ctx, db.RotateSessionTokenParams{
+			SessionUid: row.SessionUid,
+			TokenHash:  util.SHA256([]byte(refreshToken)),
+			ExpiresAt:  now.Add(s.cfg.Auth.RefreshTTL),
+			UserAgent:  userAgent,
+			Ip:         ip,
+			Successor:  successor,
 		}); err != nil {
 			return fmt.Errorf("save refresh token: %w", err)
 		}
@@ -225,14 +358,140 @@ func (s *UserService) RefreshToken(ctx context.Context, req *api.RefreshTokenReq
 	}); err != nil {
 		return nil, err
 	}
+	if reused.Valid {
+		if err := s.denySessions(ctx, reused.UUID); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("refresh token reused, session revoked")
+	}
 
 	return resp, nil
 }
 
-func (s *UserService) genToken(uid string) (string, string, error) {
-	accessToken, err := util.GenerateJWT(uid, s.cfg.Auth.JWTSecret, s.cfg.Auth.JWTIssuer, s.cfg.Auth.JWTTTL)
+// Logout ends the session holding refreshToken and revokes its access tokens, along with
+// the caller's access token identified by tokenID when there is one. Unknown refresh
+// tokens are ignored so that logging out twice succeeds.
+func (s *UserService) Logout(ctx context.Context, refreshToken, tokenID string, tokenExpiresAt time.Time) error {
+	sessions, err := s.db.DeleteRefreshToken(ctx, util.SHA256([]byte(refreshToken)))
 	if err != nil {
-		return "", "", fmt.Errorf("generate access token: %w", err)
+		return fmt.Errorf("delete refresh token: %w", err)
+	}
+	if err := s.denySessions(ctx, sessions...); err != nil {
+		return err
+	}
+	if tokenID != "" {
+		if err := s.denylist.DenyToken(ctx, tokenID, tokenExpiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *UserService) ListMySessions(ctx context.Context, uid, currentSession string) (*api.ListMySessionsResponse, error) {
+	rows, err := s.db.ListSessionsByUser(ctx, util.UUID(uid))
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	sessions := make([]*api.Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, &api.Session{
+			Uid:        row.SessionUid.String(),
+			DeviceId:   row.DeviceID,
+			UserAgent:  row.UserAgent,
+			Ip:         row.Ip,
+			CreatedAt:  row.CreatedAt.Unix(),
+			LastUsedAt: row.LastUsedAt.Unix(),
+			Current:    row.SessionUid.String() == currentSession,
+		})
+	}
+	return &api.ListMySessionsResponse{Sessions: sessions}, nil
+}
+
+// RevokeSession deletes one of uid's sessions and revokes the access tokens issued for it.
+func (s *UserService) RevokeSession(ctx context.Context, uid, sessionUid string) error {
+	n, err := s.db.DeleteSession(ctx, db.DeleteSessionParams{
+		Uid:        util.UUID(uid),
+		SessionUid: util.UUID(sessionUid),
+	})
+	if err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("session not found")
+	}
+	return s.denySessions(ctx, util.UUID(sessionUid))
+}
+
+func (s *UserService) RevokeAllOtherSessions(ctx context.Context, uid, currentSession string) error {
+	sessions, err := s.db.DeleteOtherSessions(ctx, db.DeleteOtherSessionsParams{
+		Uid:        util.UUID(uid),
+		SessionUid: util.UUID(currentSession),
+	})
+	if err != nil {
+		return fmt.Errorf("delete sessions: %w", err)
+	}
+	return s.denySessions(ctx, sessions...)
+}
+
+// ChangePassword replaces uid's password after checking the old one and signs out every
+// other session, keeping currentSession when the caller's token has one.
+func (s *UserService) ChangePassword(ctx context.Context, uid, currentSession string, req *api.ChangePasswordRequest) error {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+	var sessions []uuid.UUID
+	if err := db.WithTx(ctx, s.dbx, s.db, func(qtx *db.Queries) error {
+		oldHash, err := qtx.GetUserPasswordHash(ctx, util.UUID(uid))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("user not found")
+			}
+			return fmt.Errorf("get user: %w", err)
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(oldHash), []byte(req.OldPassword)); err != nil {
+			return fmt.Errorf("invalid credentials")
+		}
+		if err := qtx.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+			Uid:          util.UUID(uid),
+			PasswordHash: string(passwordHash),
+		}); err != nil {
+			return fmt.Errorf("update password: %w", err)
+		}
+		sessions, err = qtx.DeleteOtherSessions(ctx, db.DeleteOtherSessionsParams{
+			Uid:        util.UUID(uid),
+			SessionUid: util.UUID(currentSession),
+		})
+		if err != nil {
+			return fmt.Errorf("delete sessions: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if currentSession == "" {
+		// Without a session to keep, revoke everything issued so far, the caller included.
+		return s.denylist.DenyUser(ctx, uid)
+	}
+	return s.denySessions(ctx, sessions...)
+}
+
+// denySessions revokes the access tokens of sessions that were deleted.
+func (s *UserService) denySessions(ctx context.Context, sessions ...uuid.UUID) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		ids = append(ids, session.String())
+	}
+	return s.denylist.DenySessions(ctx, ids...)
+}
+
+func (s *UserService) genToken(uid, role, sessionUid string) (string, string, error) {
+	accessToken, err := s.genAccessToken(uid, role, sessionUid)
+	if err != nil {
+		return "", "", err
 	}
 	refreshToken, err := util.RandomString64()
 	if err != nil {
@@ -240,3 +499,18 @@ func (s *UserService) genToken(uid string) (string, string, error) {
 	}
 	return accessToken, refreshToken, nil
 }
+
+func (s *UserService) genAccessToken(uid, role, sessionUid string) (string, error) {
+	accessToken, err := util.GenerateJWT(uid, role, sessionUid, s.cfg.Auth.JWTIssuer, s.cfg.Auth.JWTTTL, s.keys)
+	if err != nil {
+		return "", fmt.Errorf("generate access token: %w", err)
+	}
+	return accessToken, nil
+}
+
+func (s *UserService) reuseGrace() time.Duration {
+	if s.cfg.Auth.RefreshReuseGrace > 0 {
+		return s.cfg.Auth.RefreshReuseGrace
+	}
+	return defaultRefreshReuseGrace
+}
@@ -0,0 +1,166 @@
+package util
+
+import (
+	"encoding/json"
+	"sort"
+	"unicode"
+)
+
+// Entity types carried in Entity.Type.
+const (
+	EntityMention = "MENTION"
+	EntityHashtag = "HASHTAG"
+)
+
+// Entity is a span of post or comment text that clients render as a link. Start and End
+// are offsets in Unicode code points with End exclusive, and cover the sigils; Text is
+// the span without them. Uid identifies the linked user for mentions.
+type Entity struct {
+	Type  string `json:"type"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Text  string `json:"text"`
+	Uid   string `json:"uid,omitempty"`
+}
+
+// ExtractMentions finds @username tokens in text. A username is a run of letters, digits
+// and underscores. CJK text has no spaces, so the @ may directly follow a CJK character
+// and the username ends where its letters switch between CJK and other scripts: both
+// 你好@alice你好 and 你好@张三abc yield a mention. Otherwise the @ must start the text or
+// follow a character that cannot be part of a username, so e-mail addresses are not
+// mistaken for mentions.
+func ExtractMentions(text string) []Entity {
+	runes := []rune(text)
+	entities := make([]Entity, 0)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' || (i > 0 && isUsernameRune(runes[i-1]) && !isCJKRune(runes[i-1])) {
+			continue
+		}
+		end := i + 1
+		cjk, seenLetter := false, false
+		for end < len(runes) && isUsernameRune(runes[end]) {
+			if unicode.IsLetter(runes[end]) {
+				if seenLetter && isCJKRune(runes[end]) != cjk {
+					break
+				}
+				cjk, seenLetter = isCJKRune(runes[end]), true
+			}
+			end++
+		}
+		if end == i+1 {
+			continue
+		}
+		entities = append(entities, Entity{
+			Type:  EntityMention,
+			Start: i,
+			End:   end,
+			Text:  string(runes[i+1 : end]),
+		})
+		i = end - 1
+	}
+	return entities
+}
+
+func isUsernameRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// ExtractHashtags finds #tag tokens in text, accepting the full-width ＃ as well. A tag is
+// a run of letters, digits, marks and underscores with at least one non-digit. CJK text
+// has no spaces, so a sigil may directly follow a CJK character, and a tag may be closed
+// with a second sigil (#话题#) to end it before the following text.
+func ExtractHashtags(text string) []Entity {
+	runes := []rune(text)
+	entities := make([]Entity, 0)
+	for i := 0; i < len(runes); i++ {
+		if !isHashtagSigil(runes[i]) || (i > 0 && isHashtagRune(runes[i-1]) && !isCJKRune(runes[i-1])) {
+			continue
+		}
+		end := i + 1
+		hasLetter := false
+		for end < len(runes) && isHashtagRune(runes[end]) {
+			if !unicode.IsDigit(runes[end]) {
+				hasLetter = true
+			}
+			end++
+		}
+		if !hasLetter {
+			continue
+		}
+		tag := string(runes[i+1 : end])
+		if end < len(runes) && isHashtagSigil(runes[end]) {
+			end++
+		}
+		entities = append(entities, Entity{
+			Type:  EntityHashtag,
+			Start: i,
+			End:   end,
+			Text:  tag,
+		})
+		i = end - 1
+	}
+	return entities
+}
+
+func isHashtagSigil(r rune) bool {
+	return r == '#' || r == '＃'
+}
+
+func isHashtagRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+func isCJKRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// HashtagNames returns the tag names of the hashtag entities, in order of appearance.
+func HashtagNames(entities []Entity) []string {
+	names := make([]string, 0, len(entities))
+	for _, entity := range entities {
+		if entity.Type == EntityHashtag {
+			names = append(names, entity.Text)
+		}
+	}
+	return names
+}
+
+// MergeEntities combines entity lists into one ordered by Start.
+func MergeEntities(lists ...[]Entity) []Entity {
+	merged := make([]Entity, 0)
+	for _, list := range lists {
+		merged = append(merged, list...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Start < merged[j].Start
+	})
+	return merged
+}
+
+// DecodeEntities parses entities stored as JSON text.
+func DecodeEntities(raw string) ([]Entity, error) {
+	if raw == "" {
+		return []Entity{}, nil
+	}
+
+	var entities []Entity
+	if err := json.Unmarshal([]byte(raw), &entities); err != nil {
+		return nil, err
+	}
+	if entities == nil {
+		return []Entity{}, nil
+	}
+	return entities, nil
+}
+
+// EncodeEntities serializes entities to JSON text, returning "[]" for nil/empty.
+func EncodeEntities(entities []Entity) (string, error) {
+	if len(entities) == 0 {
+		return "[]", nil
+	}
+	b, err := json.Marshal(entities)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -1,30 +1,147 @@
 package util
 
 import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type JWTClaims struct {
+	Role      string `json:"role,omitempty"`
+	SessionID string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWT(subject, secret, issuer string, ttl time.Duration) (string, error) {
+// JWTKey is one key of a JWTKeySet. SignKey is nil for keys that only verify. A key signs
+// from ActivateAt and verifies until RetireAt; zero times leave that side unbounded.
+type JWTKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	SignKey    any
+	VerifyKey  any
+	ActivateAt time.Time
+	RetireAt   time.Time
+}
+
+// JWTKeySet holds the keys tokens are signed and verified with. Several keys overlap
+// during a rotation: the next key is published before it activates and the previous one
+// keeps verifying until the tokens it signed have expired.
+type JWTKeySet struct {
+	keys []JWTKey
+}
+
+func NewJWTKeySet(keys []JWTKey) (*JWTKeySet, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one key is required")
+	}
+	seen := make(map[string]bool, len(keys))
+	signers := 0
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("key id is required")
+		}
+		if seen[key.ID] {
+			return nil, fmt.Errorf("duplicate key id %q", key.ID)
+		}
+		seen[key.ID] = true
+		if key.Method == nil || key.VerifyKey == nil {
+			return nil, fmt.Errorf("key %q: method and verify key are required", key.ID)
+		}
+		if key.SignKey != nil {
+			signers++
+		}
+	}
+	if signers == 0 {
+		return nil, errors.New("no key can sign")
+	}
+	return &JWTKeySet{keys: keys}, nil
+}
+
+// NewHMACKeySet returns a set holding only the HS256 shared secret, for deployments
+// without asymmetric keys.
+func NewHMACKeySet(secret string) (*JWTKeySet, error) {
+	if secret == "" {
+		return nil, errors.New("secret is required")
+	}
+	return &JWTKeySet{keys: []JWTKey{{
+		Method:    jwt.SigningMethodHS256,
+		SignKey:   []byte(secret),
+		VerifyKey: []byte(secret),
+	}}}, nil
+}
+
+// signingKey returns the most recently activated key that can sign at now.
+func (ks *JWTKeySet) signingKey(now time.Time) (*JWTKey, error) {
+	var current *JWTKey
+	for i := range ks.keys {
+		key := &ks.keys[i]
+		if key.SignKey == nil || key.ActivateAt.After(now) || key.retired(now) {
+			continue
+		}
+		if current == nil || key.ActivateAt.After(current.ActivateAt) {
+			current = key
+		}
+	}
+	if current == nil {
+		return nil, errors.New("no active signing key")
+	}
+	return current, nil
+}
+
+func (ks *JWTKeySet) verifyKey(kid string, now time.Time) (*JWTKey, error) {
+	for i := range ks.keys {
+		key := &ks.keys[i]
+		if key.ID != kid {
+			continue
+		}
+		if key.retired(now) {
+			return nil, fmt.Errorf("key %q is retired", kid)
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+func (k *JWTKey) retired(now time.Time) bool {
+	return !k.RetireAt.IsZero() && !now.Before(k.RetireAt)
+}
+
+func (ks *JWTKeySet) methods() []string {
+	algs := make([]string, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		algs = append(algs, key.Method.Alg())
+	}
+	return algs
+}
+
+func GenerateJWT(subject, role, sessionID, issuer string, ttl time.Duration, keys *JWTKeySet) (string, error) {
 	if subject == "" {
 		return "", errors.New("subject is required")
 	}
-	if secret == "" {
-		return "", errors.New("secret is required")
+	if keys == nil {
+		return "", errors.New("keys are required")
 	}
 	if ttl <= 0 {
 		return "", errors.New("ttl must be positive")
 	}
 
 	now := time.Now()
+	key, err := keys.signingKey(now)
+	if err != nil {
+		return "", err
+	}
 	claims := JWTClaims{
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			Subject:   subject,
 			Issuer:    issuer,
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -32,23 +149,35 @@ func GenerateJWT(subject, secret, issuer string, ttl time.Duration) (string, err
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+	token := jwt.NewWithClaims(key.Method, claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+	return token.SignedString(key.SignKey)
 }
 
-func ParseJWT(tokenString, secret string) (*JWTClaims, error) {
+func ParseJWT(tokenString string, keys *JWTKeySet) (*JWTClaims, error) {
 	if tokenString == "" {
 		return nil, errors.New("token is empty")
 	}
-	if secret == "" {
-		return nil, errors.New("secret is required")
+	if keys == nil {
+		return nil, errors.New("keys are required")
 	}
 
 	claims := &JWTClaims{}
-	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	parser := jwt.NewParser(jwt.WithValidMethods(keys.methods()))
 
-	if _, err := parser.ParseWithClaims(tokenString, claims, func(_ *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
+	if _, err := parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := keys.verifyKey(kid, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		// The header picks the key but must not pick the algorithm.
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("key %q does not use %s", kid, token.Method.Alg())
+		}
+		return key.VerifyKey, nil
 	}); err != nil {
 		return nil, err
 	}
@@ -59,3 +188,46 @@ func ParseJWT(tokenString, secret string) (*JWTClaims, error) {
 
 	return claims, nil
 }
+
+// JWK is a public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys that verify tokens at now, including keys that have not
+// activated yet so verifiers can cache them ahead of the switch. Shared secrets are never
+// published.
+func (ks *JWTKeySet) JWKS(now time.Time) JWKS {
+	set := JWKS{Keys: []JWK{}}
+	for _, key := range ks.keys {
+		if key.retired(now) {
+			continue
+		}
+		jwk := JWK{Kid: key.ID, Use: "sig", Alg: key.Method.Alg()}
+		switch pub := key.VerifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
@@ -0,0 +1,102 @@
+package util
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// EscapeLike escapes the LIKE wildcards in s so it matches literally.
+func EscapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// SearchTerms splits a websearch-style query into the plain terms to highlight,
+// dropping quotes, exclusions and the OR operator.
+func SearchTerms(query string) []string {
+	terms := make([]string, 0)
+	for _, field := range strings.Fields(query) {
+		if strings.HasPrefix(field, "-") || strings.EqualFold(field, "or") {
+			continue
+		}
+		field = strings.Trim(field, `"`)
+		if field != "" {
+			terms = append(terms, field)
+		}
+	}
+	return terms
+}
+
+// Highlight returns an HTML-escaped excerpt of text of at most width runes around the
+// first match, with every case-insensitive occurrence of terms wrapped in <em>.
+func Highlight(text string, terms []string, width int) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		needle := []rune(term)
+		for i, r := range needle {
+			needle[i] = unicode.ToLower(r)
+		}
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(lower); i++ {
+			if !runesEqual(lower[i:i+len(needle)], needle) {
+				continue
+			}
+			for j := i; j < i+len(needle); j++ {
+				marked[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+			i += len(needle) - 1
+		}
+	}
+
+	start, end := 0, len(runes)
+	if width > 0 && len(runes) > width {
+		start = max(first-width/4, 0)
+		end = min(start+width, len(runes))
+		start = max(end-width, 0)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	open := false
+	for i := start; i < end; i++ {
+		if marked[i] != open {
+			if open {
+				b.WriteString("</em>")
+			} else {
+				b.WriteString("<em>")
+			}
+			open = marked[i]
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+	}
+	if open {
+		b.WriteString("</em>")
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}